@@ -24,6 +24,8 @@ type OidcAuthMethodAttributes struct {
 	AllowedAudiences                  []string `json:"allowed_audiences,omitempty"`
 	ClaimsScopes                      []string `json:"claims_scopes,omitempty"`
 	AccountClaimMaps                  []string `json:"account_claim_maps,omitempty"`
+	ClaimAliases                      []string `json:"claim_aliases,omitempty"`
+	ClaimTypes                        []string `json:"claim_types,omitempty"`
 	DisableDiscoveredConfigValidation bool     `json:"disable_discovered_config_validation,omitempty"`
 	DryRun                            bool     `json:"dry_run,omitempty"`
 }
@@ -295,6 +295,54 @@ func DefaultLdapAuthMethodCertificates() Option {
 	}
 }
 
+func WithOidcAuthMethodClaimAliases(inClaimAliases []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["claim_aliases"] = inClaimAliases
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodClaimAliases() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["claim_aliases"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodClaimTypes(inClaimTypes []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["claim_types"] = inClaimTypes
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodClaimTypes() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["claim_types"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodClaimsScopes(inClaimsScopes []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
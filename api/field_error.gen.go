@@ -7,4 +7,5 @@ package api
 type FieldError struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
+	Code        string `json:"code,omitempty"`
 }
@@ -12,22 +12,33 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/api/scopes"
 )
 
 type ManagedGroup struct {
-	Id                string                 `json:"id,omitempty"`
-	Scope             *scopes.ScopeInfo      `json:"scope,omitempty"`
-	Name              string                 `json:"name,omitempty"`
-	Description       string                 `json:"description,omitempty"`
-	CreatedTime       time.Time              `json:"created_time,omitempty"`
-	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
-	Version           uint32                 `json:"version,omitempty"`
-	Type              string                 `json:"type,omitempty"`
-	AuthMethodId      string                 `json:"auth_method_id,omitempty"`
-	Attributes        map[string]interface{} `json:"attributes,omitempty"`
-	MemberIds         []string               `json:"member_ids,omitempty"`
-	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
+	Id                string                      `json:"id,omitempty"`
+	Scope             *scopes.ScopeInfo           `json:"scope,omitempty"`
+	Name              string                      `json:"name,omitempty"`
+	Description       string                      `json:"description,omitempty"`
+	CreatedTime       time.Time                   `json:"created_time,omitempty"`
+	UpdatedTime       time.Time                   `json:"updated_time,omitempty"`
+	Version           uint32                      `json:"version,omitempty"`
+	Type              string                      `json:"type,omitempty"`
+	AuthMethodId      string                      `json:"auth_method_id,omitempty"`
+	AuthMethodType    string                      `json:"auth_method_type,omitempty"`
+	AuthMethod        *authmethods.AuthMethodInfo `json:"auth_method,omitempty"`
+	Attributes        map[string]interface{}      `json:"attributes,omitempty"`
+	MemberIds         []string                    `json:"member_ids,omitempty"`
+	AttributesJson    string                      `json:"attributes_json,omitempty"`
+	FieldUpdatedTimes *FieldUpdatedTimes          `json:"field_updated_times,omitempty"`
+	RoleCount         uint32                      `json:"role_count,omitempty"`
+	Tags              map[string]string           `json:"tags,omitempty"`
+	Kind              string                      `json:"kind,omitempty"`
+	OwnerId           string                      `json:"owner_id,omitempty"`
+	Uri               string                      `json:"uri,omitempty"`
+	AuthorizedActions []string                    `json:"authorized_actions,omitempty"`
+	PopulatedFields   []string                    `json:"populated_fields,omitempty"`
 
 	response *api.Response
 }
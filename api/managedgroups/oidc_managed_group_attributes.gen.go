@@ -11,7 +11,14 @@ import (
 )
 
 type OidcManagedGroupAttributes struct {
-	Filter string `json:"filter,omitempty"`
+	Filter              string                        `json:"filter,omitempty"`
+	Disabled            bool                          `json:"disabled,omitempty"`
+	MatchOptions        *OidcManagedGroupMatchOptions `json:"match_options,omitempty"`
+	FilterVersion       uint32                        `json:"filter_version,omitempty"`
+	Frozen              bool                          `json:"frozen,omitempty"`
+	FilterSyntaxVersion string                        `json:"filter_syntax_version,omitempty"`
+	FilterHasWarnings   bool                          `json:"filter_has_warnings,omitempty"`
+	FilterComplexity    uint32                        `json:"filter_complexity,omitempty"`
 }
 
 func AttributesMapToOidcManagedGroupAttributes(in map[string]interface{}) (*OidcManagedGroupAttributes, error) {
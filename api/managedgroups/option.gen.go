@@ -138,6 +138,18 @@ func DefaultLdapManagedGroupGroupNames() Option {
 	}
 }
 
+func WithKind(inKind string) Option {
+	return func(o *options) {
+		o.postMap["kind"] = inKind
+	}
+}
+
+func DefaultKind() Option {
+	return func(o *options) {
+		o.postMap["kind"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -149,3 +161,27 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithOwnerId(inOwnerId string) Option {
+	return func(o *options) {
+		o.postMap["owner_id"] = inOwnerId
+	}
+}
+
+func DefaultOwnerId() Option {
+	return func(o *options) {
+		o.postMap["owner_id"] = nil
+	}
+}
+
+func WithTags(inTags map[string]string) Option {
+	return func(o *options) {
+		o.postMap["tags"] = inTags
+	}
+}
+
+func DefaultTags() Option {
+	return func(o *options) {
+		o.postMap["tags"] = nil
+	}
+}
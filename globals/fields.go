@@ -11,11 +11,17 @@ const (
 	DescriptionField                            = "description"
 	CreatedTimeField                            = "created_time"
 	UpdatedTimeField                            = "updated_time"
+	FieldUpdatedTimesField                      = "field_updated_times"
 	TypeField                                   = "type"
+	KindField                                   = "kind"
+	OwnerIdField                                = "owner_id"
+	UriField                                    = "uri"
 	AttributesField                             = "attributes"
 	ScopeIdField                                = "scope_id"
 	ScopeField                                  = "scope"
 	AuthMethodIdField                           = "auth_method_id"
+	AuthMethodTypeField                         = "auth_method_type"
+	AuthMethodField                             = "auth_method"
 	AccountIdField                              = "account_id"
 	UserIdField                                 = "user_id"
 	IsPrimaryField                              = "is_primary"
@@ -25,6 +31,7 @@ const (
 	ApproximateLastUsedTimeField                = "approximate_last_used_time"
 	MembersField                                = "members"
 	MemberIdsField                              = "member_ids"
+	RoleCountField                              = "role_count"
 	HostCatalogIdField                          = "host_catalog_id"
 	HostSetIdsField                             = "host_set_ids"
 	HostSourceIdsField                          = "host_source_ids"
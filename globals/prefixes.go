@@ -32,6 +32,9 @@ const (
 	// OidcManagedGroupPrefix defines the prefix for OIDC ManagedGroup public
 	// ids
 	OidcManagedGroupPrefix = "mgoidc"
+	// OidcManagedGroupTemplatePrefix defines the prefix for OIDC
+	// ManagedGroupTemplate public ids
+	OidcManagedGroupTemplatePrefix = "mgtoidc"
 
 	// LdapManagedGroupPrefix defines the prefix for ManagedGroup public ids
 	// from the LDAP auth method.
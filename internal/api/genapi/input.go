@@ -166,6 +166,11 @@ var inputStructs = []*structInfo{
 		outFile:     "scopes/scope_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &authmethods.AuthMethodInfo{},
+		outFile:     "authmethods/auth_method_info.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &plugins.PluginInfo{},
 		outFile:     "plugins/plugin_info.gen.go",
@@ -449,6 +454,14 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto: &managedgroups.OidcManagedGroupMatchOptions{},
+		outFile: "managedgroups/oidc_managed_group_match_options.gen.go",
+	},
+	{
+		inProto: &managedgroups.FieldUpdatedTimes{},
+		outFile: "managedgroups/field_updated_times.gen.go",
+	},
 	{
 		inProto:     &managedgroups.LdapManagedGroupAttributes{},
 		outFile:     "managedgroups/ldap_managed_group_attributes.gen.go",
@@ -467,6 +480,12 @@ var inputStructs = []*structInfo{
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:      "Tags",
+				FieldType: "map[string]string",
+			},
+		},
 		templates: []*template.Template{
 			clientTemplate,
 			commonCreateTemplate,
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+func init() {
+	auth.RegisterMembershipResolver(Subtype, membershipResolver{})
+}
+
+// membershipResolver resolves the memberships of LDAP managed groups by
+// comparing their group names to the groups an account is a member of.
+type membershipResolver struct{}
+
+// ResolveMemberships implements auth.MembershipResolver. The identity holds
+// the names (DNs) of the groups the account is a member of under "groups",
+// and a managed group matches if any of its group names is one of them, the
+// way the auth_ldap_managed_group_member_account view matches them.
+func (membershipResolver) ResolveMemberships(ctx context.Context, _ auth.AuthMethod, mgs []auth.ManagedGroup, identity map[string]any) ([]auth.ManagedGroup, error) {
+	const op = "ldap.(membershipResolver).ResolveMemberships"
+	groups, err := identityGroups(identity)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	var matched []auth.ManagedGroup
+	for _, mg := range mgs {
+		ldapMg, ok := mg.(*ManagedGroup)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("managed group %s is a %T, not an ldap managed group", mg.GetPublicId(), mg))
+		}
+		var names []string
+		if err := json.Unmarshal([]byte(ldapMg.GetGroupNames()), &names); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to unmarshal group names of managed group %s", mg.GetPublicId())))
+		}
+		for _, name := range names {
+			if groups[name] {
+				matched = append(matched, mg)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+// identityGroups returns the set of groups the identity is a member of.
+func identityGroups(identity map[string]any) (map[string]bool, error) {
+	groups := map[string]bool{}
+	switch g := identity["groups"].(type) {
+	case nil:
+	case []string:
+		for _, name := range g {
+			groups[name] = true
+		}
+	case []any:
+		for _, v := range g {
+			name, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("group %v is not a string", v)
+			}
+			groups[name] = true
+		}
+	default:
+		return nil, fmt.Errorf("groups are a %T, not a list of strings", g)
+	}
+	return groups, nil
+}
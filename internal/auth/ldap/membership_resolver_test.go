@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembershipResolver(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resolver, ok := auth.MembershipResolverFor(Subtype)
+	require.True(t, ok)
+
+	admins := AllocManagedGroup()
+	admins.PublicId = "mgldap_1"
+	admins.GroupNames = `["cn=admin,ou=groups,dc=example,dc=com","cn=root,ou=groups,dc=example,dc=com"]`
+	devs := AllocManagedGroup()
+	devs.PublicId = "mgldap_2"
+	devs.GroupNames = `["cn=dev,ou=groups,dc=example,dc=com"]`
+	mgs := []auth.ManagedGroup{admins, devs}
+
+	got, err := resolver.ResolveMemberships(ctx, nil, mgs, map[string]any{
+		"groups": []string{"cn=root,ou=groups,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []auth.ManagedGroup{admins}, got)
+
+	// Groups decoded from JSON are accepted too.
+	got, err = resolver.ResolveMemberships(ctx, nil, mgs, map[string]any{
+		"groups": []any{"cn=dev,ou=groups,dc=example,dc=com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []auth.ManagedGroup{devs}, got)
+
+	got, err = resolver.ResolveMemberships(ctx, nil, mgs, map[string]any{})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = resolver.ResolveMemberships(ctx, nil, mgs, map[string]any{"groups": "cn=dev,ou=groups,dc=example,dc=com"})
+	require.Error(t, err)
+}
@@ -9,8 +9,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 )
 
 type options struct {
@@ -46,6 +48,22 @@ type options struct {
 	withMemberOfGroups       string
 	withUrls                 []string
 	withPublicId             string
+	withForce                bool
+	withVersion              uint32
+	withKind                 string
+	withOwnerId              string
+	withRoles                []*iam.Role
+	withTags                 map[string]string
+	withReplaceTags          bool
+	withAfterManagedGroupId  string
+	withAfterMemberId        string
+	withAfterCreateTime      time.Time
+	withAfterCreateMemberId  string
+	withIgnoreNameCase       bool
+	withTagFilter            map[string]string
+	withNoMembers            bool
+	withInRoleIds            []string
+	withNotInRoleIds         []string
 }
 
 // Option - how options are passed as args
@@ -373,3 +391,134 @@ func WithPublicId(ctx context.Context, publicId string) Option {
 		return nil
 	}
 }
+
+// WithForce provides an option to force a write operation which would
+// otherwise be refused, e.g. deleting a managed group which is a role
+// principal.
+func WithForce(_ context.Context) Option {
+	return func(o *options) error {
+		o.withForce = true
+		return nil
+	}
+}
+
+// WithVersion provides an option to only perform a write operation when the
+// resource is at the given version.
+func WithVersion(_ context.Context, v uint32) Option {
+	return func(o *options) error {
+		o.withVersion = v
+		return nil
+	}
+}
+
+// WithKind provides an option for creating a managed group of the given kind,
+// either auth.ManagedGroupKindLogin or auth.ManagedGroupKindSynced. When
+// listing managed groups it lists only the ones of that kind.
+func WithKind(_ context.Context, kind string) Option {
+	return func(o *options) error {
+		o.withKind = kind
+		return nil
+	}
+}
+
+// WithOwnerId provides an option for creating a managed group owned by the
+// given user id or principal. When listing managed groups it lists only the
+// ones it owns.
+func WithOwnerId(_ context.Context, ownerId string) Option {
+	return func(o *options) error {
+		o.withOwnerId = ownerId
+		return nil
+	}
+}
+
+// WithRoles provides an option for specifying roles to add a managed group to
+// as a principal when it is created. The roles must be at their current
+// version.
+func WithRoles(_ context.Context, roles ...*iam.Role) Option {
+	return func(o *options) error {
+		o.withRoles = roles
+		return nil
+	}
+}
+
+// WithTags provides an option for replacing the tags of a managed group with
+// tags when it is created, updated or touched, in the same transaction. A nil
+// or empty tags clears them.
+func WithTags(_ context.Context, tags map[string]string) Option {
+	return func(o *options) error {
+		o.withTags = tags
+		o.withReplaceTags = true
+		return nil
+	}
+}
+
+// WithAfterMembership provides an option for listing the managed group
+// memberships which sort after the membership of the member with memberId in
+// the managed group with managedGroupId, ordered by managed group id and then
+// member id.
+func WithAfterMembership(_ context.Context, managedGroupId, memberId string) Option {
+	return func(o *options) error {
+		o.withAfterManagedGroupId = managedGroupId
+		o.withAfterMemberId = memberId
+		return nil
+	}
+}
+
+// WithAfterMember provides an option for listing the memberships of a managed
+// group which sort after the membership of the member with memberId created at
+// createTime, ordered by create time and then member id. It is meant to be
+// used along with WithOrderByCreateTime, and follows its direction.
+func WithAfterMember(_ context.Context, createTime time.Time, memberId string) Option {
+	return func(o *options) error {
+		o.withAfterCreateTime = createTime
+		o.withAfterCreateMemberId = memberId
+		return nil
+	}
+}
+
+// WithIgnoreNameCase provides an option for looking up a managed group by
+// name without regard to case.
+func WithIgnoreNameCase(_ context.Context, ignore bool) Option {
+	return func(o *options) error {
+		o.withIgnoreNameCase = ignore
+		return nil
+	}
+}
+
+// WithTagFilter provides an option for listing only the managed groups which
+// have all of the tags.
+func WithTagFilter(_ context.Context, tags map[string]string) Option {
+	return func(o *options) error {
+		o.withTagFilter = tags
+		return nil
+	}
+}
+
+// WithNoMembers provides an option for listing only the managed groups which
+// have no members.
+func WithNoMembers(_ context.Context, noMembers bool) Option {
+	return func(o *options) error {
+		o.withNoMembers = noMembers
+		return nil
+	}
+}
+
+// WithPrincipalInRoles provides an option for listing only the managed groups
+// which are a principal in at least one of the roles with the given ids. An
+// empty, non-nil roleIds lists none of them.
+func WithPrincipalInRoles(_ context.Context, roleIds []string) Option {
+	return func(o *options) error {
+		o.withInRoleIds = roleIds
+		return nil
+	}
+}
+
+// WithPrincipalInNoRoles provides an option for listing only the managed
+// groups which aren't a principal in any of the roles with the given ids. An
+// empty, non-nil roleIds lists all of them.
+func WithPrincipalInNoRoles(_ context.Context, roleIds []string) Option {
+	return func(o *options) error {
+		o.withNotInRoleIds = roleIds
+		return nil
+	}
+}
@@ -9,7 +9,10 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/internal/iam"
+	iamstore "github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -324,4 +327,126 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withPublicId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithForce", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithForce(testCtx))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withForce = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithVersion", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithVersion(testCtx, 2))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withVersion = 2
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithKind", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithKind(testCtx, "synced"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withKind = "synced"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOwnerId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithOwnerId(testCtx, "u_1234567890"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withOwnerId = "u_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		role := &iam.Role{Role: &iamstore.Role{PublicId: "r_1234567890", Version: 1}}
+		opts, err := getOpts(WithRoles(testCtx, role))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withRoles = []*iam.Role{role}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTags", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithTags(testCtx, map[string]string{"team": "eng"}))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withTags = map[string]string{"team": "eng"}
+		testOpts.withReplaceTags = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAfterMembership", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithAfterMembership(testCtx, "mgldap_1234567890", "acctldap_1234567890"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withAfterManagedGroupId = "mgldap_1234567890"
+		testOpts.withAfterMemberId = "acctldap_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAfterMember", func(t *testing.T) {
+		assert := assert.New(t)
+		created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		opts, err := getOpts(WithAfterMember(testCtx, created, "acctldap_1234567890"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withAfterCreateTime = created
+		testOpts.withAfterCreateMemberId = "acctldap_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIgnoreNameCase", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithIgnoreNameCase(testCtx, true))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withIgnoreNameCase = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTagFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithTagFilter(testCtx, map[string]string{"team": "eng"}))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withTagFilter = map[string]string{"team": "eng"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNoMembers", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithNoMembers(testCtx, true))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withNoMembers = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPrincipalInRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithPrincipalInRoles(testCtx, []string{"r_1234567890"}))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withInRoleIds = []string{"r_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPrincipalInNoRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithPrincipalInNoRoles(testCtx, []string{"r_1234567890"}))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withNotInRoleIds = []string{"r_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -45,6 +45,7 @@ const (
 	BindPasswordField         = "BindPassword"
 	AccountAttributeMapsField = "AccountAttributeMaps"
 	GroupNamesField           = "GroupNames"
+	OwnerIdField              = "OwnerId"
 )
 
 // isEmpty returns true if all the args are empty.  Only supports checking
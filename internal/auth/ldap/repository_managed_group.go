@@ -6,10 +6,13 @@ package ldap
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
@@ -18,11 +21,15 @@ import (
 // CreateManagedGroup inserts an ManagedGroup, mg, into the repository and
 // returns a new ManagedGroup containing its PublicId. mg is not changed. mg
 // must contain a valid AuthMethodId. mg must not contain a PublicId. The
-// PublicId is generated and assigned by this method. All options are ignored.
+// PublicId is generated and assigned by this method.
 //
 // Both mg.Name and mg.Description are optional. If mg.Name is set, it must be
 // unique within mg.AuthMethodId.
-func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, _ ...Option) (*ManagedGroup, error) {
+//
+// WithRoles is supported to add the managed group as a principal to the roles
+// in the same transaction. If it can't be added to all of them nothing is
+// created. WithTags is supported to set its tags in the same transaction.
+func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, opt ...Option) (*ManagedGroup, error) {
 	const op = "ldap.(Repository).CreateManagedGroup"
 	switch {
 	case mg == nil:
@@ -38,6 +45,10 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 	case scopeId == "":
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	mg = mg.clone()
 
@@ -59,11 +70,21 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 
 	var newManagedGroup *ManagedGroup
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newManagedGroup = mg.clone()
 			if err := w.Create(ctx, newManagedGroup, db.WithOplog(oplogWrapper, oplogMetadata)); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if len(opts.withRoles) > 0 {
+				if _, err := iam.AddManagedGroupToRolesTx(ctx, reader, w, r.kms, newManagedGroup.PublicId, opts.withRoles); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add managed group to roles"))
+				}
+			}
+			if len(opts.withTags) > 0 {
+				if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, newManagedGroup.PublicId, opts.withTags, oplogWrapper, oplogMetadata); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+				}
+			}
 			return nil
 		},
 	)
@@ -97,7 +118,67 @@ func (r *Repository) LookupManagedGroup(ctx context.Context, withPublicId string
 	return a, nil
 }
 
-// ListManagedGroups in an auth method and supports WithLimit option.
+// LookupManagedGroups looks up the managed groups with the provided ids in a
+// single query. Ids which don't match a managed group are left out, so fewer
+// managed groups than ids may be returned, in no particular order. All options
+// are ignored.
+func (r *Repository) LookupManagedGroups(ctx context.Context, withPublicIds []string, _ ...Option) ([]*ManagedGroup, error) {
+	const op = "ldap.(Repository).LookupManagedGroups"
+	if len(withPublicIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public ids")
+	}
+	var mgs []*ManagedGroup
+	if err := r.reader.SearchWhere(ctx, &mgs, "public_id in (?)", []any{withPublicIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
+// LookupManagedGroupByName will look up the managed group with the provided
+// name in the auth method. Names are unique and compared case-sensitively.
+// WithIgnoreNameCase compares them case-insensitively instead, in which case a
+// managed group whose name matches exactly is preferred, and an error with the
+// errors.NotUnique code is returned if several managed groups match only
+// case-insensitively. If the managed group is not found, it will return nil,
+// nil. Supports the WithIgnoreNameCase option.
+func (r *Repository) LookupManagedGroupByName(ctx context.Context, withAuthMethodId, withName string, opt ...Option) (*ManagedGroup, error) {
+	const op = "ldap.(Repository).LookupManagedGroupByName"
+	switch {
+	case withAuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case withName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	where := "auth_method_id = ? and name = ?"
+	if opts.withIgnoreNameCase {
+		where = "auth_method_id = ? and lower(name) = lower(?)"
+	}
+	var mgs []*ManagedGroup
+	if err := r.reader.SearchWhere(ctx, &mgs, where, []any{withAuthMethodId, withName}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s in %s", withName, withAuthMethodId)))
+	}
+	switch len(mgs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return mgs[0], nil
+	}
+	for _, mg := range mgs {
+		if mg.GetName() == withName {
+			return mg, nil
+		}
+	}
+	return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("name %q matches %d managed groups in %s", withName, len(mgs), withAuthMethodId))
+}
+
+// ListManagedGroups in an auth method and supports WithLimit option. The
+// managed groups listed can be restricted with the WithKind, WithOwnerId,
+// WithTagFilter, WithNoMembers, WithPrincipalInRoles and
+// WithPrincipalInNoRoles options.
 func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroup, error) {
 	const op = "ldap.(Repository).ListManagedGroups"
 	if withAuthMethodId == "" {
@@ -112,17 +193,121 @@ func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId str
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
+	where, args := listManagedGroupsWhere(withAuthMethodId, opts)
 	var mgs []*ManagedGroup
-	err = r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []any{withAuthMethodId}, db.WithLimit(limit))
+	err = r.reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(limit))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	return mgs, nil
 }
 
+// listManagedGroupsWhere returns the where clause, and its arguments, of the
+// managed groups in the auth method which the list options in opts restrict
+// them to.
+func listManagedGroupsWhere(authMethodId string, opts options) (string, []any) {
+	where, args := []string{"auth_method_id = ?"}, []any{authMethodId}
+	if opts.withKind != "" {
+		where = append(where, "kind = ?")
+		args = append(args, opts.withKind)
+	}
+	if opts.withOwnerId != "" {
+		where = append(where, "owner_id = ?")
+		args = append(args, opts.withOwnerId)
+	}
+	// The tags are sorted so the same tags always build the same query.
+	keys := make([]string, 0, len(opts.withTagFilter))
+	for k := range opts.withTagFilter {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		where = append(where, managedGroupHasTagWhere)
+		args = append(args, k, opts.withTagFilter[k])
+	}
+	if opts.withNoMembers {
+		where = append(where, managedGroupWithoutMembersWhere)
+	}
+	if opts.withInRoleIds != nil {
+		where = append(where, managedGroupInRolesWhere)
+		args = append(args, opts.withInRoleIds)
+	}
+	if opts.withNotInRoleIds != nil {
+		where = append(where, "not "+managedGroupInRolesWhere)
+		args = append(args, opts.withNotInRoleIds)
+	}
+	return strings.Join(where, " and "), args
+}
+
+// CountManagedGroups returns the number of managed groups in an auth method
+// without loading them. It supports the same options restricting the managed
+// groups as ListManagedGroups, and counts the managed groups it would list
+// without a limit.
+func (r *Repository) CountManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) (int, error) {
+	const op = "ldap.(Repository).CountManagedGroups"
+	if withAuthMethodId == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	where, args := listManagedGroupsWhere(withAuthMethodId, opts)
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(managedGroupCountQuery, where), args)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return count, nil
+}
+
+// CountManagedGroupMembers returns the number of member accounts of each
+// managed group in an auth method, keyed by the managed group's public id.
+// Managed groups without members are included with a count of 0.
+func (r *Repository) CountManagedGroupMembers(ctx context.Context, withAuthMethodId string) (map[string]int, error) {
+	const op = "ldap.(Repository).CountManagedGroupMembers"
+	if withAuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rows, err := r.reader.Query(ctx, managedGroupMemberCountsQuery, []any{withAuthMethodId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	counts := make(map[string]int)
+	for rows.Next() {
+		var id string
+		var count int
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		counts[id] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
 // DeleteManagedGroup deletes the managed group for the provided id from the
-// repository returning a count of the number of records deleted. All options
-// are ignored.
+// repository returning a count of the number of records deleted. If the managed
+// group is a principal in any roles an error with the errors.Conflict code
+// listing those roles is returned and nothing is deleted, unless WithForce is
+// provided, in which case the principal associations are removed along with the
+// managed group.
+//
+// If WithVersion is provided, the managed group is only deleted when it is at
+// that version; when it is at another version an error with the
+// errors.Conflict code is returned.
 func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
 	const op = "ldap.(Repository).DeleteManagedGroup"
 	switch {
@@ -146,20 +331,51 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate oplog metadata"))
 	}
 
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
 	var rowsDeleted int
 	_, err = r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) (err error) {
+		func(reader db.Reader, w db.Writer) (err error) {
+			if !opts.withForce {
+				roleIds, err := managedGroupRoleIds(ctx, reader, withPublicId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if len(roleIds) > 0 {
+					return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("managed group is a principal in roles: %s", strings.Join(roleIds, ", ")))
+				}
+			}
 			dMg := mg.clone()
-			rowsDeleted, err = w.Delete(ctx, dMg, db.WithOplog(oplogWrapper, metadata))
+			deleteOpts := []db.Option{db.WithOplog(oplogWrapper, metadata)}
+			if opts.withVersion > 0 {
+				deleteOpts = append(deleteOpts, db.WithWhere("version = ?", opts.withVersion))
+			}
+			rowsDeleted, err = w.Delete(ctx, dMg, deleteOpts...)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
 			if rowsDeleted > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
 			}
+			if rowsDeleted == 0 && opts.withVersion > 0 {
+				// Nothing was deleted, either because the managed group is at
+				// another version or because it no longer exists.
+				current := AllocManagedGroup()
+				current.PublicId = withPublicId
+				if err := reader.LookupById(ctx, current); err != nil {
+					if errors.IsNotFoundError(err) {
+						return nil
+					}
+					return errors.Wrap(ctx, err, op)
+				}
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("managed group is at version %d, not %d", current.Version, opts.withVersion))
+			}
 			return nil
 		},
 	)
@@ -176,12 +392,14 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 // ManagedGroup containing the updated values and a count of the number of
 // records updated. mg is not changed.
 //
-// mg must contain a valid PublicId. Only mg.Name, mg.Description, and mg.GroupNames
-// can be updated. If mg.Name is set to a non-empty string, it must be unique
+// mg must contain a valid PublicId. Only mg.Name, mg.Description, mg.GroupNames
+// and mg.OwnerId can be updated. If mg.Name is set to a non-empty string, it must be unique
 // within mg.AuthMethodId.
 //
 // An attribute of a will be set to NULL in the database if the attribute in a
 // is the zero value and it is included in fieldMaskPaths.
+//
+// WithTags is supported to replace its tags in the same transaction.
 func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, version uint32, fieldMaskPaths []string, opt ...Option) (*ManagedGroup, int, error) {
 	const op = "ldap.(Repository).UpdateManagedGroup"
 	switch {
@@ -202,6 +420,7 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 		case strings.EqualFold(NameField, f):
 		case strings.EqualFold(DescriptionField, f):
 		case strings.EqualFold(GroupNamesField, f):
+		case strings.EqualFold(OwnerIdField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -212,6 +431,7 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 			NameField:        mg.Name,
 			DescriptionField: mg.Description,
 			GroupNamesField:  mg.GroupNames,
+			OwnerIdField:     mg.OwnerId,
 		},
 		fieldMaskPaths,
 		nil,
@@ -220,6 +440,11 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
 	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
@@ -238,7 +463,7 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 	var rowsUpdated int
 	var returnedManagedGroup *ManagedGroup
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedManagedGroup = mg.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedManagedGroup, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
@@ -248,6 +473,11 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 && opts.withReplaceTags {
+				if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, mg.PublicId, opts.withTags, oplogWrapper, metadata); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+				}
+			}
 			return nil
 		},
 	)
@@ -262,3 +492,195 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 
 	return returnedManagedGroup, rowsUpdated, nil
 }
+
+// TouchManagedGroup increments the version of the managed group with the
+// provided id, which also sets its update time, without changing any of its
+// other fields. It returns the touched ManagedGroup and a count of the number
+// of records updated, which is 0 when the managed group isn't at version.
+// WithTags is supported to replace its tags in the same transaction, for an
+// update of only its tags.
+func (r *Repository) TouchManagedGroup(ctx context.Context, scopeId, withPublicId string, version uint32, opt ...Option) (*ManagedGroup, int, error) {
+	const op = "ldap.(Repository).TouchManagedGroup"
+	switch {
+	case withPublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case scopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
+			errors.WithMsg(("unable to get oplog wrapper")))
+	}
+
+	foundMg := AllocManagedGroup()
+	foundMg.PublicId = withPublicId
+	if err := r.reader.LookupById(ctx, foundMg); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("managed group not found"))
+	}
+	metadata, err := foundMg.oplog(ctx, oplog.OpType_OP_TYPE_UPDATE, scopeId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate oplog metadata"))
+	}
+	var rowsUpdated int
+	var returnedManagedGroup *ManagedGroup
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			// Writing the next version is the change; the update time
+			// follows from it.
+			returnedManagedGroup = AllocManagedGroup()
+			returnedManagedGroup.PublicId = withPublicId
+			returnedManagedGroup.Version = version + 1
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedManagedGroup, []string{"Version"}, nil, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if rowsUpdated == 1 && opts.withReplaceTags {
+				if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, withPublicId, opts.withTags, oplogWrapper, metadata); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(withPublicId))
+	}
+	return returnedManagedGroup, rowsUpdated, nil
+}
+
+// UpsertManagedGroup creates mg if no managed group with mg.Name exists in
+// mg.AuthMethodId, otherwise it updates the description and group names of
+// the existing managed group to match mg. The lookup and the write happen in
+// the same transaction using the stored version, so callers don't need to
+// track it. It returns the resulting ManagedGroup and whether it was created.
+// mg is not changed. All options are ignored.
+//
+// mg must contain a valid AuthMethodId, a Name and GroupNames. mg must not
+// contain a PublicId.
+func (r *Repository) UpsertManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, _ ...Option) (*ManagedGroup, bool, error) {
+	const op = "ldap.(Repository).UpsertManagedGroup"
+	switch {
+	case mg == nil:
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing managed group")
+	case mg.ManagedGroup == nil:
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing embedded managed group")
+	case mg.AuthMethodId == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case mg.Name == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	case len(mg.GroupNames) == 0:
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing group names")
+	case mg.PublicId != "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	case scopeId == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	mg = mg.clone()
+	newId, err := newManagedGroupId(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"), errors.WithCode(errors.Encrypt))
+	}
+
+	var created bool
+	var returnedManagedGroup *ManagedGroup
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			found := AllocManagedGroup()
+			err := reader.LookupWhere(ctx, found, "auth_method_id = ? and name = ?", []any{mg.AuthMethodId, mg.Name})
+			switch {
+			case errors.IsNotFoundError(err):
+				created = true
+				returnedManagedGroup = mg.clone()
+				returnedManagedGroup.PublicId = newId
+				metadata, err := returnedManagedGroup.oplog(ctx, oplog.OpType_OP_TYPE_CREATE, scopeId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate managed group oplog metadata"))
+				}
+				if err := w.Create(ctx, returnedManagedGroup, db.WithOplog(oplogWrapper, metadata)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				return nil
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			}
+
+			created = false
+			returnedManagedGroup = mg.clone()
+			returnedManagedGroup.PublicId = found.PublicId
+			metadata, err := found.oplog(ctx, oplog.OpType_OP_TYPE_UPDATE, scopeId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate oplog metadata"))
+			}
+			dbMask, nullFields := dbw.BuildUpdatePaths(
+				map[string]any{
+					DescriptionField: returnedManagedGroup.Description,
+					GroupNamesField:  returnedManagedGroup.GroupNames,
+				},
+				[]string{DescriptionField, GroupNamesField},
+				nil,
+			)
+			version := found.Version
+			rowsUpdated, err := w.Update(ctx, returnedManagedGroup, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 managed group to be updated, got %d", rowsUpdated))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, false, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf(
+				"in auth method %s: name %q already exists",
+				mg.AuthMethodId, mg.Name))
+		}
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithMsg(mg.AuthMethodId))
+	}
+	return returnedManagedGroup, created, nil
+}
+
+// managedGroupRoleIds returns the ids of the roles the managed group is a
+// principal in, ordered by id.
+func managedGroupRoleIds(ctx context.Context, reader db.Reader, managedGroupId string) ([]string, error) {
+	const op = "ldap.managedGroupRoleIds"
+	rows, err := reader.Query(ctx, managedGroupRoleIdsQuery, []any{managedGroupId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var roleIds []string
+	for rows.Next() {
+		var result struct {
+			RoleId string
+		}
+		if err := reader.ScanRows(ctx, rows, &result); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		roleIds = append(roleIds, result.RoleId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return roleIds, nil
+}
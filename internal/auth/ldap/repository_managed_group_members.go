@@ -5,6 +5,7 @@ package ldap
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/db"
@@ -58,8 +59,37 @@ func (r *Repository) ListManagedGroupMembershipsByMember(ctx context.Context, wi
 	return mgs, nil
 }
 
+// ListManagedGroupsByMember lists the managed groups the member (account) is
+// currently a member of, ordered by id, and supports WithLimit option.
+func (r *Repository) ListManagedGroupsByMember(ctx context.Context, withAcctId string, opt ...Option) ([]*ManagedGroup, error) {
+	const op = "ldap.(Repository).ListManagedGroupsByMember"
+	if withAcctId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var mgs []*ManagedGroup
+	err = r.reader.SearchWhere(ctx, &mgs,
+		"public_id in (select managed_group_id from auth_ldap_managed_group_member_account where member_id = ?)",
+		[]any{withAcctId}, db.WithLimit(limit), db.WithOrder("public_id"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
 // ListManagedGroupMembershipsByGroup lists managed group memberships via the
-// group ID and supports WithLimit option.
+// group ID, ordered by member id, and supports WithLimit option. With
+// WithOrderByCreateTime they're ordered by create time and then member id
+// instead, and WithAfterMember lists the ones after a membership in that
+// order.
 func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, withGroupId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
 	const op = "ldap.(Repository).ListManagedGroupMembershipsByGroup"
 	if withGroupId == "" {
@@ -74,8 +104,53 @@ func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, wit
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
+	where, args := "managed_group_id = ?", []any{withGroupId}
+	order := "member_id"
+	if opts.withOrderByCreateTime {
+		cmp := ">"
+		order = "create_time, member_id"
+		if !opts.ascending {
+			cmp = "<"
+			order = "create_time desc, member_id desc"
+		}
+		if opts.withAfterCreateMemberId != "" {
+			where += fmt.Sprintf(" and (create_time, member_id) %s (?, ?)", cmp)
+			args = append(args, opts.withAfterCreateTime, opts.withAfterCreateMemberId)
+		}
+	}
+	var mgs []*ManagedGroupMemberAccount
+	err = r.reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(limit), db.WithOrder(order))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
+// ListManagedGroupMembershipsByAuthMethod lists the memberships of the managed
+// groups of the auth method, ordered by managed group id and then member id,
+// and supports WithLimit and WithAfterMembership options.
+func (r *Repository) ListManagedGroupMembershipsByAuthMethod(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
+	const op = "ldap.(Repository).ListManagedGroupMembershipsByAuthMethod"
+	if withAuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	where, args := managedGroupMembershipsByAuthMethodWhere, []any{withAuthMethodId}
+	if opts.withAfterManagedGroupId != "" {
+		where += " and (managed_group_id, member_id) > (?, ?)"
+		args = append(args, opts.withAfterManagedGroupId, opts.withAfterMemberId)
+	}
 	var mgs []*ManagedGroupMemberAccount
-	err = r.reader.SearchWhere(ctx, &mgs, "managed_group_id = ?", []any{withGroupId}, db.WithLimit(limit))
+	err = r.reader.SearchWhere(ctx, &mgs, where, args,
+		db.WithLimit(limit), db.WithOrder("managed_group_id, member_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
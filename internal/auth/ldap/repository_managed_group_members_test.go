@@ -194,6 +194,76 @@ func Test_ManagedGroupMemberships(t *testing.T) {
 		assert.Contains(t, err.Error(), "missing account id")
 		assert.Nil(t, got)
 	})
+	t.Run("ListManagedGroupsByMember", func(t *testing.T) {
+		got, err := repo.ListManagedGroupsByMember(testCtx, staticAccount.PublicId)
+		require.NoError(t, err)
+		require.Len(t, got, staticMembershipCount)
+		assert.Equal(t, staticGroup.PublicId, got[0].PublicId)
+		assert.Equal(t, staticGroup.GroupNames, got[0].GroupNames)
+	})
+	t.Run("ListManagedGroupsByMember-invalid-parameter", func(t *testing.T) {
+		got, err := repo.ListManagedGroupsByMember(testCtx, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing account id")
+		assert.Nil(t, got)
+	})
+	t.Run("ListManagedGroupMembershipsByAuthMethod", func(t *testing.T) {
+		got, err := repo.ListManagedGroupMembershipsByAuthMethod(testCtx, testAuthMethod.PublicId)
+		require.NoError(t, err)
+		var found bool
+		for i, m := range got {
+			if i > 0 {
+				prev := got[i-1]
+				assert.True(t, prev.ManagedGroupId < m.ManagedGroupId ||
+					(prev.ManagedGroupId == m.ManagedGroupId && prev.MemberId < m.MemberId))
+			}
+			if m.ManagedGroupId == staticGroup.PublicId && m.MemberId == staticAccount.PublicId {
+				found = true
+				assert.NotNil(t, m.GetCreateTime())
+			}
+		}
+		assert.True(t, found)
+
+		after, err := repo.ListManagedGroupMembershipsByAuthMethod(testCtx, testAuthMethod.PublicId, ldap.WithAfterMembership(testCtx, got[0].ManagedGroupId, got[0].MemberId))
+		require.NoError(t, err)
+		assert.Equal(t, len(got)-1, len(after))
+	})
+	t.Run("ListManagedGroupMembershipsByAuthMethod-invalid-parameter", func(t *testing.T) {
+		got, err := repo.ListManagedGroupMembershipsByAuthMethod(testCtx, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing auth method id")
+		assert.Nil(t, got)
+	})
+}
+
+func Test_ManagedGroupMemberships_syncedKind(t *testing.T) {
+	t.Parallel()
+
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRootWrapper := db.TestWrapper(t)
+	testRw := db.New(testConn)
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	testCtx := context.Background()
+	testGlobalDbWrapper, err := testKms.GetWrapper(testCtx, "global", kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	testAuthMethod := ldap.TestAuthMethod(t, testConn, testGlobalDbWrapper, "global", []string{"ldaps://ldap1"})
+	account := ldap.TestAccount(t, testConn, testAuthMethod, "test-login-name", ldap.WithMemberOfGroups(testCtx, "admin"))
+	login := ldap.TestManagedGroup(t, testConn, testAuthMethod, []string{"admin"})
+	synced := ldap.TestManagedGroup(t, testConn, testAuthMethod, []string{"admin"}, ldap.WithKind(testCtx, "synced"))
+
+	repo, err := ldap.NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	// The groups of an account aren't matched against synced managed groups.
+	memberships, err := repo.ListManagedGroupMembershipsByMember(testCtx, account.PublicId)
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.Equal(t, login.PublicId, memberships[0].ManagedGroupId)
+
+	members, err := repo.ListManagedGroupMembershipsByGroup(testCtx, synced.PublicId)
+	require.NoError(t, err)
+	assert.Empty(t, members)
 }
 
 func TestManagedGroupMemberAccount_SetTableName(t *testing.T) {
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ManagedGroupTags(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testCtx := context.Background()
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	users := TestManagedGroup(t, testConn, am, []string{"users"})
+
+	repo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	admins, err := NewManagedGroup(testCtx, am.GetPublicId(), []string{"admin"})
+	require.NoError(t, err)
+	admins, err = repo.CreateManagedGroup(testCtx, org.PublicId, admins, WithTags(testCtx, map[string]string{"team": "eng", "env": "prod"}))
+	require.NoError(t, err)
+
+	got, err := repo.LookupManagedGroupTags(testCtx, admins.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "eng", "env": "prod"}, got)
+	got, err = repo.LookupManagedGroupTags(testCtx, users.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	all, err := repo.ListManagedGroupTags(testCtx, am.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{admins.GetPublicId(): {"team": "eng", "env": "prod"}}, all)
+
+	// The tags are replaced along with an update.
+	upd := admins.clone()
+	upd.Description = "updated"
+	admins, _, err = repo.UpdateManagedGroup(testCtx, org.PublicId, upd, admins.Version, []string{DescriptionField}, WithTags(testCtx, map[string]string{"env": "dev"}))
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroupTags(testCtx, admins.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "dev"}, got)
+
+	// Touching replaces them for an update of only the tags.
+	_, _, err = repo.TouchManagedGroup(testCtx, org.PublicId, admins.GetPublicId(), admins.Version, WithTags(testCtx, nil))
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroupTags(testCtx, admins.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	// The tags are oplogged with their managed group.
+	assert.Equal(t, 4, auth.TestManagedGroupTagOplogEntries(t, testRw, admins.GetPublicId()))
+	assert.Zero(t, auth.TestManagedGroupTagOplogEntries(t, testRw, users.GetPublicId()))
+
+	all, err = repo.ListManagedGroupTags(testCtx, globals.LdapAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	_, err = repo.ListManagedGroupTags(testCtx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
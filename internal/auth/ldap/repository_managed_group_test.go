@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestRepository_CreateManagedGroup(t *testing.T) {
@@ -432,6 +433,148 @@ func TestRepository_LookupManagedGroup(t *testing.T) {
 	}
 }
 
+func TestRepository_LookupManagedGroups(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testCtx := context.Background()
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	other := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap2"})
+	mg1 := TestManagedGroup(t, testConn, am, testGrpNames)
+	mg2 := TestManagedGroup(t, testConn, other, testGrpNames)
+	TestManagedGroup(t, testConn, other, testGrpNames)
+
+	// The lookup isn't bounded by the repo limit
+	repo, err := NewRepository(testCtx, testRw, testRw, testKms, WithLimit(testCtx, 1))
+	require.NoError(t, err)
+
+	got, err := repo.LookupManagedGroups(testCtx, []string{mg1.PublicId, mg2.PublicId, globals.LdapManagedGroupPrefix + "_doesntexist"})
+	require.NoError(t, err)
+	var gotIds []string
+	for _, mg := range got {
+		gotIds = append(gotIds, mg.PublicId)
+	}
+	assert.ElementsMatch(t, []string{mg1.PublicId, mg2.PublicId}, gotIds)
+
+	_, err = repo.LookupManagedGroups(testCtx, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_LookupManagedGroupByName(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	rootWrapper := db.TestWrapper(t)
+
+	testKms := kms.TestKms(t, testConn, rootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	testCtx := context.Background()
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	mg := TestManagedGroup(t, testConn, authMethod, testGrpNames, WithName(testCtx, "named"))
+	upperMg := TestManagedGroup(t, testConn, authMethod, testGrpNames, WithName(testCtx, "Admins"))
+	TestManagedGroup(t, testConn, authMethod, testGrpNames, WithName(testCtx, "admins"))
+
+	testRepo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		authMethodId    string
+		mgName          string
+		opt             []Option
+		want            *ManagedGroup
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:            "missing-auth-method-id",
+			mgName:          "named",
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing auth method id",
+		},
+		{
+			name:            "missing-name",
+			authMethodId:    authMethod.PublicId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing name",
+		},
+		{
+			name:         "not-found",
+			authMethodId: authMethod.PublicId,
+			mgName:       "unknown",
+		},
+		{
+			name:         "found",
+			authMethodId: authMethod.PublicId,
+			mgName:       "named",
+			want:         mg,
+		},
+		{
+			name:         "not-found-different-case",
+			authMethodId: authMethod.PublicId,
+			mgName:       "NaMeD",
+		},
+		{
+			name:         "found-exact-among-case-variants",
+			authMethodId: authMethod.PublicId,
+			mgName:       "Admins",
+			want:         upperMg,
+		},
+		{
+			name:         "found-different-case-ignoring-case",
+			authMethodId: authMethod.PublicId,
+			mgName:       "NaMeD",
+			opt:          []Option{WithIgnoreNameCase(testCtx, true)},
+			want:         mg,
+		},
+		{
+			name:         "found-exact-among-case-variants-ignoring-case",
+			authMethodId: authMethod.PublicId,
+			mgName:       "Admins",
+			opt:          []Option{WithIgnoreNameCase(testCtx, true)},
+			want:         upperMg,
+		},
+		{
+			name:            "ambiguous-case-variants-ignoring-case",
+			authMethodId:    authMethod.PublicId,
+			mgName:          "ADMINS",
+			opt:             []Option{WithIgnoreNameCase(testCtx, true)},
+			wantErrMatch:    errors.T(errors.NotUnique),
+			wantErrContains: `name "ADMINS" matches 2 managed groups`,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := testRepo.LookupManagedGroupByName(testCtx, tc.authMethodId, tc.mgName, tc.opt...)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.EqualValues(tc.want, got)
+		})
+	}
+}
+
 func TestRepository_DeleteManagedGroup(t *testing.T) {
 	t.Parallel()
 	testConn, _ := db.TestSetup(t, "postgres")
@@ -448,6 +591,7 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 	authMethod := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
 
 	mg := TestManagedGroup(t, testConn, authMethod, testGrpNames)
+	versionedMg := TestManagedGroup(t, testConn, authMethod, testGrpNames)
 	newMgId, err := newManagedGroupId(testCtx)
 	require.NoError(t, err)
 
@@ -461,6 +605,7 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 		repo            *Repository
 		scopeId         string
 		in              string
+		opts            []Option
 		want            int
 		wantErrMatch    *errors.Template
 		wantErrContains string
@@ -566,6 +711,25 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 			wantErrMatch:    errors.T(errors.Unknown),
 			wantErrContains: "unable to generate oplog metadata",
 		},
+		{
+			name:            "With a different version",
+			ctx:             testCtx,
+			repo:            testRepo,
+			scopeId:         org.GetPublicId(),
+			in:              versionedMg.GetPublicId(),
+			opts:            []Option{WithVersion(testCtx, versionedMg.GetVersion()+1)},
+			wantErrMatch:    errors.T(errors.Conflict),
+			wantErrContains: fmt.Sprintf("managed group is at version %d, not %d", versionedMg.GetVersion(), versionedMg.GetVersion()+1),
+		},
+		{
+			name:    "With the current version",
+			ctx:     testCtx,
+			repo:    testRepo,
+			scopeId: org.GetPublicId(),
+			in:      versionedMg.GetPublicId(),
+			opts:    []Option{WithVersion(testCtx, versionedMg.GetVersion())},
+			want:    1,
+		},
 		{
 			name:    "With existing managed group id",
 			ctx:     testCtx,
@@ -578,7 +742,7 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			got, err := tc.repo.DeleteManagedGroup(context.Background(), tc.scopeId, tc.in)
+			got, err := tc.repo.DeleteManagedGroup(context.Background(), tc.scopeId, tc.in, tc.opts...)
 			if tc.wantErrMatch != nil {
 				assert.Truef(errors.Match(tc.wantErrMatch, err), "Unexpected error %s", err)
 				if tc.wantErrContains != "" {
@@ -783,6 +947,87 @@ func TestRepository_ListManagedGroups_Limits(t *testing.T) {
 	}
 }
 
+func TestRepository_CountManagedGroups(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testCtx := context.Background()
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	other := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap2"})
+	for i := 0; i < 3; i++ {
+		TestManagedGroup(t, testConn, am, testGrpNames)
+	}
+	TestManagedGroup(t, testConn, other, testGrpNames)
+
+	// The count isn't bounded by the repo limit
+	repo, err := NewRepository(testCtx, testRw, testRw, testKms, WithLimit(testCtx, 1))
+	require.NoError(t, err)
+
+	got, err := repo.CountManagedGroups(testCtx, am.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+
+	// The count is restricted like the list
+	TestManagedGroup(t, testConn, am, testGrpNames, WithKind(testCtx, auth.ManagedGroupKindSynced))
+	got, err = repo.CountManagedGroups(testCtx, am.GetPublicId(), WithKind(testCtx, auth.ManagedGroupKindSynced))
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	got, err = repo.CountManagedGroups(testCtx, globals.LdapAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	_, err = repo.CountManagedGroups(testCtx, "")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_CountManagedGroupMembers(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testCtx := context.Background()
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	admins := TestManagedGroup(t, testConn, am, []string{"admin"})
+	users := TestManagedGroup(t, testConn, am, []string{"users"})
+	empty := TestManagedGroup(t, testConn, am, []string{"nobody"})
+	TestAccount(t, testConn, am, "alice", WithMemberOfGroups(testCtx, "admin", "users"))
+	TestAccount(t, testConn, am, "bob", WithMemberOfGroups(testCtx, "users"))
+
+	repo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	got, err := repo.CountManagedGroupMembers(testCtx, am.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{admins.GetPublicId(): 1, users.GetPublicId(): 2, empty.GetPublicId(): 0}, got)
+
+	got, err = repo.CountManagedGroupMembers(testCtx, globals.LdapAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = repo.CountManagedGroupMembers(testCtx, "")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
 func TestRepository_UpdateManagedGroup(t *testing.T) {
 	t.Parallel()
 	testCtx := context.Background()
@@ -822,6 +1067,13 @@ func TestRepository_UpdateManagedGroup(t *testing.T) {
 		}
 	}
 
+	changeOwnerId := func(s string) func(*ManagedGroup) *ManagedGroup {
+		return func(mg *ManagedGroup) *ManagedGroup {
+			mg.OwnerId = s
+			return mg
+		}
+	}
+
 	makeNil := func() func(*ManagedGroup) *ManagedGroup {
 		return func(mg *ManagedGroup) *ManagedGroup {
 			return nil
@@ -1053,6 +1305,28 @@ func TestRepository_UpdateManagedGroup(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name:    "change-owner-id",
+			repo:    testRepo,
+			scopeId: org.GetPublicId(),
+			version: 1,
+			orig: &ManagedGroup{
+				ManagedGroup: &store.ManagedGroup{
+					Name:        "change-owner-id-test-name-repo",
+					Description: "test-description-repo",
+				},
+			},
+			chgFn: changeOwnerId("platform-team"),
+			masks: []string{OwnerIdField},
+			want: &ManagedGroup{
+				ManagedGroup: &store.ManagedGroup{
+					Name:        "change-owner-id-test-name-repo",
+					Description: "test-description-repo",
+					OwnerId:     "platform-team",
+				},
+			},
+			wantCount: 1,
+		},
 		{
 			name:    "change-name-and-description",
 			repo:    testRepo,
@@ -1219,9 +1493,100 @@ func TestRepository_UpdateManagedGroup(t *testing.T) {
 				return
 			}
 			assert.Equal(tc.want.Description, got.Description)
+			assert.Equal(tc.want.OwnerId, got.OwnerId)
 			if tc.wantCount > 0 {
 				assert.NoError(db.TestVerifyOplog(t, testRw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
 			}
 		})
 	}
 }
+
+func TestRepository_TouchManagedGroup(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testCtx := context.Background()
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	mg := TestManagedGroup(t, testConn, am, testGrpNames, WithName(testCtx, "default"))
+
+	repo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	touched, rowsUpdated, err := repo.TouchManagedGroup(testCtx, org.PublicId, mg.PublicId, mg.Version)
+	require.NoError(t, err)
+	require.Equal(t, 1, rowsUpdated)
+	assert.Equal(t, mg.Version+1, touched.Version)
+	assert.True(t, touched.GetUpdateTime().AsTime().After(mg.GetUpdateTime().AsTime()))
+
+	// Nothing else changed.
+	want := mg.clone()
+	want.Version = touched.Version
+	want.UpdateTime = touched.UpdateTime
+	assert.True(t, proto.Equal(want.ManagedGroup, touched.ManagedGroup))
+
+	// The version is checked.
+	_, rowsUpdated, err = repo.TouchManagedGroup(testCtx, org.PublicId, mg.PublicId, mg.Version)
+	require.NoError(t, err)
+	assert.Equal(t, 0, rowsUpdated)
+
+	_, _, err = repo.TouchManagedGroup(testCtx, org.PublicId, "", touched.Version)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, _, err = repo.TouchManagedGroup(testCtx, org.PublicId, mg.PublicId, 0)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, _, err = repo.TouchManagedGroup(testCtx, "", mg.PublicId, touched.Version)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_UpsertManagedGroup(t *testing.T) {
+	t.Parallel()
+	testConn, _ := db.TestSetup(t, "postgres")
+	testRw := db.New(testConn)
+	testRootWrapper := db.TestWrapper(t)
+
+	testKms := kms.TestKms(t, testConn, testRootWrapper)
+	iamRepo := iam.TestRepo(t, testConn, testRootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	testCtx := context.Background()
+	orgDbWrapper, err := testKms.GetWrapper(testCtx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	testAuthMethod := TestAuthMethod(t, testConn, orgDbWrapper, org.GetPublicId(), []string{"ldaps://ldap1"})
+
+	testRepo, err := NewRepository(testCtx, testRw, testRw, testKms)
+	require.NoError(t, err)
+
+	t.Run("missing-name", func(t *testing.T) {
+		mg, err := NewManagedGroup(testCtx, testAuthMethod.PublicId, []string{"admin"})
+		require.NoError(t, err)
+		_, _, err = testRepo.UpsertManagedGroup(testCtx, org.PublicId, mg)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	})
+
+	mg, err := NewManagedGroup(testCtx, testAuthMethod.PublicId, []string{"admin"}, WithName(testCtx, "upserted"), WithDescription(testCtx, "first"))
+	require.NoError(t, err)
+	created, wasCreated, err := testRepo.UpsertManagedGroup(testCtx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.True(t, wasCreated)
+	assert.True(t, strings.HasPrefix(created.PublicId, globals.LdapManagedGroupPrefix))
+	assert.Equal(t, uint32(1), created.Version)
+
+	mg, err = NewManagedGroup(testCtx, testAuthMethod.PublicId, []string{"admin", "users"}, WithName(testCtx, "upserted"))
+	require.NoError(t, err)
+	updated, wasCreated, err := testRepo.UpsertManagedGroup(testCtx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.Equal(t, created.PublicId, updated.PublicId)
+	assert.Equal(t, uint32(2), updated.Version)
+	assert.Equal(t, mg.GroupNames, updated.GroupNames)
+	assert.Empty(t, updated.Description)
+}
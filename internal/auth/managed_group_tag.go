@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/auth/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultManagedGroupTagTableName defines the default table name for a
+// ManagedGroupTag
+const defaultManagedGroupTagTableName = "auth_managed_group_tag"
+
+// ManagedGroupTag is a free-form key/value tag of a managed group of any
+// subtype.
+type ManagedGroupTag struct {
+	*store.ManagedGroupTag
+	tableName string
+}
+
+// NewManagedGroupTag creates a new in memory ManagedGroupTag of the managed
+// group with the provided id.
+func NewManagedGroupTag(ctx context.Context, managedGroupId, key, value string) (*ManagedGroupTag, error) {
+	const op = "auth.NewManagedGroupTag"
+	switch {
+	case managedGroupId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	case key == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing key")
+	}
+	return &ManagedGroupTag{
+		ManagedGroupTag: &store.ManagedGroupTag{
+			ManagedGroupId: managedGroupId,
+			Key:            key,
+			Value:          value,
+		},
+	}, nil
+}
+
+// AllocManagedGroupTag makes an empty one in memory
+func AllocManagedGroupTag() ManagedGroupTag {
+	return ManagedGroupTag{
+		ManagedGroupTag: &store.ManagedGroupTag{},
+	}
+}
+
+// Clone a ManagedGroupTag
+func (t *ManagedGroupTag) Clone() *ManagedGroupTag {
+	cp := proto.Clone(t.ManagedGroupTag)
+	return &ManagedGroupTag{
+		ManagedGroupTag: cp.(*store.ManagedGroupTag),
+	}
+}
+
+// TableName returns the table name.
+func (t *ManagedGroupTag) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultManagedGroupTagTableName
+}
+
+// SetTableName sets the table name.
+func (t *ManagedGroupTag) SetTableName(n string) {
+	t.tableName = n
+}
+
+// ReplaceManagedGroupTagsTx replaces the tags of the managed group with the
+// provided id with tags, within the transaction of the reader and writer.
+// Tags which are kept with the same value aren't rewritten. The tags which
+// are deleted and created are oplogged with oplogWrapper and the managed
+// group's oplog metadata, so they are replicated along with the change of the
+// managed group.
+func ReplaceManagedGroupTagsTx(ctx context.Context, reader db.Reader, w db.Writer, managedGroupId string, tags map[string]string, oplogWrapper wrapping.Wrapper, metadata oplog.Metadata) error {
+	const op = "auth.ReplaceManagedGroupTagsTx"
+	switch {
+	case managedGroupId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	case oplogWrapper == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	var existing []*ManagedGroupTag
+	if err := reader.SearchWhere(ctx, &existing, "managed_group_id = ?", []any{managedGroupId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to read tags of managed group %q", managedGroupId)))
+	}
+	kept := make(map[string]bool, len(existing))
+	deletes := make([]any, 0, len(existing))
+	for _, t := range existing {
+		if v, ok := tags[t.Key]; ok && v == t.Value {
+			kept[t.Key] = true
+			continue
+		}
+		deletes = append(deletes, t)
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		if !kept[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	creates := make([]any, 0, len(keys))
+	for _, k := range keys {
+		t, err := NewManagedGroupTag(ctx, managedGroupId, k, tags[k])
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		creates = append(creates, t)
+	}
+
+	tagMetadata := make(oplog.Metadata, len(metadata)+1)
+	for k, v := range metadata {
+		tagMetadata[k] = v
+	}
+	tagMetadata["resource-type"] = []string{"managed group tag"}
+	if len(deletes) > 0 {
+		rowsDeleted, err := w.DeleteItems(ctx, deletes, db.WithOplog(oplogWrapper, tagMetadata))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("couldn't delete existing tags for managed group %q", managedGroupId)))
+		}
+		if rowsDeleted != len(deletes) {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("tags deleted %d did not match request for %d", rowsDeleted, len(deletes)))
+		}
+	}
+	if len(creates) > 0 {
+		if err := w.CreateItems(ctx, creates, db.WithOplog(oplogWrapper, tagMetadata)); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error creating tags for managed group %q", managedGroupId)))
+		}
+	}
+	return nil
+}
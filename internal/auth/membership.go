@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// MembershipResolver computes which managed groups an identity is a member
+// of. Each auth method subtype with managed groups registers one, so
+// memberships can be computed without knowing how the subtype matches them.
+type MembershipResolver interface {
+	// ResolveMemberships returns the managed groups in mgs, all of the auth
+	// method am, which the identity is a member of. What the identity holds
+	// depends on the subtype, e.g. the claims of an OIDC login.
+	ResolveMemberships(ctx context.Context, am AuthMethod, mgs []ManagedGroup, identity map[string]any) ([]ManagedGroup, error)
+}
+
+var membershipResolvers = map[subtypes.Subtype]MembershipResolver{}
+
+// RegisterMembershipResolver registers the membership resolver of the auth
+// method subtype. It panics if one is already registered for the subtype.
+func RegisterMembershipResolver(s subtypes.Subtype, r MembershipResolver) {
+	if _, ok := membershipResolvers[s]; ok {
+		panic(fmt.Sprintf("membership resolver for subtype %q already exists", s))
+	}
+	membershipResolvers[s] = r
+}
+
+// MembershipResolverFor returns the membership resolver registered for the
+// auth method subtype, and false if there is none.
+func MembershipResolverFor(s subtypes.Subtype) (MembershipResolver, bool) {
+	r, ok := membershipResolvers[s]
+	return r, ok
+}
@@ -113,6 +113,20 @@ func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientS
 			a.AccountClaimMaps = append(a.AccountClaimMaps, fmt.Sprintf("%s=%s", k, v))
 		}
 	}
+	if len(opts.withClaimAliases) > 0 {
+		a.ClaimAliases = make([]string, 0, len(opts.withClaimAliases))
+		for k, v := range opts.withClaimAliases {
+			a.ClaimAliases = append(a.ClaimAliases, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(a.ClaimAliases)
+	}
+	if len(opts.withClaimTypes) > 0 {
+		a.ClaimTypes = make([]string, 0, len(opts.withClaimTypes))
+		for k, v := range opts.withClaimTypes {
+			a.ClaimTypes = append(a.ClaimTypes, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(a.ClaimTypes)
+	}
 	if a.OperationalState != string(InactiveState) {
 		if err := a.isComplete(ctx); err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("new auth method being created with incomplete data but non-inactive state"))
@@ -282,6 +296,8 @@ type convertedValues struct {
 	Certs            []any
 	ClaimsScopes     []any
 	AccountClaimMaps []any
+	ClaimAliases     []any
+	ClaimTypes       []any
 }
 
 // convertValueObjects converts the embedded value objects. It will return an
@@ -292,7 +308,7 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
 	var err error
-	var addAlgs, addAuds, addCerts, addScopes, addAccountClaimMaps []any
+	var addAlgs, addAuds, addCerts, addScopes, addAccountClaimMaps, addClaimAliases, addClaimTypes []any
 	if addAlgs, err = am.convertSigningAlgs(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -308,12 +324,20 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 	if addAccountClaimMaps, err = am.convertAccountClaimMaps(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if addClaimAliases, err = am.convertClaimAliases(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if addClaimTypes, err = am.convertClaimTypes(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	return &convertedValues{
 		Algs:             addAlgs,
 		Auds:             addAuds,
 		Certs:            addCerts,
 		ClaimsScopes:     addScopes,
 		AccountClaimMaps: addAccountClaimMaps,
+		ClaimAliases:     addClaimAliases,
+		ClaimTypes:       addClaimTypes,
 	}, nil
 }
 
@@ -425,6 +449,64 @@ func (am *AuthMethod) convertAccountClaimMaps(ctx context.Context) ([]any, error
 	return newInterfaces, nil
 }
 
+// convertClaimAliases converts the embedded claim aliases from []string to
+// []interface{} where each slice element is a *ClaimAlias. It will return an
+// error if the AuthMethod's public id is not set or it can't parse the claim
+// aliases.
+func (am *AuthMethod) convertClaimAliases(ctx context.Context) ([]any, error) {
+	const op = "oidc.(AuthMethod).convertClaimAliases"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	aliases, err := ParseClaimAliases(ctx, am.ClaimAliases...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	names := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	newInterfaces := make([]any, 0, len(names))
+	for _, alias := range names {
+		obj, err := NewClaimAlias(ctx, am.PublicId, alias, aliases[alias])
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertClaimTypes converts the embedded claim types from []string to
+// []interface{} where each slice element is a *ClaimType. It will return an
+// error if the AuthMethod's public id is not set or it can't parse the claim
+// types.
+func (am *AuthMethod) convertClaimTypes(ctx context.Context) ([]any, error) {
+	const op = "oidc.(AuthMethod).convertClaimTypes"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	types, err := ParseClaimTypes(ctx, am.ClaimTypes...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	selectors := make([]string, 0, len(types))
+	for selector := range types {
+		selectors = append(selectors, selector)
+	}
+	sort.Strings(selectors)
+	newInterfaces := make([]any, 0, len(selectors))
+	for _, selector := range selectors {
+		obj, err := NewClaimType(ctx, am.PublicId, selector, types[selector])
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
 // ClaimMap defines the To and From of an oidc claim map
 type ClaimMap struct {
 	To   string
@@ -591,6 +591,22 @@ func Test_convertValueObjects(t *testing.T) {
 		testAccountClaimMaps = append(testAccountClaimMaps, obj)
 	}
 
+	testAliases := []string{"groups=/token/groups", "email=/userinfo/email"}
+	var testClaimAliases []any
+	for _, a := range [][2]string{{"email", "/userinfo/email"}, {"groups", "/token/groups"}} {
+		obj, err := NewClaimAlias(ctx, testPublicId, a[0], a[1])
+		require.NoError(t, err)
+		testClaimAliases = append(testClaimAliases, obj)
+	}
+
+	testTypes := []string{"/token/groups=list", "/userinfo/email_verified=bool"}
+	var testClaimTypes []any
+	for _, ct := range [][2]string{{"/token/groups", "list"}, {"/userinfo/email_verified", "bool"}} {
+		obj, err := NewClaimType(ctx, testPublicId, ct[0], ct[1])
+		require.NoError(t, err)
+		testClaimTypes = append(testClaimTypes, obj)
+	}
+
 	tests := []struct {
 		name            string
 		authMethodId    string
@@ -599,6 +615,8 @@ func Test_convertValueObjects(t *testing.T) {
 		certs           []string
 		scopes          []string
 		maps            []string
+		aliases         []string
+		types           []string
 		wantValues      *convertedValues
 		wantErrMatch    *errors.Template
 		wantErrContains string
@@ -611,12 +629,16 @@ func Test_convertValueObjects(t *testing.T) {
 			certs:        testCerts,
 			scopes:       testScopes,
 			maps:         testClaimMaps,
+			aliases:      testAliases,
+			types:        testTypes,
 			wantValues: &convertedValues{
 				Algs:             testSigningAlgs,
 				Auds:             testAudiences,
 				Certs:            testCertificates,
 				ClaimsScopes:     testClaimsScopes,
 				AccountClaimMaps: testAccountClaimMaps,
+				ClaimAliases:     testClaimAliases,
+				ClaimTypes:       testClaimTypes,
 			},
 		},
 		{
@@ -636,6 +658,8 @@ func Test_convertValueObjects(t *testing.T) {
 					Certificates:     tt.certs,
 					ClaimsScopes:     tt.scopes,
 					AccountClaimMaps: tt.maps,
+					ClaimAliases:     tt.aliases,
+					ClaimTypes:       tt.types,
 				},
 			}
 
@@ -693,6 +717,22 @@ func Test_convertValueObjects(t *testing.T) {
 				assert.Equal(want, got)
 			}
 
+			convertedAliases, err := am.convertClaimAliases(ctx)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "wanted err %q and got: %+v", tt.wantErrMatch.Code, err)
+			} else {
+				assert.Equal(tt.wantValues.ClaimAliases, convertedAliases)
+			}
+
+			convertedTypes, err := am.convertClaimTypes(ctx)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "wanted err %q and got: %+v", tt.wantErrMatch.Code, err)
+			} else {
+				assert.Equal(tt.wantValues.ClaimTypes, convertedTypes)
+			}
+
 			values, err := am.convertValueObjects(ctx)
 			if tt.wantErrMatch != nil {
 				require.Error(err)
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr/grammar"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultClaimAliasTableName defines the default table name for a ClaimAlias
+	defaultClaimAliasTableName = "auth_oidc_claim_alias"
+
+	// maxClaimAliasSelectorLength is the exclusive upper bound on the length
+	// of the selector of a ClaimAlias.
+	maxClaimAliasSelectorLength = 1024
+)
+
+// claimAliasRe matches the names of claim aliases, which filters reference
+// with a leading @.
+var claimAliasRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,127}$`)
+
+// ClaimAlias defines an optional named alias for a selector, which the managed
+// group filters of an OIDC auth method can reference as @alias instead of
+// repeating the selector.
+type ClaimAlias struct {
+	*store.ClaimAlias
+	tableName string
+}
+
+// NewClaimAlias creates a new in memory ClaimAlias of the auth method which
+// expands alias to the selector, a JSON pointer such as "/token/groups".
+func NewClaimAlias(ctx context.Context, authMethodId, alias, selector string) (*ClaimAlias, error) {
+	const op = "oidc.NewClaimAlias"
+	ca := &ClaimAlias{
+		ClaimAlias: &store.ClaimAlias{
+			OidcMethodId: authMethodId,
+			Alias:        alias,
+			Selector:     selector,
+		},
+	}
+	if err := ca.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return ca, nil
+}
+
+// validate the ClaimAlias.  On success, it will return nil.
+func (ca *ClaimAlias) validate(ctx context.Context, caller errors.Op) error {
+	if ca.OidcMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing oidc auth method id")
+	}
+	return validateClaimAlias(ctx, caller, ca.Alias, ca.Selector)
+}
+
+func validateClaimAlias(ctx context.Context, caller errors.Op, alias, selector string) error {
+	if !claimAliasRe.MatchString(alias) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid claim alias %q", alias))
+	}
+	if !validClaimAliasSelector(selector) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("claim alias %s selector %q is not a JSON pointer", alias, selector))
+	}
+	return nil
+}
+
+// validClaimAliasSelector reports whether s can be the selector of a
+// ClaimAlias. The selectors of an auth method are read joined by "|" so it
+// can't be part of one.
+func validClaimAliasSelector(s string) bool {
+	return ValidSelectorPrefix(s) && !strings.Contains(s, "|") && len(s) < maxClaimAliasSelectorLength
+}
+
+// AllocClaimAlias makes an empty one in memory
+func AllocClaimAlias() ClaimAlias {
+	return ClaimAlias{
+		ClaimAlias: &store.ClaimAlias{},
+	}
+}
+
+// Clone a ClaimAlias
+func (ca *ClaimAlias) Clone() *ClaimAlias {
+	cp := proto.Clone(ca.ClaimAlias)
+	return &ClaimAlias{
+		ClaimAlias: cp.(*store.ClaimAlias),
+	}
+}
+
+// TableName returns the table name.
+func (ca *ClaimAlias) TableName() string {
+	if ca.tableName != "" {
+		return ca.tableName
+	}
+	return defaultClaimAliasTableName
+}
+
+// SetTableName sets the table name.
+func (ca *ClaimAlias) SetTableName(n string) {
+	ca.tableName = n
+}
+
+// ParseClaimAliases parses claim aliases written as alias=selector, the form
+// they are held in by AuthMethod.ClaimAliases, into a map from each alias to
+// its selector.
+func ParseClaimAliases(ctx context.Context, a ...string) (map[string]string, error) {
+	const op = "oidc.ParseClaimAliases"
+	aliases := make(map[string]string, len(a))
+	for _, s := range a {
+		alias, selector, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim alias %q is not of the form alias=selector", s))
+		}
+		if _, dup := aliases[alias]; dup {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim alias %s is defined more than once", alias))
+		}
+		if err := validateClaimAlias(ctx, op, alias, selector); err != nil {
+			return nil, err
+		}
+		aliases[alias] = selector
+	}
+	return aliases, nil
+}
+
+// ClaimAliasReferences returns the sorted names of the claim aliases the
+// managed group filter references, without their leading @.
+func ClaimAliasReferences(filter string) []string {
+	seen := map[string]bool{}
+	_, _ = rewriteClaimAliases(filter, func(alias string) (string, bool) {
+		seen[alias] = true
+		return "/" + alias, true
+	})
+	refs := make([]string, 0, len(seen))
+	for alias := range seen {
+		refs = append(refs, alias)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// ExpandClaimAliases returns the managed group filter with every @alias it
+// references replaced by the quoted selector the alias expands to, so it can
+// be compiled with bexpr. Text within quoted values is never expanded. An
+// error with the errors.InvalidParameter code is returned if the filter
+// references an alias which isn't defined.
+//
+// Filters are expanded the same way when they are validated and when they
+// are evaluated at login.
+func ExpandClaimAliases(ctx context.Context, filter string, aliases map[string]string) (string, error) {
+	const op = "oidc.ExpandClaimAliases"
+	out, undefined := rewriteClaimAliases(filter, func(alias string) (string, bool) {
+		selector, ok := aliases[alias]
+		return selector, ok
+	})
+	if len(undefined) > 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("undefined claim alias %s", "@"+strings.Join(undefined, ", @")))
+	}
+	return out, nil
+}
+
+// CompilableFilter returns the managed group filter with every alias it
+// references replaced by a placeholder selector, so the rest of the filter can
+// be checked with ManagedGroupFilterEvaluator without knowing what the aliases
+// expand to.
+func CompilableFilter(filter string) string {
+	out, _ := rewriteClaimAliases(filter, func(alias string) (string, bool) {
+		return "/" + alias, true
+	})
+	return out
+}
+
+// claimAliasPlaceholders returns the aliases the managed group filter
+// references, keyed by name, so the selectors CompilableFilter puts in their
+// place can be told apart with isClaimAliasPlaceholder.
+func claimAliasPlaceholders(filter string) map[string]bool {
+	refs := map[string]bool{}
+	for _, alias := range ClaimAliasReferences(filter) {
+		refs[alias] = true
+	}
+	return refs
+}
+
+// isClaimAliasPlaceholder reports whether the selector is the placeholder
+// CompilableFilter put in place of one of the aliases.
+func isClaimAliasPlaceholder(sel grammar.Selector, aliases map[string]bool) bool {
+	return sel.Type == grammar.SelectorTypeJsonPointer && len(sel.Path) == 1 && aliases[sel.Path[0]]
+}
+
+// rewriteClaimAliases replaces each @alias outside of quoted values in filter
+// with the quoted selector returned by lookup. It returns the rewritten filter
+// and the aliases lookup didn't find, in the order they are referenced.
+//
+// The selector is quoted with strconv.Quote, so a selector containing a quote
+// can't end the quoted selector early and add to the expression. Since bexpr
+// JSON pointer selectors have no escapes, such a selector makes the filter
+// fail to parse instead.
+func rewriteClaimAliases(filter string, lookup func(alias string) (string, bool)) (string, []string) {
+	if !strings.Contains(filter, "@") {
+		return filter, nil
+	}
+	var b strings.Builder
+	var undefined []string
+	seen := map[string]bool{}
+	for i := 0; i < len(filter); {
+		switch c := filter[i]; c {
+		case '"', '`':
+			// Copy the quoted value as is, up to and including its closing
+			// quote. Only double quoted values have escapes.
+			j := i + 1
+			for j < len(filter) && filter[j] != c {
+				if c == '"' && filter[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(filter) {
+				j++
+			}
+			if j > len(filter) {
+				// The filter ends with an escape.
+				j = len(filter)
+			}
+			b.WriteString(filter[i:j])
+			i = j
+		case '@':
+			j := i + 1
+			for j < len(filter) && isClaimAliasByte(filter[j], j == i+1) {
+				j++
+			}
+			if j == i+1 {
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			alias := filter[i+1 : j]
+			selector, ok := lookup(alias)
+			if !ok && !seen[alias] {
+				undefined = append(undefined, alias)
+				seen[alias] = true
+			}
+			b.WriteString(strconv.Quote(selector))
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), undefined
+}
+
+func isClaimAliasByte(c byte, first bool) bool {
+	switch {
+	case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+	case '0' <= c && c <= '9':
+		return !first
+	}
+	return false
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimAlias_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, InactiveState, "alice_rp", "my-dogs-name",
+		WithIssuer(TestConvertToUrls(t, "https://alice.com")[0]), WithApiUrl(TestConvertToUrls(t, "https://api.com")[0]))
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		alias        string
+		selector     string
+		want         *ClaimAlias
+		wantErr      bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "valid",
+			authMethodId: testAuthMethod.PublicId,
+			alias:        "groups",
+			selector:     "/token/groups",
+			want: func() *ClaimAlias {
+				want := AllocClaimAlias()
+				want.OidcMethodId = testAuthMethod.PublicId
+				want.Alias = "groups"
+				want.Selector = "/token/groups"
+				return &want
+			}(),
+		},
+		{
+			name:      "missing-auth-method-id",
+			alias:     "groups",
+			selector:  "/token/groups",
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:         "invalid-alias",
+			authMethodId: testAuthMethod.PublicId,
+			alias:        "1groups",
+			selector:     "/token/groups",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "invalid-selector",
+			authMethodId: testAuthMethod.PublicId,
+			alias:        "groups",
+			selector:     "token.groups",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "selector-with-delimiter",
+			authMethodId: testAuthMethod.PublicId,
+			alias:        "groups",
+			selector:     "/token/a|b",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewClaimAlias(ctx, tt.authMethodId, tt.alias, tt.selector)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			require.NoError(rw.Create(ctx, got))
+
+			found := AllocClaimAlias()
+			require.NoError(rw.LookupWhere(ctx, &found, "oidc_method_id = ? and alias = ?", []any{tt.authMethodId, tt.alias}))
+			assert.Equal(got.Selector, found.Selector)
+
+			// a second alias with the same name can't be added to the auth method
+			dup, err := NewClaimAlias(ctx, tt.authMethodId, tt.alias, "/userinfo/groups")
+			require.NoError(err)
+			require.Error(rw.Create(ctx, dup))
+		})
+	}
+}
+
+func TestClaimAlias_Clone(t *testing.T) {
+	t.Parallel()
+	ca := &ClaimAlias{ClaimAlias: &store.ClaimAlias{OidcMethodId: "amoidc_1234567890", Alias: "groups", Selector: "/token/groups"}}
+	cp := ca.Clone()
+	assert.Equal(t, ca.ClaimAlias, cp.ClaimAlias)
+	cp.Selector = "/userinfo/groups"
+	assert.Equal(t, "/token/groups", ca.Selector)
+}
+
+func TestParseClaimAliases(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	tests := []struct {
+		name    string
+		in      []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			in:   []string{"groups=/token/groups", "email_verified=/userinfo/email_verified"},
+			want: map[string]string{"groups": "/token/groups", "email_verified": "/userinfo/email_verified"},
+		},
+		{
+			name: "none",
+			want: map[string]string{},
+		},
+		{
+			name:    "missing-selector",
+			in:      []string{"groups"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate",
+			in:      []string{"groups=/token/groups", "groups=/userinfo/groups"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid-alias",
+			in:      []string{"my-groups=/token/groups"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid-selector",
+			in:      []string{"groups=token.groups"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ParseClaimAliases(ctx, tt.in...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestExpandClaimAliases(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	aliases := map[string]string{
+		"groups": "/token/groups",
+		"email":  "/userinfo/email",
+	}
+	tests := []struct {
+		name     string
+		filter   string
+		want     string
+		wantRefs []string
+		wantErr  string
+	}{
+		{
+			name:     "no-aliases",
+			filter:   `"/token/sub" == "alice"`,
+			want:     `"/token/sub" == "alice"`,
+			wantRefs: []string{},
+		},
+		{
+			name:     "alias",
+			filter:   `@groups contains "admin"`,
+			want:     `"/token/groups" contains "admin"`,
+			wantRefs: []string{"groups"},
+		},
+		{
+			name:     "several-aliases",
+			filter:   `@groups contains "admin" and @email matches ".*@example.com" and "dev" in @groups`,
+			want:     `"/token/groups" contains "admin" and "/userinfo/email" matches ".*@example.com" and "dev" in "/token/groups"`,
+			wantRefs: []string{"email", "groups"},
+		},
+		{
+			name:     "quoted-values-not-expanded",
+			filter:   `"/token/email" == "@groups" and "/token/note" == "a \\ @groups" and "/token/raw" == ` + "`@email`",
+			want:     `"/token/email" == "@groups" and "/token/note" == "a \\ @groups" and "/token/raw" == ` + "`@email`",
+			wantRefs: []string{},
+		},
+		{
+			name:     "undefined",
+			filter:   `@groups contains "admin" and @roles contains "admin" and @teams contains "x" and @roles contains "y"`,
+			wantRefs: []string{"groups", "roles", "teams"},
+			wantErr:  "undefined claim alias @roles, @teams",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			assert.Equal(tt.wantRefs, ClaimAliasReferences(tt.filter))
+			_, err := ManagedGroupFilterEvaluator(CompilableFilter(tt.filter))
+			require.NoError(err)
+
+			got, err := ExpandClaimAliases(ctx, tt.filter, aliases)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			_, err = ManagedGroupFilterEvaluator(got)
+			require.NoError(err)
+		})
+	}
+
+	t.Run("selector-is-quoted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := ExpandClaimAliases(ctx, `@groups contains "admin"`, map[string]string{
+			"groups": `/token/groups" or "/token/sub" != "`,
+		})
+		require.NoError(err)
+		assert.Equal(`"/token/groups\" or \"/token/sub\" != \"" contains "admin"`, got)
+		_, err = ManagedGroupFilterEvaluator(got)
+		require.Error(err)
+	})
+}
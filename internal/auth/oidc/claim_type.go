@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr/grammar"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultClaimTypeTableName defines the default table name for a ClaimType
+const defaultClaimTypeTableName = "auth_oidc_claim_type"
+
+// The types a claim can be declared as.
+const (
+	ClaimTypeString = "string"
+	ClaimTypeNumber = "number"
+	ClaimTypeBool   = "bool"
+	ClaimTypeList   = "list"
+)
+
+// ClaimType defines the optional type of a claim, so the managed group
+// filters of an OIDC auth method which compare the claim in a way that never
+// matches a claim of its type can be rejected.
+type ClaimType struct {
+	*store.ClaimType
+	tableName string
+}
+
+// NewClaimType creates a new in memory ClaimType of the auth method which
+// declares the claim at the selector, a JSON pointer such as "/token/groups",
+// to be of the claimType.
+func NewClaimType(ctx context.Context, authMethodId, selector, claimType string) (*ClaimType, error) {
+	const op = "oidc.NewClaimType"
+	ct := &ClaimType{
+		ClaimType: &store.ClaimType{
+			OidcMethodId: authMethodId,
+			Selector:     selector,
+			ClaimType:    claimType,
+		},
+	}
+	if err := ct.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return ct, nil
+}
+
+// validate the ClaimType.  On success, it will return nil.
+func (ct *ClaimType) validate(ctx context.Context, caller errors.Op) error {
+	if ct.OidcMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing oidc auth method id")
+	}
+	return validateClaimType(ctx, caller, ct.Selector, ct.ClaimType.ClaimType)
+}
+
+func validateClaimType(ctx context.Context, caller errors.Op, selector, claimType string) error {
+	// Selectors are read joined by "|" in the same way as those of claim
+	// aliases.
+	if !validClaimAliasSelector(selector) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("claim type selector %q is not a JSON pointer", selector))
+	}
+	switch claimType {
+	case ClaimTypeString, ClaimTypeNumber, ClaimTypeBool, ClaimTypeList:
+	default:
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("claim %s has unsupported type %q, must be %q, %q, %q or %q", selector, claimType, ClaimTypeString, ClaimTypeNumber, ClaimTypeBool, ClaimTypeList))
+	}
+	return nil
+}
+
+// AllocClaimType makes an empty one in memory
+func AllocClaimType() ClaimType {
+	return ClaimType{
+		ClaimType: &store.ClaimType{},
+	}
+}
+
+// Clone a ClaimType
+func (ct *ClaimType) Clone() *ClaimType {
+	cp := proto.Clone(ct.ClaimType)
+	return &ClaimType{
+		ClaimType: cp.(*store.ClaimType),
+	}
+}
+
+// TableName returns the table name.
+func (ct *ClaimType) TableName() string {
+	if ct.tableName != "" {
+		return ct.tableName
+	}
+	return defaultClaimTypeTableName
+}
+
+// SetTableName sets the table name.
+func (ct *ClaimType) SetTableName(n string) {
+	ct.tableName = n
+}
+
+// ParseClaimTypes parses claim types written as selector=type, the form they
+// are held in by AuthMethod.ClaimTypes, into a map from each selector to its
+// type.
+func ParseClaimTypes(ctx context.Context, t ...string) (map[string]string, error) {
+	const op = "oidc.ParseClaimTypes"
+	types := make(map[string]string, len(t))
+	for _, s := range t {
+		selector, claimType, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim type %q is not of the form selector=type", s))
+		}
+		if _, dup := types[selector]; dup {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim %s is given a type more than once", selector))
+		}
+		if err := validateClaimType(ctx, op, selector, claimType); err != nil {
+			return nil, err
+		}
+		types[selector] = claimType
+	}
+	return types, nil
+}
+
+// ClaimTypeMismatches returns why the match expressions of the managed group
+// filter never match the claims they reference, given the types the claims
+// are declared as. A list claim can't be compared with "==" or "matches", a
+// number or bool claim can only be compared with "==" or "!=" to a value of
+// its type, and claims without a declared type are never reported. An error
+// with the errors.InvalidParameter code is returned if the filter can't be
+// parsed.
+//
+// Options supported:
+//
+// * WithClaimAliases: the claim aliases the filter's @alias references are
+// expanded with before its selectors are compared to the declared ones.
+func ClaimTypeMismatches(ctx context.Context, filter string, types map[string]string, opt ...Option) ([]string, error) {
+	const op = "oidc.ClaimTypeMismatches"
+	if len(types) == 0 {
+		return nil, nil
+	}
+	opts := getOpts(opt...)
+	expanded, err := ExpandClaimAliases(ctx, filter, opts.withClaimAliases)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// Filters can write a selector as a JSON pointer or with dots, so they're
+	// compared by their parts.
+	declared := make(map[string]string, len(types))
+	for selector, claimType := range types {
+		ptr, err := pointerstructure.Parse(selector)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		declared[strings.Join(ptr.Parts, "\x00")] = claimType
+	}
+	ast, err := grammar.Parse("", []byte(expanded))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	var mismatches []string
+	var walk func(grammar.Expression)
+	walk = func(e grammar.Expression) {
+		switch e := e.(type) {
+		case *grammar.UnaryExpression:
+			walk(e.Operand)
+		case *grammar.BinaryExpression:
+			walk(e.Left)
+			walk(e.Right)
+		case *grammar.MatchExpression:
+			claimType, ok := declared[strings.Join(e.Selector.Path, "\x00")]
+			if !ok {
+				return
+			}
+			if msg := claimTypeMismatch(e, claimType); msg != "" {
+				mismatches = append(mismatches, msg)
+			}
+		}
+	}
+	if e, ok := ast.(grammar.Expression); ok {
+		walk(e)
+	}
+	return mismatches, nil
+}
+
+// claimTypeMismatch returns why the match expression never matches a claim
+// of the claimType, or "" if it may.
+func claimTypeMismatch(m *grammar.MatchExpression, claimType string) string {
+	sel, op := selectorText(m.Selector), operatorText(m.Operator)
+	switch claimType {
+	case ClaimTypeList:
+		switch m.Operator {
+		case grammar.MatchEqual:
+			return fmt.Sprintf("Claim %q is a list; use \"contains\" rather than %q.", sel, op)
+		case grammar.MatchNotEqual:
+			return fmt.Sprintf("Claim %q is a list; use \"not contains\" rather than %q.", sel, op)
+		case grammar.MatchMatches, grammar.MatchNotMatches:
+			return fmt.Sprintf("Claim %q is a list and can't be compared with %q.", sel, op)
+		}
+	case ClaimTypeNumber, ClaimTypeBool:
+		switch m.Operator {
+		case grammar.MatchEqual, grammar.MatchNotEqual:
+			var err error
+			if claimType == ClaimTypeNumber {
+				_, err = strconv.ParseFloat(m.Value.Raw, 64)
+			} else {
+				_, err = strconv.ParseBool(m.Value.Raw)
+			}
+			if err != nil {
+				return fmt.Sprintf("Claim %q is a %s but %q isn't one.", sel, claimType, m.Value.Raw)
+			}
+		default:
+			return fmt.Sprintf("Claim %q is a %s and can't be compared with %q.", sel, claimType, op)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimType_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, InactiveState, "alice_rp", "my-dogs-name",
+		WithIssuer(TestConvertToUrls(t, "https://alice.com")[0]), WithApiUrl(TestConvertToUrls(t, "https://api.com")[0]))
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		selector     string
+		claimType    string
+		want         *ClaimType
+		wantErr      bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "valid",
+			authMethodId: testAuthMethod.PublicId,
+			selector:     "/token/groups",
+			claimType:    ClaimTypeList,
+			want: func() *ClaimType {
+				want := AllocClaimType()
+				want.OidcMethodId = testAuthMethod.PublicId
+				want.Selector = "/token/groups"
+				want.ClaimType.ClaimType = ClaimTypeList
+				return &want
+			}(),
+		},
+		{
+			name:      "missing-auth-method-id",
+			selector:  "/token/groups",
+			claimType: ClaimTypeList,
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:         "invalid-selector",
+			authMethodId: testAuthMethod.PublicId,
+			selector:     "token.groups",
+			claimType:    ClaimTypeList,
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "unsupported-type",
+			authMethodId: testAuthMethod.PublicId,
+			selector:     "/token/groups",
+			claimType:    "array",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewClaimType(ctx, tt.authMethodId, tt.selector, tt.claimType)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			require.NoError(rw.Create(ctx, got))
+
+			found := AllocClaimType()
+			require.NoError(rw.LookupWhere(ctx, &found, "oidc_method_id = ? and selector = ?", []any{tt.authMethodId, tt.selector}))
+			assert.Equal(got.ClaimType.ClaimType, found.ClaimType.ClaimType)
+
+			// a claim can't be given a second type on the auth method
+			dup, err := NewClaimType(ctx, tt.authMethodId, tt.selector, ClaimTypeString)
+			require.NoError(err)
+			require.Error(rw.Create(ctx, dup))
+		})
+	}
+}
+
+func TestClaimType_Clone(t *testing.T) {
+	t.Parallel()
+	ct := &ClaimType{ClaimType: &store.ClaimType{OidcMethodId: "amoidc_1234567890", Selector: "/token/groups", ClaimType: ClaimTypeList}}
+	cp := ct.Clone()
+	assert.Equal(t, ct.ClaimType, cp.ClaimType)
+	cp.Selector = "/userinfo/groups"
+	assert.Equal(t, "/token/groups", ct.Selector)
+}
+
+func TestParseClaimTypes(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	tests := []struct {
+		name    string
+		in      []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			in:   []string{"/token/groups=list", "/userinfo/email_verified=bool"},
+			want: map[string]string{"/token/groups": ClaimTypeList, "/userinfo/email_verified": ClaimTypeBool},
+		},
+		{
+			name: "none",
+			want: map[string]string{},
+		},
+		{
+			name:    "missing-type",
+			in:      []string{"/token/groups"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate",
+			in:      []string{"/token/groups=list", "/token/groups=string"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported-type",
+			in:      []string{"/token/groups=array"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid-selector",
+			in:      []string{"token.groups=list"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ParseClaimTypes(ctx, tt.in...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestClaimTypeMismatches(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	types := map[string]string{
+		"/token/groups":            ClaimTypeList,
+		"/token/age":               ClaimTypeNumber,
+		"/userinfo/email_verified": ClaimTypeBool,
+		"/token/email":             ClaimTypeString,
+	}
+	tests := []struct {
+		name    string
+		filter  string
+		opt     []Option
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "list-contains",
+			filter: `"admin" in "/token/groups"`,
+		},
+		{
+			name:   "list-equal",
+			filter: `"/token/groups" == "admin"`,
+			want:   []string{`Claim "/token/groups" is a list; use "contains" rather than "==".`},
+		},
+		{
+			name:   "list-not-equal-dotted",
+			filter: `token.groups != "admin"`,
+			want:   []string{`Claim "token.groups" is a list; use "not contains" rather than "!=".`},
+		},
+		{
+			name:   "list-matches",
+			filter: `"/token/groups" matches "adm.*"`,
+			want:   []string{`Claim "/token/groups" is a list and can't be compared with "matches".`},
+		},
+		{
+			name:   "number-equal",
+			filter: `"/token/age" == 42`,
+		},
+		{
+			name:   "number-not-a-number",
+			filter: `"/token/age" == "old"`,
+			want:   []string{`Claim "/token/age" is a number but "old" isn't one.`},
+		},
+		{
+			name:   "bool-contains",
+			filter: `"/userinfo/email_verified" contains "true"`,
+			want:   []string{`Claim "/userinfo/email_verified" is a bool and can't be compared with "contains".`},
+		},
+		{
+			name:   "string-anything",
+			filter: `"/token/email" matches ".*@example.com" and "/token/email" != "bob@example.com"`,
+		},
+		{
+			name:   "undeclared",
+			filter: `"/token/roles" == "admin"`,
+		},
+		{
+			name:   "several",
+			filter: `"/token/groups" == "admin" or not ("/token/age" == "old")`,
+			want: []string{
+				`Claim "/token/groups" is a list; use "contains" rather than "==".`,
+				`Claim "/token/age" is a number but "old" isn't one.`,
+			},
+		},
+		{
+			name:   "alias",
+			filter: `@groups == "admin"`,
+			opt:    []Option{WithClaimAliases(map[string]string{"groups": "/token/groups"})},
+			want:   []string{`Claim "/token/groups" is a list; use "contains" rather than "==".`},
+		},
+		{
+			name:    "invalid-filter",
+			filter:  `"/token/groups" ==`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ClaimTypeMismatches(ctx, tt.filter, types, tt.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
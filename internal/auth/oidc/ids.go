@@ -58,3 +58,12 @@ func newManagedGroupId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+func newManagedGroupTemplateId(ctx context.Context) (string, error) {
+	const op = "oidc.newManagedGroupTemplateId"
+	id, err := db.NewPublicId(ctx, globals.OidcManagedGroupTemplatePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
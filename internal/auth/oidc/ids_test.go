@@ -26,4 +26,9 @@ func Test_Ids(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, globals.OidcAccountPrefix+"_"))
 	})
+	t.Run(globals.OidcManagedGroupTemplatePrefix, func(t *testing.T) {
+		id, err := newManagedGroupTemplateId(ctx)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, globals.OidcManagedGroupTemplatePrefix+"_"))
+	})
 }
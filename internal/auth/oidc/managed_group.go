@@ -6,10 +6,10 @@ package oidc
 import (
 	"context"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog"
-	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -24,16 +24,21 @@ type ManagedGroup struct {
 }
 
 // NewManagedGroup creates a new in memory ManagedGroup assigned to OIDC
-// AuthMethod. Supported options are withName and withDescription.
+// AuthMethod. Supported options are WithName, WithDescription, WithDisabled,
+// WithMatchCaseInsensitive, WithKind and WithOwnerId.
 func NewManagedGroup(ctx context.Context, authMethodId string, filter string, opt ...Option) (*ManagedGroup, error) {
 	const op = "oidc.NewManagedGroup"
 	opts := getOpts(opt...)
 	mg := &ManagedGroup{
 		ManagedGroup: &store.ManagedGroup{
-			AuthMethodId: authMethodId,
-			Name:         opts.withName,
-			Description:  opts.withDescription,
-			Filter:       filter,
+			AuthMethodId:         authMethodId,
+			Name:                 opts.withName,
+			Description:          opts.withDescription,
+			Filter:               filter,
+			Disabled:             opts.withDisabled,
+			MatchCaseInsensitive: opts.withMatchCaseInsensitive,
+			Kind:                 opts.withKind,
+			OwnerId:              opts.withOwnerId,
 		},
 	}
 	if err := mg.validate(ctx, op); err != nil {
@@ -51,13 +56,19 @@ func (mg *ManagedGroup) validate(ctx context.Context, caller errors.Op) error {
 	if mg.Filter == "" {
 		return errors.New(ctx, errors.InvalidParameter, caller, "missing filter")
 	}
-	if _, err := bexpr.CreateEvaluator(mg.Filter); err != nil {
+	if _, err := ManagedGroupFilterEvaluator(CompilableFilter(mg.Filter)); err != nil {
 		return errors.New(ctx, errors.InvalidParameter, caller, "error evaluating filter expression", errors.WithWrap(err))
 	}
 
 	return nil
 }
 
+// keepsMembers reports whether the members of the managed group are left as
+// they are at login rather than being matched against its filter.
+func (mg *ManagedGroup) keepsMembers() bool {
+	return mg.GetFrozen() || mg.GetKind() == auth.ManagedGroupKindSynced
+}
+
 // AllocManagedGroup makes an empty one in memory
 func AllocManagedGroup() *ManagedGroup {
 	return &ManagedGroup{
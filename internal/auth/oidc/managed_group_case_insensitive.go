@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// bexprIdentifierRe matches the parts of a selector bexpr reads after a dot.
+var bexprIdentifierRe = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9_/]*|[0-9]+)$`)
+
+// CaseInsensitiveFilter returns the managed group filter rewritten to match
+// claims whose string values have been lowercased by lowerStringValues: the
+// values the filter compares claims to are lowercased, and the patterns of
+// matches operators are made case-insensitive. Selectors are left as is, since
+// the names of claims are still matched exactly.
+//
+// The filter is parsed with the bexpr grammar and formatted again once its
+// values are folded, so the result is equivalent but not necessarily written
+// the same way. A filter which can't be parsed is returned as is, for bexpr to
+// reject.
+func CaseInsensitiveFilter(filter string) string {
+	ast, err := grammar.Parse("", []byte(filter))
+	if err != nil {
+		return filter
+	}
+	e, ok := ast.(grammar.Expression)
+	if !ok {
+		return filter
+	}
+	walkMatchExpressions(e, func(m *grammar.MatchExpression) {
+		if m.Value == nil {
+			return
+		}
+		switch m.Operator {
+		case grammar.MatchMatches, grammar.MatchNotMatches:
+			m.Value.Raw = "(?i)" + m.Value.Raw
+		default:
+			m.Value.Raw = strings.ToLower(m.Value.Raw)
+		}
+	})
+	return formatExpression(e)
+}
+
+// lowerStringValues returns a copy of the claims in v with every string value
+// lowercased. The keys of objects aren't changed.
+func lowerStringValues(v any) any {
+	switch v := v.(type) {
+	case string:
+		return strings.ToLower(v)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = lowerStringValues(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = lowerStringValues(e)
+		}
+		return out
+	case []string:
+		out := make([]string, len(v))
+		for i, e := range v {
+			out[i] = strings.ToLower(e)
+		}
+		return out
+	}
+	return v
+}
+
+// formatExpression returns a filter bexpr parses to the expression e. Binary
+// and negated expressions are parenthesized, and values are always quoted.
+func formatExpression(e grammar.Expression) string {
+	switch e := e.(type) {
+	case *grammar.UnaryExpression:
+		return "not (" + formatExpression(e.Operand) + ")"
+	case *grammar.BinaryExpression:
+		op := " and "
+		if e.Operator == grammar.BinaryOpOr {
+			op = " or "
+		}
+		return "(" + formatExpression(e.Left) + op + formatExpression(e.Right) + ")"
+	case *grammar.MatchExpression:
+		sel := formatSelector(e.Selector)
+		var value string
+		if e.Value != nil {
+			value = formatValue(e.Value.Raw)
+		}
+		switch e.Operator {
+		case grammar.MatchEqual:
+			return sel + " == " + value
+		case grammar.MatchNotEqual:
+			return sel + " != " + value
+		case grammar.MatchIn:
+			return value + " in " + sel
+		case grammar.MatchNotIn:
+			return value + " not in " + sel
+		case grammar.MatchIsEmpty:
+			return sel + " is empty"
+		case grammar.MatchIsNotEmpty:
+			return sel + " is not empty"
+		case grammar.MatchMatches:
+			return sel + " matches " + value
+		case grammar.MatchNotMatches:
+			return sel + " not matches " + value
+		}
+	}
+	return ""
+}
+
+// formatSelector returns the selector written the way it was parsed: as a
+// quoted JSON pointer or in bexpr's dotted form, with the parts which can't
+// follow a dot as index expressions.
+func formatSelector(sel grammar.Selector) string {
+	if sel.Type == grammar.SelectorTypeJsonPointer {
+		return strconv.Quote((&pointerstructure.Pointer{Parts: sel.Path}).String())
+	}
+	var b strings.Builder
+	for i, p := range sel.Path {
+		switch {
+		case i == 0:
+			b.WriteString(p)
+		case bexprIdentifierRe.MatchString(p):
+			b.WriteString("." + p)
+		default:
+			b.WriteString("[" + strconv.Quote(p) + "]")
+		}
+	}
+	return b.String()
+}
+
+// formatValue returns the value quoted so bexpr reads it as a string rather
+// than as a selector, which it would if it were a double quoted JSON pointer.
+func formatValue(v string) string {
+	if !strings.Contains(v, "`") {
+		return "`" + v + "`"
+	}
+	// A JSON pointer never contains a backtick.
+	return strconv.Quote(v)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaseInsensitiveFilter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		filter  string
+		want    string
+		invalid bool
+	}{
+		{
+			filter: `"/token/Groups" contains "Admins"`,
+			want:   "`admins` in \"/token/Groups\"",
+		},
+		{
+			filter: `"Admins" in "/token/Groups"`,
+			want:   "`admins` in \"/token/Groups\"",
+		},
+		{
+			filter: `"Admins"   not in "/token/groups" or "/token/sub"!="Bob"`,
+			want:   "(`admins` not in \"/token/groups\" or \"/token/sub\" != `bob`)",
+		},
+		{
+			filter: "(\"/token/email\" matches `^[A-Z]+@Example\\.com$`) and \"/token/role\" == `A\"B`",
+			want:   "(\"/token/email\" matches `(?i)^[A-Z]+@Example\\.com$` and \"/token/role\" == `a\"b`)",
+		},
+		{
+			filter: `"/token/sub" == Alice and "/token/n" == 5`,
+			want:   "(\"/token/sub\" == `alice` and \"/token/n\" == `5`)",
+		},
+		{
+			// bexpr reads the value as the JSON pointer "/Foo/Bar", whose
+			// value is "Foo/Bar".
+			filter: `"/token/sub" == "/Foo/Bar"`,
+			want:   "\"/token/sub\" == `foo/bar`",
+		},
+		{
+			filter: `"/token/groups" is not empty`,
+			want:   `"/token/groups" is not empty`,
+		},
+		{
+			// Operators and parentheses within values aren't operators.
+			filter: `"/token/sub" == "A) or (B" and not ("/token/Name" matches "x in Y")`,
+			want:   "(\"/token/sub\" == `a) or (b` and not (\"/token/Name\" matches `(?i)x in Y`))",
+		},
+		{
+			// Selectors are written the way they were parsed.
+			filter: `token.Sub == "Alice" and "Admin" in token["My Groups"] and "/token/a~1b" != "C"`,
+			want:   "(token.Sub == `alice` and (`admin` in token[\"My Groups\"] and \"/token/a~1b\" != `c`))",
+		},
+		{
+			filter: "\"/token/sub\" == \"`Alice`\"",
+			want:   "\"/token/sub\" == \"`alice`\"",
+		},
+		{
+			// Left for bexpr to reject.
+			filter:  `"/token/sub" ==`,
+			want:    `"/token/sub" ==`,
+			invalid: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.filter, func(t *testing.T) {
+			got := CaseInsensitiveFilter(tc.filter)
+			assert.Equal(t, tc.want, got)
+			_, err := bexpr.CreateEvaluator(got)
+			if tc.invalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_lowerStringValues(t *testing.T) {
+	t.Parallel()
+	in := map[string]any{
+		"Token": map[string]any{
+			"Groups": []any{"Admins", 5, true},
+			"Roles":  []string{"Dev"},
+			"Sub":    "Alice",
+		},
+	}
+	got := lowerStringValues(in)
+	assert.Equal(t, map[string]any{
+		"Token": map[string]any{
+			"Groups": []any{"admins", 5, true},
+			"Roles":  []string{"dev"},
+			"Sub":    "alice",
+		},
+	}, got)
+	assert.Equal(t, "Alice", in["Token"].(map[string]any)["Sub"])
+}
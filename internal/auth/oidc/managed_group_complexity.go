@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// FilterComplexity returns the number of nodes of the managed group filter
+// once parsed: each match expression and each "and", "or" and "not" is one
+// node. The work of evaluating a filter at login grows with its number of
+// nodes, so it lets unusually expensive filters be found. A filter which
+// can't be parsed is rejected before it's written, so its complexity is 0.
+func FilterComplexity(filter string) uint32 {
+	ast, err := grammar.Parse("", []byte(CompilableFilter(filter)))
+	if err != nil {
+		return 0
+	}
+	e, ok := ast.(grammar.Expression)
+	if !ok {
+		return 0
+	}
+	return filterNodes(e)
+}
+
+func filterNodes(e grammar.Expression) uint32 {
+	switch e := e.(type) {
+	case *grammar.UnaryExpression:
+		return 1 + filterNodes(e.Operand)
+	case *grammar.BinaryExpression:
+		return 1 + filterNodes(e.Left) + filterNodes(e.Right)
+	case *grammar.MatchExpression:
+		return 1
+	}
+	return 0
+}
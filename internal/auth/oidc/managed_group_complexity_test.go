@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterComplexity(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		filter string
+		want   uint32
+	}{
+		{
+			name:   "single-match",
+			filter: `"/token/sub" == "alice"`,
+			want:   1,
+		},
+		{
+			name:   "and",
+			filter: `"/token/sub" == "alice" and "admin" in "/userinfo/groups"`,
+			want:   3,
+		},
+		{
+			name:   "nested",
+			filter: `not ("/token/sub" == "alice" or "/token/sub" == "bob") and "/token/email" matches ".*@example.com"`,
+			want:   6,
+		},
+		{
+			name:   "grouping-is-free",
+			filter: `(("/token/sub" == "alice"))`,
+			want:   1,
+		},
+		{
+			name:   "claim-aliases",
+			filter: `@groups contains "admin" or "/token/sub" == "alice"`,
+			want:   3,
+		},
+		{
+			name:   "invalid",
+			filter: `"/token/sub" ==`,
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FilterComplexity(tc.filter))
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"container/list"
+	"context"
+	"runtime/debug"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// defaultFilterCacheSize is the number of compiled managed group filters kept
+// by the process-wide cache used by ManagedGroupFilterEvaluator.
+const defaultFilterCacheSize = 1024
+
+var managedGroupFilters = newFilterCache(defaultFilterCacheSize)
+
+// bexprModulePath is the path of the module managed group filters are compiled
+// with.
+const bexprModulePath = "github.com/hashicorp/go-bexpr"
+
+// FilterSyntaxVersion is the version of the filter syntax managed group
+// filters are validated under, which is the version of the go-bexpr module the
+// binary was built with. It is recorded with a filter whenever the filter is
+// written. It is empty when the binary has no build information.
+var FilterSyntaxVersion = filterSyntaxVersion()
+
+func filterSyntaxVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != bexprModulePath {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return ""
+}
+
+// ManagedGroupFilterEvaluator returns a compiled evaluator for the managed
+// group filter. Compiled evaluators are memoized in a size bounded LRU cache
+// keyed by the exact filter text, so the evaluator built when a filter is
+// validated is reused when the filter is evaluated at login. Filters which
+// fail to compile are not cached.
+//
+// The returned evaluator is shared and must not be modified.
+func ManagedGroupFilterEvaluator(filter string) (*bexpr.Evaluator, error) {
+	return managedGroupFilters.get(filter)
+}
+
+// MatchManagedGroups returns the managed groups whose filter matches evalData.
+// At login evalData holds the ID token claims under "token" and the UserInfo
+// claims under "userinfo". A filter referencing a claim which isn't present
+// doesn't match, and disabled and synced managed groups never match. The
+// filters of managed groups which match case insensitively are compared to the
+// claims without regard to the case of either.
+//
+// Options supported:
+//
+// * WithClaimAliases: the claim aliases of the managed groups' auth method,
+// which the filters' @alias references are expanded with. A filter
+// referencing an alias which isn't defined doesn't match.
+func MatchManagedGroups(ctx context.Context, mgs []*ManagedGroup, evalData map[string]any, opt ...Option) ([]*ManagedGroup, error) {
+	const op = "oidc.MatchManagedGroups"
+	opts := getOpts(opt...)
+	matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+	// foldedData is evalData with its string values lowercased, built when
+	// the first managed group which matches case insensitively needs it.
+	var foldedData any
+	for _, mg := range mgs {
+		if mg.GetDisabled() || mg.GetKind() == auth.ManagedGroupKindSynced {
+			continue
+		}
+		filter, err := loginFilter(ctx, mg, opts.withClaimAliases)
+		if err != nil {
+			// The alias was removed from the auth method after the filter
+			// was written, which mustn't fail the login.
+			continue
+		}
+		data := any(evalData)
+		if mg.GetMatchCaseInsensitive() {
+			if foldedData == nil {
+				foldedData = lowerStringValues(evalData)
+			}
+			data = foldedData
+		}
+		eval, err := ManagedGroupFilterEvaluator(filter)
+		if err != nil {
+			// We check all filters on ingress so this should never happen,
+			// but we validate anyways
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		match, err := eval.Evaluate(data)
+		if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if match {
+			matchedMgs = append(matchedMgs, mg)
+		}
+	}
+	return matchedMgs, nil
+}
+
+// CompileManagedGroupFilter compiles the stored filter of the managed group
+// the way MatchManagedGroups does at login, bypassing the cache of compiled
+// filters, and returns the error which prevents it from compiling, if any. It
+// is meant to find stored filters which were valid when written but no longer
+// compile, e.g. after the filter grammar changed. A filter referencing an
+// alias which isn't defined fails with an error with the
+// errors.InvalidParameter code.
+//
+// Options supported:
+//
+// * WithClaimAliases: the claim aliases of the managed group's auth method.
+func CompileManagedGroupFilter(ctx context.Context, mg *ManagedGroup, opt ...Option) error {
+	const op = "oidc.CompileManagedGroupFilter"
+	opts := getOpts(opt...)
+	filter, err := loginFilter(ctx, mg, opts.withClaimAliases)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := bexpr.CreateEvaluator(filter); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "error evaluating filter expression", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// loginFilter returns the filter of the managed group as it is compiled at
+// login: with its claim aliases expanded and, if it matches case
+// insensitively, rewritten to compare lowercased claims.
+func loginFilter(ctx context.Context, mg *ManagedGroup, aliases map[string]string) (string, error) {
+	filter, err := ExpandClaimAliases(ctx, mg.GetFilter(), aliases)
+	if err != nil {
+		return "", err
+	}
+	if mg.GetMatchCaseInsensitive() {
+		filter = CaseInsensitiveFilter(filter)
+	}
+	return filter, nil
+}
+
+// filterCache is an LRU cache of compiled bexpr evaluators which is safe for
+// concurrent use.
+type filterCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type filterCacheEntry struct {
+	filter string
+	eval   *bexpr.Evaluator
+}
+
+func newFilterCache(size int) *filterCache {
+	return &filterCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+func (c *filterCache) get(filter string) (*bexpr.Evaluator, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[filter]; ok {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*filterCacheEntry).eval, nil
+	}
+	c.mu.Unlock()
+
+	// Compile outside of the lock; concurrent misses for the same filter
+	// may both compile but only one entry is kept.
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[filter]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*filterCacheEntry).eval, nil
+	}
+	c.entries[filter] = c.order.PushFront(&filterCacheEntry{filter: filter, eval: eval})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*filterCacheEntry).filter)
+	}
+	return eval, nil
+}
+
+func (c *filterCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// ManagedGroupFilterOperators returns the operators a managed group filter
+// can use, as they are written in the filter: the match operators of the
+// bexpr grammar followed by its logical operators.
+func ManagedGroupFilterOperators() []string {
+	return []string{
+		"==", "!=", "contains", "not contains", "in", "not in",
+		"is empty", "is not empty", "matches", "not matches",
+		"and", "or", "not",
+	}
+}
+
+// ManagedGroupFilterSelectorPrefixes returns the prefixes of the selectors
+// which reference claims at login, under which the ID token claims and the
+// UserInfo claims are found.
+func ManagedGroupFilterSelectorPrefixes() []string {
+	return []string{"/token/", "/userinfo/"}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_filterCache(t *testing.T) {
+	t.Parallel()
+	t.Run("memoizes", func(t *testing.T) {
+		c := newFilterCache(2)
+		first, err := c.get(TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		second, err := c.get(TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, c.len())
+	})
+	t.Run("exact-text", func(t *testing.T) {
+		c := newFilterCache(2)
+		first, err := c.get(`"/token/sub" == "alice"`)
+		require.NoError(t, err)
+		second, err := c.get(`"/token/sub"  ==  "alice"`)
+		require.NoError(t, err)
+		assert.NotSame(t, first, second)
+		assert.Equal(t, 2, c.len())
+	})
+	t.Run("evicts-least-recently-used", func(t *testing.T) {
+		c := newFilterCache(2)
+		a, err := c.get(`"/token/sub" == "a"`)
+		require.NoError(t, err)
+		_, err = c.get(`"/token/sub" == "b"`)
+		require.NoError(t, err)
+		// touch a so that b is the oldest entry
+		_, err = c.get(`"/token/sub" == "a"`)
+		require.NoError(t, err)
+		_, err = c.get(`"/token/sub" == "c"`)
+		require.NoError(t, err)
+		assert.Equal(t, 2, c.len())
+		assert.NotContains(t, c.entries, `"/token/sub" == "b"`)
+		again, err := c.get(`"/token/sub" == "a"`)
+		require.NoError(t, err)
+		assert.Same(t, a, again)
+	})
+	t.Run("errors-not-cached", func(t *testing.T) {
+		c := newFilterCache(2)
+		_, err := c.get("foobar")
+		require.Error(t, err)
+		assert.Equal(t, 0, c.len())
+	})
+}
+
+func TestMatchManagedGroups(t *testing.T) {
+	t.Parallel()
+	newMg := func(filter string) *ManagedGroup {
+		mg := AllocManagedGroup()
+		mg.Filter = filter
+		return mg
+	}
+	sub := newMg(`"/token/sub" == "alice"`)
+	email := newMg(`"/userinfo/email" == "alice@example.com"`)
+	missing := newMg(`"/token/groups" contains "admin"`)
+	disabled := newMg(`"/token/sub" == "alice"`)
+	disabled.Disabled = true
+	synced := newMg(`"/token/sub" == "alice"`)
+	synced.Kind = "synced"
+
+	got, err := MatchManagedGroups(context.Background(), []*ManagedGroup{sub, email, missing, disabled, synced}, map[string]any{
+		"token":    map[string]any{"sub": "alice"},
+		"userinfo": map[string]any{"email": "bob@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*ManagedGroup{sub}, got)
+
+	_, err = MatchManagedGroups(context.Background(), []*ManagedGroup{newMg("foobar")}, nil)
+	require.Error(t, err)
+
+	aliased := newMg(`@groups contains "admin"`)
+	undefined := newMg(`@roles contains "admin"`)
+	got, err = MatchManagedGroups(context.Background(), []*ManagedGroup{aliased, undefined}, map[string]any{
+		"token": map[string]any{"groups": []any{"admin"}, "roles": []any{"admin"}},
+	}, WithClaimAliases(map[string]string{"groups": "/token/groups"}))
+	require.NoError(t, err)
+	assert.Equal(t, []*ManagedGroup{aliased}, got)
+
+	exact := newMg(`"Admins" in "/token/groups"`)
+	folded := newMg(`"Admins" in "/token/groups" and "/token/email" matches "@EXAMPLE\\.com$"`)
+	folded.MatchCaseInsensitive = true
+	claims := map[string]any{
+		"token": map[string]any{"groups": []any{"admins"}, "email": "Alice@Example.com"},
+	}
+	got, err = MatchManagedGroups(context.Background(), []*ManagedGroup{exact, folded}, claims)
+	require.NoError(t, err)
+	assert.Equal(t, []*ManagedGroup{folded}, got)
+	// The claims aren't changed.
+	assert.Equal(t, "Alice@Example.com", claims["token"].(map[string]any)["email"])
+}
+
+func BenchmarkManagedGroupFilterEvaluator(b *testing.B) {
+	filters := make([]string, 100)
+	for i := range filters {
+		filters[i] = fmt.Sprintf(`"/token/sub" == "user-%d" and "/userinfo/email" matches ".*@example.com"`, i)
+	}
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := bexpr.CreateEvaluator(filters[i%len(filters)]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		c := newFilterCache(defaultFilterCacheSize)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.get(filters[i%len(filters)]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Test_accountEvalData(t *testing.T) {
+	t.Parallel()
+	acct := AllocAccount()
+	got, err := accountEvalData(acct)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"token": map[string]any{}, "userinfo": map[string]any{}}, got)
+
+	acct.TokenClaims = `{"sub":"alice","groups":["admin"]}`
+	acct.UserinfoClaims = `{"email":"alice@example.com"}`
+	got, err = accountEvalData(acct)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"token":    map[string]any{"sub": "alice", "groups": []any{"admin"}},
+		"userinfo": map[string]any{"email": "alice@example.com"},
+	}, got)
+
+	acct.TokenClaims = "not json"
+	_, err = accountEvalData(acct)
+	require.Error(t, err)
+}
+
+func TestCompileManagedGroupFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newMg := func(filter string) *ManagedGroup {
+		mg := AllocManagedGroup()
+		mg.Filter = filter
+		return mg
+	}
+	aliases := WithClaimAliases(map[string]string{"groups": "/token/groups"})
+
+	assert.NoError(t, CompileManagedGroupFilter(ctx, newMg(`"/token/sub" == "alice"`)))
+	assert.NoError(t, CompileManagedGroupFilter(ctx, newMg(`"admin" in @groups`), aliases))
+
+	err := CompileManagedGroupFilter(ctx, newMg(`"admin" in @groups`))
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	assert.Contains(t, err.Error(), "undefined claim alias @groups")
+
+	// A filter which doesn't compile, as a stored filter wouldn't after a
+	// change of the grammar.
+	err = CompileManagedGroupFilter(ctx, newMg(`"/token/sub" == `))
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	// A selector value is compared as its text, so it's folded too.
+	folded := newMg(`"/token/sub" == token["Sub"]`)
+	assert.NoError(t, CompileManagedGroupFilter(ctx, folded))
+	folded.MatchCaseInsensitive = true
+	assert.NoError(t, CompileManagedGroupFilter(ctx, folded))
+}
+
+func TestManagedGroupFilterOperators(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	// Every operator is accepted in a filter the way it is returned.
+	for _, op := range ManagedGroupFilterOperators() {
+		var filter string
+		switch op {
+		case "and", "or":
+			filter = fmt.Sprintf(`"/token/sub" == "alice" %s "/userinfo/email" == "a@example.com"`, op)
+		case "not":
+			filter = `not "/token/sub" == "alice"`
+		case "is empty", "is not empty":
+			filter = fmt.Sprintf(`"/token/groups" %s`, op)
+		case "in", "not in":
+			filter = fmt.Sprintf(`"admin" %s "/token/groups"`, op)
+		default:
+			filter = fmt.Sprintf(`"/token/sub" %s "alice"`, op)
+		}
+		mg := AllocManagedGroup()
+		mg.Filter = filter
+		assert.NoError(t, CompileManagedGroupFilter(ctx, mg), filter)
+	}
+	for _, prefix := range ManagedGroupFilterSelectorPrefixes() {
+		mg := AllocManagedGroup()
+		mg.Filter = fmt.Sprintf(`"%ssub" == "alice"`, prefix)
+		assert.NoError(t, CompileManagedGroupFilter(ctx, mg), mg.Filter)
+		warnings, err := LintManagedGroupFilter(ctx, mg.Filter)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	}
+}
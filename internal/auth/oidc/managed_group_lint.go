@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// filterLintRule inspects a single match expression of a managed group filter
+// and returns a warning if the expression looks like a mistake.
+type filterLintRule struct {
+	name  string
+	check func(*grammar.MatchExpression) string
+}
+
+// listClaims are claims which identity providers commonly issue as lists.
+var listClaims = map[string]bool{
+	"groups": true,
+	"roles":  true,
+	"amr":    true,
+	"aud":    true,
+}
+
+// filterLintRules are applied, in order, to every match expression of a filter.
+var filterLintRules = []filterLintRule{
+	{
+		name: "list-claim-equality",
+		check: func(m *grammar.MatchExpression) string {
+			if m.Operator != grammar.MatchEqual && m.Operator != grammar.MatchNotEqual {
+				return ""
+			}
+			if len(m.Selector.Path) == 0 || !listClaims[m.Selector.Path[len(m.Selector.Path)-1]] {
+				return ""
+			}
+			return fmt.Sprintf("Claim %q is usually a list; you probably meant \"contains\" rather than %q.", selectorText(m.Selector), operatorText(m.Operator))
+		},
+	},
+	{
+		name: "unknown-claim-source",
+		check: func(m *grammar.MatchExpression) string {
+			if len(m.Selector.Path) == 0 {
+				return ""
+			}
+			switch m.Selector.Path[0] {
+			case "token", "userinfo":
+				return ""
+			}
+			return fmt.Sprintf("Selector %q doesn't start with \"token\" or \"userinfo\" and never matches at login.", selectorText(m.Selector))
+		},
+	},
+	{
+		name: "unanchored-regex",
+		check: func(m *grammar.MatchExpression) string {
+			if m.Operator != grammar.MatchMatches && m.Operator != grammar.MatchNotMatches {
+				return ""
+			}
+			if strings.HasPrefix(m.Value.Raw, "^") && strings.HasSuffix(m.Value.Raw, "$") {
+				return ""
+			}
+			return fmt.Sprintf("Regular expression %q is not anchored with ^ and $ so it also matches claims which only contain it.", m.Value.Raw)
+		},
+	},
+}
+
+// LintManagedGroupFilter returns warnings about parts of the managed group
+// filter which are valid but likely not what the author intended. The
+// warnings are advisory and never make a filter invalid. An error is only
+// returned if the filter cannot be parsed.
+func LintManagedGroupFilter(ctx context.Context, filter string) ([]string, error) {
+	const op = "oidc.LintManagedGroupFilter"
+	ast, err := grammar.Parse("", []byte(CompilableFilter(filter)))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	// Selectors the aliases expand to are checked when the aliases are
+	// defined, so only the selectors written in the filter are linted.
+	aliases := claimAliasPlaceholders(filter)
+	var warnings []string
+	var walk func(grammar.Expression)
+	walk = func(e grammar.Expression) {
+		switch e := e.(type) {
+		case *grammar.UnaryExpression:
+			walk(e.Operand)
+		case *grammar.BinaryExpression:
+			walk(e.Left)
+			walk(e.Right)
+		case *grammar.MatchExpression:
+			if isClaimAliasPlaceholder(e.Selector, aliases) {
+				return
+			}
+			for _, rule := range filterLintRules {
+				if w := rule.check(e); w != "" {
+					warnings = append(warnings, w)
+				}
+			}
+		}
+	}
+	if e, ok := ast.(grammar.Expression); ok {
+		walk(e)
+		if reason := unsatisfiable(e); reason != "" {
+			warnings = append(warnings, "Filter can never match: "+reason)
+		}
+	}
+	return warnings, nil
+}
+
+// FilterHasWarnings reports whether LintManagedGroupFilter returns warnings
+// for the managed group filter. A filter which can't be parsed is rejected
+// before it's written, so it isn't reported.
+func FilterHasWarnings(ctx context.Context, filter string) bool {
+	warnings, err := LintManagedGroupFilter(ctx, filter)
+	return err == nil && len(warnings) > 0
+}
+
+// unsatisfiable returns why the filter expression can never match, or "" if
+// it may. Only obvious contradictions between match expressions which must
+// all hold are found, so filters which are reported can't match but not every
+// filter which can't match is reported. Negated expressions are never
+// considered contradictory.
+func unsatisfiable(e grammar.Expression) string {
+	b, ok := e.(*grammar.BinaryExpression)
+	if !ok {
+		return ""
+	}
+	switch b.Operator {
+	case grammar.BinaryOpOr:
+		left := unsatisfiable(b.Left)
+		if left == "" || unsatisfiable(b.Right) == "" {
+			return ""
+		}
+		return left
+	case grammar.BinaryOpAnd:
+		if reason := unsatisfiable(b.Left); reason != "" {
+			return reason
+		}
+		if reason := unsatisfiable(b.Right); reason != "" {
+			return reason
+		}
+		return contradiction(conjuncts(b, nil))
+	}
+	return ""
+}
+
+// conjuncts appends the match expressions which must all hold for the
+// expression to match to ms.
+func conjuncts(e grammar.Expression, ms []*grammar.MatchExpression) []*grammar.MatchExpression {
+	switch e := e.(type) {
+	case *grammar.MatchExpression:
+		ms = append(ms, e)
+	case *grammar.BinaryExpression:
+		if e.Operator == grammar.BinaryOpAnd {
+			ms = conjuncts(e.Left, ms)
+			ms = conjuncts(e.Right, ms)
+		}
+	}
+	return ms
+}
+
+// contradiction returns why two of the match expressions, which must all
+// hold, can't hold together, or "" if none are found to contradict. Values
+// which only differ in case aren't considered different, since the filter may
+// be matched without regard to case.
+func contradiction(ms []*grammar.MatchExpression) string {
+	for i, a := range ms {
+		for _, b := range ms[i+1:] {
+			sel := selectorText(a.Selector)
+			if sel != selectorText(b.Selector) {
+				continue
+			}
+			if a.Operator > b.Operator {
+				a, b = b, a
+			}
+			switch {
+			case a.Operator == grammar.MatchEqual && b.Operator == grammar.MatchEqual:
+				if !strings.EqualFold(a.Value.Raw, b.Value.Raw) {
+					return fmt.Sprintf("%q can't equal both %q and %q.", sel, a.Value.Raw, b.Value.Raw)
+				}
+			case a.Operator == grammar.MatchEqual && b.Operator == grammar.MatchNotEqual:
+				if a.Value.Raw == b.Value.Raw {
+					return fmt.Sprintf("%q can't both equal and not equal %q.", sel, a.Value.Raw)
+				}
+			case a.Operator == grammar.MatchIsEmpty && b.Operator == grammar.MatchIsNotEmpty:
+				return fmt.Sprintf("%q can't be both empty and not empty.", sel)
+			}
+		}
+	}
+	return ""
+}
+
+// operatorText returns the operator as it is written in a filter, with the
+// selector first.
+func operatorText(op grammar.MatchOperator) string {
+	switch op {
+	case grammar.MatchEqual:
+		return "=="
+	case grammar.MatchNotEqual:
+		return "!="
+	case grammar.MatchIn:
+		return "contains"
+	case grammar.MatchNotIn:
+		return "not contains"
+	case grammar.MatchIsEmpty:
+		return "is empty"
+	case grammar.MatchIsNotEmpty:
+		return "is not empty"
+	case grammar.MatchMatches:
+		return "matches"
+	case grammar.MatchNotMatches:
+		return "not matches"
+	default:
+		return op.String()
+	}
+}
+
+// selectorText returns the selector as it is written in a filter.
+func selectorText(sel grammar.Selector) string {
+	if sel.Type == grammar.SelectorTypeJsonPointer {
+		return "/" + sel.String()
+	}
+	return sel.String()
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintManagedGroupFilter(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name         string
+		filter       string
+		wantWarnings []string
+		wantErr      bool
+	}{
+		{
+			name:   "clean",
+			filter: `"/token/sub" == "alice" and "admin" in "/userinfo/groups"`,
+		},
+		{
+			name:         "list claim equality",
+			filter:       `"/token/groups" == "admin"`,
+			wantWarnings: []string{`Claim "/token/groups" is usually a list; you probably meant "contains" rather than "==".`},
+		},
+		{
+			name:         "unknown claim source",
+			filter:       `"/claims/sub" == "alice"`,
+			wantWarnings: []string{`Selector "/claims/sub" doesn't start with "token" or "userinfo" and never matches at login.`},
+		},
+		{
+			name:         "unanchored regex",
+			filter:       `"/userinfo/email" matches ".*@example.com"`,
+			wantWarnings: []string{`Regular expression ".*@example.com" is not anchored with ^ and $ so it also matches claims which only contain it.`},
+		},
+		{
+			name:   "anchored regex",
+			filter: `"/userinfo/email" matches "^.*@example\\.com$"`,
+		},
+		{
+			name:   "nested expressions",
+			filter: `not ("/token/roles" != "admin" or "/token/sub" == "alice") and "/foo/bar" == "zipzap"`,
+			wantWarnings: []string{
+				`Claim "/token/roles" is usually a list; you probably meant "contains" rather than "!=".`,
+				`Selector "/foo/bar" doesn't start with "token" or "userinfo" and never matches at login.`,
+			},
+		},
+		{
+			name:         "contradictory equality",
+			filter:       `"/token/sub" == "alice" and "/token/sub" == "bob"`,
+			wantWarnings: []string{`Filter can never match: "/token/sub" can't equal both "alice" and "bob".`},
+		},
+		{
+			name:         "equal and not equal",
+			filter:       `"/token/sub" != "alice" and ("/token/email" is not empty and "/token/sub" == "alice")`,
+			wantWarnings: []string{`Filter can never match: "/token/sub" can't both equal and not equal "alice".`},
+		},
+		{
+			name:         "empty and not empty",
+			filter:       `"/token/email" is empty and "/token/email" is not empty`,
+			wantWarnings: []string{`Filter can never match: "/token/email" can't be both empty and not empty.`},
+		},
+		{
+			name: "contradiction in every alternative",
+			filter: `("/token/sub" == "alice" and "/token/sub" == "bob") or ` +
+				`("/token/email" is empty and "/token/email" is not empty)`,
+			wantWarnings: []string{`Filter can never match: "/token/sub" can't equal both "alice" and "bob".`},
+		},
+		{
+			name:   "contradiction in one alternative",
+			filter: `("/token/sub" == "alice" and "/token/sub" == "bob") or "/token/sub" == "carol"`,
+		},
+		{
+			name:   "alternatives of the same claim",
+			filter: `"/token/sub" == "alice" or "/token/sub" == "bob"`,
+		},
+		{
+			name:   "values differing in case",
+			filter: `"/token/sub" == "alice" and "/token/sub" == "Alice"`,
+		},
+		{
+			name:   "negated contradiction",
+			filter: `not ("/token/sub" == "alice" and "/token/sub" == "bob")`,
+		},
+		{
+			name:   "different claims",
+			filter: `"/token/sub" == "alice" and "/userinfo/sub" == "bob"`,
+		},
+		{
+			name:         "claim aliases",
+			filter:       `@groups == "admin" and "/claims/sub" == "alice"`,
+			wantWarnings: []string{`Selector "/claims/sub" doesn't start with "token" or "userinfo" and never matches at login.`},
+		},
+		{
+			name:         "contradiction on claim alias",
+			filter:       `@email is empty and @email is not empty`,
+			wantWarnings: []string{`Filter can never match: "/email" can't be both empty and not empty.`},
+		},
+		{
+			name:    "invalid",
+			filter:  "foobar",
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := LintManagedGroupFilter(context.Background(), tc.filter)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.False(t, FilterHasWarnings(context.Background(), tc.filter))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantWarnings, got)
+			assert.Equal(t, len(tc.wantWarnings) > 0, FilterHasWarnings(context.Background(), tc.filter))
+		})
+	}
+}
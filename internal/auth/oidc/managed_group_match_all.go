@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// requiredIdTokenClaims are the claims every ID token has, so every account
+// which authenticates has a value for them.
+var requiredIdTokenClaims = map[string]bool{
+	"token\x00iss": true,
+	"token\x00sub": true,
+	"token\x00aud": true,
+	"token\x00exp": true,
+	"token\x00iat": true,
+}
+
+// sampleClaimValues are dissimilar values a required claim might have, used to
+// probe whether a regular expression matches any value.
+var sampleClaimValues = []string{
+	"a",
+	"Z9",
+	"alice@example.com",
+	"https://idp.example.com/",
+	"248289761001",
+	"CN=Alice,OU=Users,DC=example,DC=com",
+}
+
+// MatchesAllSubjects reports whether the managed group filter appears to match
+// every account which authenticates, such as `"/token/sub" != ""`. It is a
+// heuristic: a match expression matches everyone if it checks only that a
+// claim every ID token has is set, or compares the claim with a regular
+// expression matching every sample value, and "and", "or" and "not" combine
+// them as expected. A filter which can't be parsed doesn't match everyone.
+func MatchesAllSubjects(filter string) bool {
+	ast, err := grammar.Parse("", []byte(CompilableFilter(filter)))
+	if err != nil {
+		return false
+	}
+	e, ok := ast.(grammar.Expression)
+	if !ok {
+		return false
+	}
+	all, _ := matchesAllOrNone(e)
+	return all
+}
+
+// matchesAllOrNone reports whether the expression appears to match every
+// subject and whether it appears to match none.
+func matchesAllOrNone(e grammar.Expression) (all, none bool) {
+	switch e := e.(type) {
+	case *grammar.UnaryExpression:
+		all, none = matchesAllOrNone(e.Operand)
+		return none, all
+	case *grammar.BinaryExpression:
+		leftAll, leftNone := matchesAllOrNone(e.Left)
+		rightAll, rightNone := matchesAllOrNone(e.Right)
+		if e.Operator == grammar.BinaryOpAnd {
+			return leftAll && rightAll, leftNone || rightNone
+		}
+		return leftAll || rightAll, leftNone && rightNone
+	case *grammar.MatchExpression:
+		if !requiredIdTokenClaims[strings.Join(e.Selector.Path, "\x00")] {
+			return false, false
+		}
+		switch e.Operator {
+		case grammar.MatchIsNotEmpty:
+			return true, false
+		case grammar.MatchIsEmpty:
+			return false, true
+		case grammar.MatchNotEqual:
+			return e.Value != nil && e.Value.Raw == "", false
+		case grammar.MatchEqual:
+			return false, e.Value != nil && e.Value.Raw == ""
+		case grammar.MatchMatches:
+			return e.Value != nil && matchesSamples(e.Value.Raw), false
+		case grammar.MatchNotMatches:
+			return false, e.Value != nil && matchesSamples(e.Value.Raw)
+		}
+	}
+	return false, false
+}
+
+// matchesSamples reports whether the regular expression matches every sample
+// claim value.
+func matchesSamples(expr string) bool {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return false
+	}
+	for _, v := range sampleClaimValues {
+		if !re.MatchString(v) {
+			return false
+		}
+	}
+	return true
+}
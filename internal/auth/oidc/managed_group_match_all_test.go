@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesAllSubjects(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{
+			name:   "sub-not-empty-string",
+			filter: `"/token/sub" != ""`,
+			want:   true,
+		},
+		{
+			name:   "sub-is-not-empty",
+			filter: `"/token/sub" is not empty`,
+			want:   true,
+		},
+		{
+			name:   "dotted-selector",
+			filter: `token.iss != ""`,
+			want:   true,
+		},
+		{
+			name:   "matches-anything",
+			filter: `"/token/sub" matches ".*"`,
+			want:   true,
+		},
+		{
+			name:   "matches-some",
+			filter: `"/token/sub" matches ".*@example.com"`,
+		},
+		{
+			name:   "or-with-match-all",
+			filter: `"/token/sub" == "alice" or "/token/sub" != ""`,
+			want:   true,
+		},
+		{
+			name:   "and-with-match-all",
+			filter: `"/token/sub" == "alice" and "/token/sub" != ""`,
+		},
+		{
+			name:   "and-of-match-all",
+			filter: `"/token/sub" != "" and "/token/aud" is not empty`,
+			want:   true,
+		},
+		{
+			name:   "not-match-none",
+			filter: `not ("/token/sub" == "")`,
+			want:   true,
+		},
+		{
+			name:   "not-match-all",
+			filter: `not ("/token/sub" != "")`,
+		},
+		{
+			name:   "optional-claim",
+			filter: `"/userinfo/email" != ""`,
+		},
+		{
+			name:   "excludes-one-subject",
+			filter: `"/token/sub" != "alice"`,
+		},
+		{
+			name:   "invalid",
+			filter: `"/token/sub" !=`,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, MatchesAllSubjects(tc.filter))
+		})
+	}
+}
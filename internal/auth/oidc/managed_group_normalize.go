@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// NormalizeManagedGroupFilter returns the canonical form of the managed group
+// filter. Filters which only differ in how they are written normalize to the
+// same form, for example:
+//
+// * selectors written as JSON pointers or in bexpr's dotted form, such as
+// "/token/sub" and token.sub
+//
+// * quoted and unquoted values, and "v" in "/sel" and "/sel" contains "v"
+//
+// * the order of the operands of and and or, and repeated operands
+//
+// * whitespace and redundant parentheses
+//
+// The canonical form is only meant to be compared and isn't necessarily a
+// valid filter. Claim aliases aren't expanded, so the filter must not
+// reference any.
+func NormalizeManagedGroupFilter(ctx context.Context, filter string) (string, error) {
+	const op = "oidc.NormalizeManagedGroupFilter"
+	ast, err := grammar.Parse("", []byte(filter))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	e, ok := ast.(grammar.Expression)
+	if !ok {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "filter is not an expression")
+	}
+	return normalizeExpression(e), nil
+}
+
+// EquivalentManagedGroup returns the managed group of mgs, other than mg
+// itself, which matches the same claims as mg because its filter normalizes to
+// the same form and it is matched with the same options, or nil if there is
+// none. The filters are normalized once their claim aliases are expanded, so a
+// filter referencing an alias is equivalent to one using the selector the
+// alias stands for.
+//
+// Options supported:
+//
+// * WithClaimAliases: the claim aliases of the managed groups' auth method.
+func EquivalentManagedGroup(ctx context.Context, mg *ManagedGroup, mgs []*ManagedGroup, opt ...Option) (*ManagedGroup, error) {
+	const op = "oidc.EquivalentManagedGroup"
+	opts := getOpts(opt...)
+	normalized := func(mg *ManagedGroup) (string, error) {
+		filter, err := loginFilter(ctx, mg, opts.withClaimAliases)
+		if err != nil {
+			return "", err
+		}
+		return NormalizeManagedGroupFilter(ctx, filter)
+	}
+	want, err := normalized(mg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, other := range mgs {
+		if other.GetPublicId() == mg.GetPublicId() || other.GetMatchCaseInsensitive() != mg.GetMatchCaseInsensitive() {
+			continue
+		}
+		got, err := normalized(other)
+		if err != nil {
+			// A stored filter which no longer compiles can't be equivalent
+			// to a valid one.
+			continue
+		}
+		if got == want {
+			return other, nil
+		}
+	}
+	return nil, nil
+}
+
+func normalizeExpression(e grammar.Expression) string {
+	switch e := e.(type) {
+	case *grammar.UnaryExpression:
+		return "not (" + normalizeExpression(e.Operand) + ")"
+	case *grammar.BinaryExpression:
+		var operands []string
+		collectOperands(e, e.Operator, &operands)
+		sort.Strings(operands)
+		out := operands[:1]
+		for _, o := range operands[1:] {
+			if o != out[len(out)-1] {
+				out = append(out, o)
+			}
+		}
+		if len(out) == 1 {
+			return out[0]
+		}
+		sep := " and "
+		if e.Operator == grammar.BinaryOpOr {
+			sep = " or "
+		}
+		return "(" + strings.Join(out, sep) + ")"
+	case *grammar.MatchExpression:
+		sel := strconv.Quote("/" + strings.Join(e.Selector.Path, "/"))
+		var value string
+		if e.Value != nil {
+			value = strconv.Quote(e.Value.Raw)
+		}
+		switch e.Operator {
+		case grammar.MatchEqual:
+			return sel + " == " + value
+		case grammar.MatchNotEqual:
+			return sel + " != " + value
+		case grammar.MatchIn:
+			return value + " in " + sel
+		case grammar.MatchNotIn:
+			return value + " not in " + sel
+		case grammar.MatchIsEmpty:
+			return sel + " is empty"
+		case grammar.MatchIsNotEmpty:
+			return sel + " is not empty"
+		case grammar.MatchMatches:
+			return sel + " matches " + value
+		case grammar.MatchNotMatches:
+			return sel + " not matches " + value
+		}
+	}
+	return ""
+}
+
+// collectOperands appends the normalized operands of the chain of binary
+// expressions with the operator op rooted at e.
+func collectOperands(e grammar.Expression, op grammar.BinaryOperator, operands *[]string) {
+	if b, ok := e.(*grammar.BinaryExpression); ok && b.Operator == op {
+		collectOperands(b.Left, op, operands)
+		collectOperands(b.Right, op, operands)
+		return
+	}
+	*operands = append(*operands, normalizeExpression(e))
+}
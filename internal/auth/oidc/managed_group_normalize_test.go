@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeManagedGroupFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{
+			name: "selector forms",
+			a:    `"/token/sub" == "alice"`,
+			b:    `token.sub == alice`,
+			same: true,
+		},
+		{
+			name: "contains",
+			a:    `"admin" in "/token/groups"`,
+			b:    `"/token/groups" contains "admin"`,
+			same: true,
+		},
+		{
+			name: "operand order and parentheses",
+			a:    `"/token/sub" == "alice" and ("admin" in "/token/groups" and "/userinfo/team" == "dev")`,
+			b:    `(("/userinfo/team"=="dev") and "/token/groups" contains "admin") and "/token/sub" == "alice"`,
+			same: true,
+		},
+		{
+			name: "repeated operand",
+			a:    `"/token/sub" == "alice" or "/token/sub" == "alice"`,
+			b:    `"/token/sub" == "alice"`,
+			same: true,
+		},
+		{
+			name: "different operators",
+			a:    `"/token/sub" == "alice" and "/token/email" == "a@example.com"`,
+			b:    `"/token/sub" == "alice" or "/token/email" == "a@example.com"`,
+		},
+		{
+			name: "different values",
+			a:    `"/token/sub" == "alice"`,
+			b:    `"/token/sub" == "Alice"`,
+		},
+		{
+			name: "negated",
+			a:    `"/token/sub" == "alice"`,
+			b:    `not "/token/sub" == "alice"`,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a, err := NormalizeManagedGroupFilter(ctx, tc.a)
+			require.NoError(t, err)
+			b, err := NormalizeManagedGroupFilter(ctx, tc.b)
+			require.NoError(t, err)
+			if tc.same {
+				assert.Equal(t, a, b)
+			} else {
+				assert.NotEqual(t, a, b)
+			}
+		})
+	}
+
+	_, err := NormalizeManagedGroupFilter(ctx, `"/token/sub" ==`)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestEquivalentManagedGroup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mg := func(id, filter string, caseInsensitive bool) *ManagedGroup {
+		mg := AllocManagedGroup()
+		mg.PublicId = id
+		mg.Filter = filter
+		mg.MatchCaseInsensitive = caseInsensitive
+		return mg
+	}
+	mgs := []*ManagedGroup{
+		mg("mgoidc_1", `"/token/sub" == "alice"`, false),
+		mg("mgoidc_2", `"admin" in "/token/groups"`, true),
+		mg("mgoidc_3", `"/token/sub" ==`, false),
+	}
+	aliases := map[string]string{"groups": "/token/groups"}
+
+	got, err := EquivalentManagedGroup(ctx, mg("mgoidc_new", `token.sub == alice`, false), mgs, WithClaimAliases(aliases))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "mgoidc_1", got.GetPublicId())
+
+	// Aliases are expanded before comparing.
+	got, err = EquivalentManagedGroup(ctx, mg("mgoidc_new", `@groups contains "admin"`, true), mgs, WithClaimAliases(aliases))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "mgoidc_2", got.GetPublicId())
+
+	// The same filter matched with different options isn't equivalent.
+	got, err = EquivalentManagedGroup(ctx, mg("mgoidc_new", `"/token/sub" == "alice"`, true), mgs, WithClaimAliases(aliases))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// A managed group isn't equivalent to itself.
+	got, err = EquivalentManagedGroup(ctx, mgs[0], mgs, WithClaimAliases(aliases))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
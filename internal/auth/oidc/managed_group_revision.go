@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultManagedGroupRevisionTableName defines the default table name for a
+// ManagedGroupRevision
+const defaultManagedGroupRevisionTableName = "auth_oidc_managed_group_revision"
+
+// ManagedGroupRevision is a version of a ManagedGroup which was replaced by an
+// update. Revisions are written by the Repository as part of the update and
+// are never changed.
+type ManagedGroupRevision struct {
+	*store.ManagedGroupRevision
+	tableName string
+}
+
+// newManagedGroupRevision returns the revision holding mg, which is being
+// replaced by the user with the id replacedBy.
+func newManagedGroupRevision(mg *ManagedGroup, replacedBy string) *ManagedGroupRevision {
+	return &ManagedGroupRevision{
+		ManagedGroupRevision: &store.ManagedGroupRevision{
+			ManagedGroupId:       mg.PublicId,
+			Version:              mg.Version,
+			Name:                 mg.Name,
+			Description:          mg.Description,
+			Filter:               mg.Filter,
+			Disabled:             mg.Disabled,
+			MatchCaseInsensitive: mg.MatchCaseInsensitive,
+			FilterVersion:        mg.FilterVersion,
+			ValidFromTime:        mg.UpdateTime,
+			ReplacedBy:           replacedBy,
+		},
+	}
+}
+
+// AllocManagedGroupRevision makes an empty one in memory
+func AllocManagedGroupRevision() *ManagedGroupRevision {
+	return &ManagedGroupRevision{
+		ManagedGroupRevision: &store.ManagedGroupRevision{},
+	}
+}
+
+// Clone a ManagedGroupRevision
+func (r *ManagedGroupRevision) Clone() *ManagedGroupRevision {
+	cp := proto.Clone(r.ManagedGroupRevision)
+	return &ManagedGroupRevision{
+		ManagedGroupRevision: cp.(*store.ManagedGroupRevision),
+	}
+}
+
+// TableName returns the table name.
+func (r *ManagedGroupRevision) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultManagedGroupRevisionTableName
+}
+
+// SetTableName sets the table name.
+func (r *ManagedGroupRevision) SetTableName(n string) {
+	r.tableName = n
+}
+
+// ManagedGroup returns the managed group as it was at the revision's version,
+// in the auth method with the provided id.
+func (r *ManagedGroupRevision) ManagedGroup(authMethodId string) *ManagedGroup {
+	mg := AllocManagedGroup()
+	mg.PublicId = r.ManagedGroupId
+	mg.AuthMethodId = authMethodId
+	mg.Version = r.Version
+	mg.Name = r.Name
+	mg.Description = r.Description
+	mg.Filter = r.Filter
+	mg.Disabled = r.Disabled
+	mg.MatchCaseInsensitive = r.MatchCaseInsensitive
+	mg.FilterVersion = r.FilterVersion
+	mg.UpdateTime = r.ValidFromTime
+	return mg
+}
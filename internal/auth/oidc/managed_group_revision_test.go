@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestManagedGroupRevision(t *testing.T) {
+	mg := AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Version = 3
+	mg.Name = "admins"
+	mg.Description = "the admins"
+	mg.Filter = TestFakeManagedGroupFilter
+	mg.Disabled = true
+	mg.MatchCaseInsensitive = true
+	mg.FilterVersion = 2
+	mg.CreateTime = timestamp.New(time.Now().Add(-time.Hour))
+	mg.UpdateTime = timestamp.New(time.Now())
+
+	rev := newManagedGroupRevision(mg, "u_1234567890")
+	assert.Equal(t, mg.PublicId, rev.ManagedGroupId)
+	assert.Equal(t, mg.UpdateTime, rev.ValidFromTime)
+	assert.Equal(t, "u_1234567890", rev.ReplacedBy)
+	assert.Nil(t, rev.ReplacedTime)
+	assert.Equal(t, defaultManagedGroupRevisionTableName, rev.TableName())
+
+	// The managed group of the revision is mg as it was, other than the
+	// create time which isn't recorded.
+	want := mg.Clone()
+	want.CreateTime = nil
+	assert.True(t, proto.Equal(want.ManagedGroup, rev.ManagedGroup(mg.AuthMethodId).ManagedGroup))
+
+	cp := rev.Clone()
+	assert.True(t, proto.Equal(rev.ManagedGroupRevision, cp.ManagedGroupRevision))
+	cp.Filter = `"/token/sub" == "bob"`
+	assert.Equal(t, TestFakeManagedGroupFilter, rev.Filter)
+}
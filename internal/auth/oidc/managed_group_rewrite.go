@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// selectorPrefixRe matches a JSON pointer made of the segments a bexpr JSON
+// pointer selector accepts.
+var selectorPrefixRe = regexp.MustCompile(`^(/[\pL\pN\-_.~:|]+)+$`)
+
+// ValidSelectorPrefix reports whether p is a JSON pointer, such as
+// "/token/groups", which can be used as a selector prefix by
+// ReplaceManagedGroupFilterSelectorPrefix.
+func ValidSelectorPrefix(p string) bool {
+	return selectorPrefixRe.MatchString(p)
+}
+
+// ReplaceManagedGroupFilterSelectorPrefix returns the managed group filter with
+// oldPrefix replaced by newPrefix in every selector whose path starts with
+// oldPrefix. Both prefixes are JSON pointers and only whole path segments are
+// matched, so "/token/group" doesn't match "/token/groups". The returned bool
+// reports whether any selector was rewritten.
+//
+// The rewrite is done on the text of the filter so the rest of the filter is
+// kept as written. It is rejected unless the result parses to the same
+// expression with only the selectors changed and compiles with
+// bexpr.CreateEvaluator. Selectors with the prefix which aren't written as
+// JSON pointers can't be rewritten and are rejected too. Claim aliases are
+// kept as written; the selectors they expand to are defined on the auth
+// method.
+func ReplaceManagedGroupFilterSelectorPrefix(ctx context.Context, filter, oldPrefix, newPrefix string) (string, bool, error) {
+	const op = "oidc.ReplaceManagedGroupFilterSelectorPrefix"
+	switch {
+	case !ValidSelectorPrefix(oldPrefix):
+		return "", false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid old prefix %q", oldPrefix))
+	case !ValidSelectorPrefix(newPrefix):
+		return "", false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid new prefix %q", newPrefix))
+	}
+	ast, err := grammar.Parse("", []byte(CompilableFilter(filter)))
+	if err != nil {
+		return "", false, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	want, ok := ast.(grammar.Expression)
+	if !ok {
+		return "", false, errors.New(ctx, errors.InvalidParameter, op, "filter is not an expression")
+	}
+
+	oldPath := strings.Split(oldPrefix[1:], "/")
+	newPath := strings.Split(newPrefix[1:], "/")
+	aliases := claimAliasPlaceholders(filter)
+	var rewritten int
+	var walkErr error
+	walkMatchExpressions(want, func(m *grammar.MatchExpression) {
+		if walkErr != nil || isClaimAliasPlaceholder(m.Selector, aliases) || !hasPathPrefix(m.Selector.Path, oldPath) {
+			return
+		}
+		if m.Selector.Type != grammar.SelectorTypeJsonPointer {
+			walkErr = errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("selector %q isn't a JSON pointer and can't be rewritten", selectorText(m.Selector)))
+			return
+		}
+		m.Selector.Path = append(append([]string{}, newPath...), m.Selector.Path[len(oldPath):]...)
+		rewritten++
+	})
+	if walkErr != nil {
+		return "", false, walkErr
+	}
+	if rewritten == 0 {
+		return filter, false, nil
+	}
+
+	out := replaceQuotedPointerPrefix(filter, oldPrefix, newPrefix)
+	gotAst, err := grammar.Parse("", []byte(CompilableFilter(out)))
+	if err != nil {
+		return "", false, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("rewritten filter doesn't parse"))
+	}
+	got, ok := gotAst.(grammar.Expression)
+	if !ok || !sameExpression(got, want) {
+		// The prefix also appears outside of selectors, for example as a
+		// quoted value, so the text can't be rewritten safely.
+		return "", false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is also used outside of selectors in the filter", oldPrefix))
+	}
+	if _, err := ManagedGroupFilterEvaluator(CompilableFilter(out)); err != nil {
+		return "", false, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("rewritten filter is invalid"))
+	}
+	return out, true, nil
+}
+
+// replaceQuotedPointerPrefix replaces oldPrefix with newPrefix wherever it
+// starts a quoted string and ends at a JSON pointer segment boundary.
+func replaceQuotedPointerPrefix(s, oldPrefix, newPrefix string) string {
+	var b strings.Builder
+	needle := `"` + oldPrefix
+	for {
+		i := strings.Index(s, needle)
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end := i + len(needle)
+		b.WriteString(s[:i])
+		if end < len(s) && (s[end] == '"' || s[end] == '/') {
+			b.WriteString(`"` + newPrefix)
+		} else {
+			b.WriteString(needle)
+		}
+		s = s[end:]
+	}
+}
+
+func walkMatchExpressions(e grammar.Expression, fn func(*grammar.MatchExpression)) {
+	switch e := e.(type) {
+	case *grammar.UnaryExpression:
+		walkMatchExpressions(e.Operand, fn)
+	case *grammar.BinaryExpression:
+		walkMatchExpressions(e.Left, fn)
+		walkMatchExpressions(e.Right, fn)
+	case *grammar.MatchExpression:
+		fn(e)
+	}
+}
+
+func hasPathPrefix(path, prefix []string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameExpression reports whether a and b are the same expression.
+func sameExpression(a, b grammar.Expression) bool {
+	switch a := a.(type) {
+	case *grammar.UnaryExpression:
+		b, ok := b.(*grammar.UnaryExpression)
+		return ok && a.Operator == b.Operator && sameExpression(a.Operand, b.Operand)
+	case *grammar.BinaryExpression:
+		b, ok := b.(*grammar.BinaryExpression)
+		return ok && a.Operator == b.Operator && sameExpression(a.Left, b.Left) && sameExpression(a.Right, b.Right)
+	case *grammar.MatchExpression:
+		b, ok := b.(*grammar.MatchExpression)
+		if !ok || a.Operator != b.Operator || a.Selector.Type != b.Selector.Type || !hasPathPrefix(a.Selector.Path, b.Selector.Path) || len(a.Selector.Path) != len(b.Selector.Path) {
+			return false
+		}
+		if a.Value == nil || b.Value == nil {
+			return a.Value == b.Value
+		}
+		return a.Value.Raw == b.Value.Raw
+	default:
+		return false
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceManagedGroupFilterSelectorPrefix(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		filter      string
+		oldPrefix   string
+		newPrefix   string
+		want        string
+		wantChanged bool
+		wantErr     bool
+	}{
+		{
+			name:        "whole selector",
+			filter:      `"admin" in "/token/groups"`,
+			oldPrefix:   "/token/groups",
+			newPrefix:   "/token/roles",
+			want:        `"admin" in "/token/roles"`,
+			wantChanged: true,
+		},
+		{
+			name:        "prefix of selectors",
+			filter:      `"/token/custom/dept" == "eng" and not ("/token/custom/title" == "intern" or "/userinfo/custom/dept" == "ops")`,
+			oldPrefix:   "/token/custom",
+			newPrefix:   "/userinfo/ext/custom",
+			want:        `"/userinfo/ext/custom/dept" == "eng" and not ("/userinfo/ext/custom/title" == "intern" or "/userinfo/custom/dept" == "ops")`,
+			wantChanged: true,
+		},
+		{
+			name:      "partial segment not replaced",
+			filter:    `"admin" in "/token/groupsx"`,
+			oldPrefix: "/token/groups",
+			newPrefix: "/token/roles",
+			want:      `"admin" in "/token/groupsx"`,
+		},
+		{
+			name:      "no match",
+			filter:    `"/token/sub" == "alice"`,
+			oldPrefix: "/userinfo/sub",
+			newPrefix: "/token/sub",
+			want:      `"/token/sub" == "alice"`,
+		},
+		{
+			name:      "prefix used as a value",
+			filter:    `"/token/groups" contains "/token/groups"`,
+			oldPrefix: "/token/groups",
+			newPrefix: "/token/roles",
+			wantErr:   true,
+		},
+		{
+			name:      "bexpr selector",
+			filter:    `token.groups contains "admin"`,
+			oldPrefix: "/token/groups",
+			newPrefix: "/token/roles",
+			wantErr:   true,
+		},
+		{
+			name:        "claim aliases kept",
+			filter:      `@groups contains "admin" and "/token/custom/dept" == "eng"`,
+			oldPrefix:   "/token/custom",
+			newPrefix:   "/userinfo/custom",
+			want:        `@groups contains "admin" and "/userinfo/custom/dept" == "eng"`,
+			wantChanged: true,
+		},
+		{
+			name:      "claim alias named like the prefix",
+			filter:    `@token contains "admin"`,
+			oldPrefix: "/token",
+			newPrefix: "/userinfo",
+			want:      `@token contains "admin"`,
+		},
+		{
+			name:      "invalid old prefix",
+			filter:    `"/token/sub" == "alice"`,
+			oldPrefix: "token/sub",
+			newPrefix: "/token/sub",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid new prefix",
+			filter:    `"/token/sub" == "alice"`,
+			oldPrefix: "/token/sub",
+			newPrefix: "/token/a b",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid filter",
+			filter:    "foobar",
+			oldPrefix: "/token/sub",
+			newPrefix: "/userinfo/sub",
+			wantErr:   true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, changed, err := ReplaceManagedGroupFilterSelectorPrefix(context.Background(), tc.filter, tc.oldPrefix, tc.newPrefix)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantChanged, changed)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultManagedGroupTemplateTableName defines the default table name for a
+// ManagedGroupTemplate
+const defaultManagedGroupTemplateTableName = "auth_oidc_managed_group_template"
+
+// ManagedGroupTemplateAuthMethodId is replaced in the name, description and
+// filter of a ManagedGroupTemplate by the id of the auth method a managed
+// group is created in from the template.
+const ManagedGroupTemplateAuthMethodId = "{{auth_method_id}}"
+
+// templateAuthMethodIdExample is the auth method id the filter of a template
+// is validated with, since the template isn't tied to an auth method.
+const templateAuthMethodIdExample = "amoidc_1234567890"
+
+// ManagedGroupTemplate is a reusable definition of an OIDC managed group. It
+// is defined in a scope and managed groups of the OIDC auth methods of that
+// scope can be created from it.
+type ManagedGroupTemplate struct {
+	*store.ManagedGroupTemplate
+	tableName string
+}
+
+// NewManagedGroupTemplate creates a new in memory ManagedGroupTemplate in the
+// scope. WithDescription is supported.
+func NewManagedGroupTemplate(ctx context.Context, scopeId, name, filter string, opt ...Option) (*ManagedGroupTemplate, error) {
+	const op = "oidc.NewManagedGroupTemplate"
+	opts := getOpts(opt...)
+	t := &ManagedGroupTemplate{
+		ManagedGroupTemplate: &store.ManagedGroupTemplate{
+			ScopeId:     scopeId,
+			Name:        name,
+			Description: opts.withDescription,
+			Filter:      filter,
+		},
+	}
+	if err := t.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return t, nil
+}
+
+// validate the ManagedGroupTemplate. On success, it will return nil.
+func (t *ManagedGroupTemplate) validate(ctx context.Context, caller errors.Op) error {
+	if t.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if t.Name == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing name")
+	}
+	if t.Filter == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing filter")
+	}
+	if _, err := t.ManagedGroup(ctx, templateAuthMethodIdExample); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// ManagedGroup returns a new in memory ManagedGroup of the auth method with
+// the provided id defined by the template: ManagedGroupTemplateAuthMethodId is
+// replaced by authMethodId in its name, description and filter. An error is
+// returned if the resulting filter is not valid.
+func (t *ManagedGroupTemplate) ManagedGroup(ctx context.Context, authMethodId string) (*ManagedGroup, error) {
+	const op = "oidc.(ManagedGroupTemplate).ManagedGroup"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	mg, err := NewManagedGroup(ctx, authMethodId, ExpandManagedGroupTemplate(t.GetFilter(), authMethodId),
+		WithName(ExpandManagedGroupTemplate(t.GetName(), authMethodId)),
+		WithDescription(ExpandManagedGroupTemplate(t.GetDescription(), authMethodId)))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mg, nil
+}
+
+// ExpandManagedGroupTemplate returns s, a field of a ManagedGroupTemplate,
+// with ManagedGroupTemplateAuthMethodId replaced by authMethodId.
+func ExpandManagedGroupTemplate(s, authMethodId string) string {
+	return strings.ReplaceAll(s, ManagedGroupTemplateAuthMethodId, authMethodId)
+}
+
+// AllocManagedGroupTemplate makes an empty one in memory
+func AllocManagedGroupTemplate() *ManagedGroupTemplate {
+	return &ManagedGroupTemplate{
+		ManagedGroupTemplate: &store.ManagedGroupTemplate{},
+	}
+}
+
+// Clone a ManagedGroupTemplate.
+func (t *ManagedGroupTemplate) Clone() *ManagedGroupTemplate {
+	cp := proto.Clone(t.ManagedGroupTemplate)
+	return &ManagedGroupTemplate{
+		ManagedGroupTemplate: cp.(*store.ManagedGroupTemplate),
+	}
+}
+
+// TableName returns the table name.
+func (t *ManagedGroupTemplate) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultManagedGroupTemplateTableName
+}
+
+// SetTableName sets the table name.
+func (t *ManagedGroupTemplate) SetTableName(n string) {
+	t.tableName = n
+}
+
+// oplog will create oplog metadata for the ManagedGroupTemplate.
+func (t *ManagedGroupTemplate) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.GetPublicId()},
+		"resource-type":      []string{"oidc managed group template"},
+		"op-type":            []string{op.String()},
+	}
+	if t.ScopeId != "" {
+		metadata["scope-id"] = []string{t.ScopeId}
+	}
+	return metadata
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewManagedGroupTemplate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		scopeId   string
+		tmplName  string
+		filter    string
+		wantIsErr errors.Code
+	}{
+		{
+			name:     "valid",
+			scopeId:  "o_1234567890",
+			tmplName: "admins",
+			filter:   `"admin" in "/token/groups"`,
+		},
+		{
+			name:     "valid-with-auth-method-id",
+			scopeId:  "o_1234567890",
+			tmplName: "admins of {{auth_method_id}}",
+			filter:   `"{{auth_method_id}}-admin" in "/token/groups"`,
+		},
+		{
+			name:      "missing-scope-id",
+			tmplName:  "admins",
+			filter:    `"admin" in "/token/groups"`,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "missing-name",
+			scopeId:   "o_1234567890",
+			filter:    `"admin" in "/token/groups"`,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "missing-filter",
+			scopeId:   "o_1234567890",
+			tmplName:  "admins",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-filter",
+			scopeId:   "o_1234567890",
+			tmplName:  "admins",
+			filter:    `"{{auth_method_id}}" in "/token/groups" and`,
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewManagedGroupTemplate(ctx, tc.scopeId, tc.tmplName, tc.filter, WithDescription("the admins"))
+			if tc.wantIsErr != 0 {
+				require.Error(t, err)
+				assert.True(t, errors.Match(errors.T(tc.wantIsErr), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.scopeId, got.ScopeId)
+			assert.Equal(t, tc.tmplName, got.Name)
+			assert.Equal(t, "the admins", got.Description)
+			assert.Equal(t, tc.filter, got.Filter)
+			assert.Equal(t, defaultManagedGroupTemplateTableName, got.TableName())
+		})
+	}
+}
+
+func TestManagedGroupTemplate_ManagedGroup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tmpl, err := NewManagedGroupTemplate(ctx, "o_1234567890", "admins of {{auth_method_id}}",
+		`"{{auth_method_id}}-admin" in "/token/groups"`, WithDescription("from {{auth_method_id}}"))
+	require.NoError(t, err)
+
+	mg, err := tmpl.ManagedGroup(ctx, "amoidc_abcdefghij")
+	require.NoError(t, err)
+	assert.Empty(t, mg.PublicId)
+	assert.Equal(t, "amoidc_abcdefghij", mg.AuthMethodId)
+	assert.Equal(t, "admins of amoidc_abcdefghij", mg.Name)
+	assert.Equal(t, "from amoidc_abcdefghij", mg.Description)
+	assert.Equal(t, `"amoidc_abcdefghij-admin" in "/token/groups"`, mg.Filter)
+
+	_, err = tmpl.ManagedGroup(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	// The template isn't changed.
+	cp := tmpl.Clone()
+	assert.True(t, proto.Equal(tmpl.ManagedGroupTemplate, cp.ManagedGroupTemplate))
+	assert.Equal(t, `"{{auth_method_id}}-admin" in "/token/groups"`, tmpl.Filter)
+}
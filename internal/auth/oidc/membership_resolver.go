@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+func init() {
+	auth.RegisterMembershipResolver(Subtype, membershipResolver{})
+}
+
+// membershipResolver resolves the memberships of OIDC managed groups by
+// matching their filters against the claims of a login.
+type membershipResolver struct{}
+
+// ResolveMemberships implements auth.MembershipResolver. The identity holds
+// the ID token claims under "token" and the UserInfo claims under "userinfo",
+// and the filters are matched the way MatchManagedGroups matches them, with
+// the claim aliases of am.
+func (membershipResolver) ResolveMemberships(ctx context.Context, am auth.AuthMethod, mgs []auth.ManagedGroup, identity map[string]any) ([]auth.ManagedGroup, error) {
+	const op = "oidc.(membershipResolver).ResolveMemberships"
+	oidcAm, ok := am.(*AuthMethod)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("auth method is a %T, not an oidc auth method", am))
+	}
+	oidcMgs := make([]*ManagedGroup, 0, len(mgs))
+	for _, mg := range mgs {
+		oidcMg, ok := mg.(*ManagedGroup)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("managed group %s is a %T, not an oidc managed group", mg.GetPublicId(), mg))
+		}
+		oidcMgs = append(oidcMgs, oidcMg)
+	}
+	matched, err := resolveMemberships(ctx, oidcAm, oidcMgs, identity)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out := make([]auth.ManagedGroup, 0, len(matched))
+	for _, mg := range matched {
+		out = append(out, mg)
+	}
+	return out, nil
+}
+
+// resolveMemberships returns the managed groups of the auth method whose
+// filter matches evalData, expanding the claim aliases of the auth method. It
+// is what logins and membership refreshes resolve memberships with.
+func resolveMemberships(ctx context.Context, am *AuthMethod, mgs []*ManagedGroup, evalData map[string]any) ([]*ManagedGroup, error) {
+	const op = "oidc.resolveMemberships"
+	aliases, err := ParseClaimAliases(ctx, am.GetClaimAliases()...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	matched, err := MatchManagedGroups(ctx, mgs, evalData, WithClaimAliases(aliases))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return matched, nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembershipResolver(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resolver, ok := auth.MembershipResolverFor(Subtype)
+	require.True(t, ok)
+
+	am := AllocAuthMethod()
+	am.ClaimAliases = []string{"groups=/userinfo/groups"}
+	admins := AllocManagedGroup()
+	admins.PublicId = "mgoidc_1"
+	admins.Filter = `"admin" in @groups`
+	alice := AllocManagedGroup()
+	alice.PublicId = "mgoidc_2"
+	alice.Filter = `"/token/sub" == "alice"`
+	identity := map[string]any{
+		"token":    map[string]any{"sub": "bob"},
+		"userinfo": map[string]any{"groups": []any{"admin"}},
+	}
+
+	got, err := resolver.ResolveMemberships(ctx, &am, []auth.ManagedGroup{admins, alice}, identity)
+	require.NoError(t, err)
+	assert.Equal(t, []auth.ManagedGroup{admins}, got)
+
+	// Managed groups and auth methods of other subtypes aren't resolved.
+	_, err = resolver.ResolveMemberships(ctx, &am, []auth.ManagedGroup{ldap.AllocManagedGroup()}, identity)
+	require.Error(t, err)
+	ldapAm := ldap.AllocAuthMethod()
+	_, err = resolver.ResolveMemberships(ctx, &ldapAm, []auth.ManagedGroup{admins}, identity)
+	require.Error(t, err)
+}
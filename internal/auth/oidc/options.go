@@ -6,8 +6,10 @@ package oidc
 import (
 	"crypto/x509"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
 )
 
 // getOpts - iterate the inbound Options and return a struct.
@@ -24,30 +26,52 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName                string
-	withDescription         string
-	withLimit               int
-	withMaxAge              int
-	withApiUrl              *url.URL
-	withCertificates        []*x509.Certificate
-	withAudClaims           []string
-	withSigningAlgs         []Alg
-	withClaimsScopes        []string
-	withEmail               string
-	withFullName            string
-	withOrderByCreateTime   bool
-	ascending               bool
-	withUnauthenticatedUser bool
-	withForce               bool
-	withDryRun              bool
-	withAuthMethod          *AuthMethod
-	withPublicId            string
-	withRoundtripPayload    string
-	withKeyId               string
-	withIssuer              *url.URL
-	withOperationalState    AuthMethodState
-	withAccountClaimMap     map[string]AccountToClaim
-	withReader              db.Reader
+	withName                 string
+	withDescription          string
+	withLimit                int
+	withMaxAge               int
+	withApiUrl               *url.URL
+	withCertificates         []*x509.Certificate
+	withAudClaims            []string
+	withSigningAlgs          []Alg
+	withClaimsScopes         []string
+	withEmail                string
+	withFullName             string
+	withOrderByCreateTime    bool
+	ascending                bool
+	withUnauthenticatedUser  bool
+	withForce                bool
+	withDryRun               bool
+	withAuthMethod           *AuthMethod
+	withPublicId             string
+	withRoundtripPayload     string
+	withKeyId                string
+	withIssuer               *url.URL
+	withOperationalState     AuthMethodState
+	withAccountClaimMap      map[string]AccountToClaim
+	withReader               db.Reader
+	withDisabled             bool
+	withMatchCaseInsensitive bool
+	withVersion              uint32
+	withClaimAliases         map[string]string
+	withClaimTypes           map[string]string
+	withActorId              string
+	withInitialMembers       []string
+	withRoles                []*iam.Role
+	withKind                 string
+	withOwnerId              string
+	withTags                 map[string]string
+	withReplaceTags          bool
+	withAfterManagedGroupId  string
+	withAfterMemberId        string
+	withAfterCreateTime      time.Time
+	withAfterCreateMemberId  string
+	withManagedGroupIds      []string
+	withIgnoreNameCase       bool
+	withTagFilter            map[string]string
+	withNoMembers            bool
+	withInRoleIds            []string
+	withNotInRoleIds         []string
 }
 
 func getDefaultOptions() options {
@@ -166,6 +190,14 @@ func WithForce() Option {
 	}
 }
 
+// WithVersion provides an option to only perform the write operation when the
+// resource is at the given version.
+func WithVersion(v uint32) Option {
+	return func(o *options) {
+		o.withVersion = v
+	}
+}
+
 // WithDryRun provides an option to do a "dry run" of a write operation, which
 // will run verification steps and return any errors, but will not persist the
 // data into the repository.
@@ -232,3 +264,164 @@ func WithReader(reader db.Reader) Option {
 		o.withReader = reader
 	}
 }
+
+// WithDisabled provides an option for creating a managed group which is
+// disabled.
+func WithDisabled(disabled bool) Option {
+	return func(o *options) {
+		o.withDisabled = disabled
+	}
+}
+
+// WithMatchCaseInsensitive provides an option for creating a managed group
+// whose filter is matched without regard to case.
+func WithMatchCaseInsensitive(caseInsensitive bool) Option {
+	return func(o *options) {
+		o.withMatchCaseInsensitive = caseInsensitive
+	}
+}
+
+// WithClaimAliases provides an option for specifying the claim aliases of an
+// auth method, as a map from each alias to the selector it expands to. When
+// matching managed groups it provides the aliases their filters are expanded
+// with.
+func WithClaimAliases(aliases map[string]string) Option {
+	return func(o *options) {
+		o.withClaimAliases = aliases
+	}
+}
+
+// WithClaimTypes provides an option for specifying the claim types of an auth
+// method, as a map from the selector of each claim to its type.
+func WithClaimTypes(types map[string]string) Option {
+	return func(o *options) {
+		o.withClaimTypes = types
+	}
+}
+
+// WithActorId provides an option for specifying the id of the user a write
+// operation is made on behalf of. When updating a managed group it's recorded
+// as who replaced the previous version.
+func WithActorId(id string) Option {
+	return func(o *options) {
+		o.withActorId = id
+	}
+}
+
+// WithInitialMembers provides an option for specifying the ids of accounts to
+// add as members of a managed group when it is created.
+func WithInitialMembers(accountIds ...string) Option {
+	return func(o *options) {
+		o.withInitialMembers = accountIds
+	}
+}
+
+// WithRoles provides an option for specifying roles to add a managed group to
+// as a principal when it is created. The roles must be at their current
+// version.
+func WithRoles(roles ...*iam.Role) Option {
+	return func(o *options) {
+		o.withRoles = roles
+	}
+}
+
+// WithKind provides an option for creating a managed group of the given kind,
+// either auth.ManagedGroupKindLogin or auth.ManagedGroupKindSynced. When
+// listing managed groups it lists only the ones of that kind.
+func WithKind(kind string) Option {
+	return func(o *options) {
+		o.withKind = kind
+	}
+}
+
+// WithOwnerId provides an option for creating a managed group owned by the
+// given user id or principal. When listing managed groups it lists only the
+// ones it owns.
+func WithOwnerId(ownerId string) Option {
+	return func(o *options) {
+		o.withOwnerId = ownerId
+	}
+}
+
+// WithTags provides an option for replacing the tags of a managed group with
+// tags when it is created, updated or touched, in the same transaction. A nil
+// or empty tags clears them.
+func WithTags(tags map[string]string) Option {
+	return func(o *options) {
+		o.withTags = tags
+		o.withReplaceTags = true
+	}
+}
+
+// WithAfterMembership provides an option for listing the managed group
+// memberships which sort after the membership of the member with memberId in
+// the managed group with managedGroupId, ordered by managed group id and then
+// member id.
+func WithAfterMembership(managedGroupId, memberId string) Option {
+	return func(o *options) {
+		o.withAfterManagedGroupId = managedGroupId
+		o.withAfterMemberId = memberId
+	}
+}
+
+// WithAfterMember provides an option for listing the memberships of a managed
+// group which sort after the membership of the member with memberId created at
+// createTime, ordered by create time and then member id. It is meant to be
+// used along with WithOrderByCreateTime, and follows its direction.
+func WithAfterMember(createTime time.Time, memberId string) Option {
+	return func(o *options) {
+		o.withAfterCreateTime = createTime
+		o.withAfterCreateMemberId = memberId
+	}
+}
+
+// WithManagedGroupIds provides an option for restricting an operation on the
+// managed groups of an auth method to the ones with the given ids. An empty,
+// non-nil ids restricts it to none of them.
+func WithManagedGroupIds(ids []string) Option {
+	return func(o *options) {
+		o.withManagedGroupIds = ids
+	}
+}
+
+// WithIgnoreNameCase provides an option for looking up a managed group by
+// name without regard to case.
+func WithIgnoreNameCase(ignore bool) Option {
+	return func(o *options) {
+		o.withIgnoreNameCase = ignore
+	}
+}
+
+// WithTagFilter provides an option for listing only the managed groups which
+// have all of the tags.
+func WithTagFilter(tags map[string]string) Option {
+	return func(o *options) {
+		o.withTagFilter = tags
+	}
+}
+
+// WithNoMembers provides an option for listing only the managed groups which
+// have no members.
+func WithNoMembers(noMembers bool) Option {
+	return func(o *options) {
+		o.withNoMembers = noMembers
+	}
+}
+
+// WithPrincipalInRoles provides an option for listing only the managed groups
+// which are a principal in at least one of the roles with the given ids. An
+// empty, non-nil roleIds lists none of them.
+func WithPrincipalInRoles(roleIds []string) Option {
+	return func(o *options) {
+		o.withInRoleIds = roleIds
+	}
+}
+
+// WithPrincipalInNoRoles provides an option for listing only the managed
+// groups which aren't a principal in any of the roles with the given ids. An
+// empty, non-nil roleIds lists all of them.
+func WithPrincipalInNoRoles(roleIds []string) Option {
+	return func(o *options) {
+		o.withNotInRoleIds = roleIds
+	}
+}
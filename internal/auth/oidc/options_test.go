@@ -7,8 +7,11 @@ import (
 	"crypto/x509"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	iamstore "github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,6 +136,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withForce = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithVersion", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithVersion(2))
+		testOpts := getDefaultOptions()
+		testOpts.withVersion = 2
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithDryRun", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithDryRun())
@@ -206,4 +216,142 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithReader(r))
 		assert.Equal(r, opts.withReader)
 	})
+	t.Run("WithDisabled", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDisabled(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDisabled = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMatchCaseInsensitive", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMatchCaseInsensitive(true))
+		testOpts := getDefaultOptions()
+		testOpts.withMatchCaseInsensitive = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithClaimAliases", func(t *testing.T) {
+		assert := assert.New(t)
+		aliases := map[string]string{"groups": "/token/groups"}
+		opts := getOpts(WithClaimAliases(aliases))
+		testOpts := getDefaultOptions()
+		testOpts.withClaimAliases = aliases
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithClaimTypes", func(t *testing.T) {
+		assert := assert.New(t)
+		types := map[string]string{"/token/groups": ClaimTypeList}
+		opts := getOpts(WithClaimTypes(types))
+		testOpts := getDefaultOptions()
+		testOpts.withClaimTypes = types
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithActorId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithActorId("u_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withActorId = "u_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithInitialMembers", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithInitialMembers("acctoidc_1", "acctoidc_2"))
+		testOpts := getDefaultOptions()
+		testOpts.withInitialMembers = []string{"acctoidc_1", "acctoidc_2"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		role := &iam.Role{Role: &iamstore.Role{PublicId: "r_1234567890", Version: 1}}
+		opts := getOpts(WithRoles(role))
+		testOpts := getDefaultOptions()
+		testOpts.withRoles = []*iam.Role{role}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTags", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTags(map[string]string{"team": "eng"}))
+		testOpts := getDefaultOptions()
+		testOpts.withTags = map[string]string{"team": "eng"}
+		testOpts.withReplaceTags = true
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithTags(nil))
+		testOpts = getDefaultOptions()
+		testOpts.withReplaceTags = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithKind", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithKind("synced"))
+		testOpts := getDefaultOptions()
+		testOpts.withKind = "synced"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOwnerId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithOwnerId("u_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withOwnerId = "u_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAfterMembership", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAfterMembership("mgoidc_1234567890", "acctoidc_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withAfterManagedGroupId = "mgoidc_1234567890"
+		testOpts.withAfterMemberId = "acctoidc_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAfterMember", func(t *testing.T) {
+		assert := assert.New(t)
+		created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		opts := getOpts(WithAfterMember(created, "acctoidc_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withAfterCreateTime = created
+		testOpts.withAfterCreateMemberId = "acctoidc_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithManagedGroupIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithManagedGroupIds([]string{"mgoidc_1234567890"}))
+		testOpts := getDefaultOptions()
+		testOpts.withManagedGroupIds = []string{"mgoidc_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIgnoreNameCase", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIgnoreNameCase(true))
+		testOpts := getDefaultOptions()
+		testOpts.withIgnoreNameCase = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTagFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTagFilter(map[string]string{"team": "eng"}))
+		testOpts := getDefaultOptions()
+		testOpts.withTagFilter = map[string]string{"team": "eng"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNoMembers", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithNoMembers(true))
+		testOpts := getDefaultOptions()
+		testOpts.withNoMembers = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPrincipalInRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithPrincipalInRoles([]string{"r_1234567890"}))
+		testOpts := getDefaultOptions()
+		testOpts.withInRoleIds = []string{"r_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPrincipalInNoRoles", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithPrincipalInNoRoles([]string{"r_1234567890"}))
+		testOpts := getDefaultOptions()
+		testOpts.withNotInRoleIds = []string{"r_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -16,3 +16,74 @@ const (
 	returning public_id, version
        `
 )
+
+const managedGroupRoleIdsQuery = `
+	select role_id
+	  from iam_managed_group_role
+	 where principal_id = ?
+	order by role_id
+`
+
+const managedGroupCountQuery = `
+	select count(*)
+	  from auth_oidc_managed_group
+	 where %s
+`
+
+const managedGroupMemberCountsQuery = `
+	   select mg.public_id, count(m.member_id)
+	     from auth_oidc_managed_group mg
+	left join auth_oidc_managed_group_member_account m
+	       on m.managed_group_id = mg.public_id
+	    where mg.auth_method_id = ?
+	 group by mg.public_id
+`
+
+const managedGroupMembershipsByAuthMethodWhere = `
+	managed_group_id in (
+		select public_id
+		  from auth_oidc_managed_group
+		 where auth_method_id = ?
+	)
+`
+
+const managedGroupTagsQuery = `
+	select key, value
+	  from auth_managed_group_tag
+	 where managed_group_id = ?
+`
+
+const managedGroupTagsByAuthMethodQuery = `
+	select t.managed_group_id, t.key, t.value
+	  from auth_managed_group_tag t
+	  join auth_oidc_managed_group mg
+	    on mg.public_id = t.managed_group_id
+	 where mg.auth_method_id = ?
+`
+
+const managedGroupHasTagWhere = `
+	exists (
+		select 1
+		  from auth_managed_group_tag t
+		 where t.managed_group_id = auth_oidc_managed_group.public_id
+		   and t.key = ?
+		   and t.value = ?
+	)
+`
+
+const managedGroupWithoutMembersWhere = `
+	not exists (
+		select 1
+		  from auth_oidc_managed_group_member_account m
+		 where m.managed_group_id = auth_oidc_managed_group.public_id
+	)
+`
+
+const managedGroupInRolesWhere = `
+	exists (
+		select 1
+		  from iam_managed_group_role r
+		 where r.principal_id = auth_oidc_managed_group.public_id
+		   and r.role_id in (?)
+	)
+`
@@ -123,6 +123,20 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt .
 				}
 				msgs = append(msgs, accountClaimMapsOplogMsgs...)
 			}
+			if len(vo.ClaimAliases) > 0 {
+				claimAliasesOplogMsgs := make([]*oplog.Message, 0, len(vo.ClaimAliases))
+				if err := w.CreateItems(ctx, vo.ClaimAliases, db.NewOplogMsgs(&claimAliasesOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, claimAliasesOplogMsgs...)
+			}
+			if len(vo.ClaimTypes) > 0 {
+				claimTypesOplogMsgs := make([]*oplog.Message, 0, len(vo.ClaimTypes))
+				if err := w.CreateItems(ctx, vo.ClaimTypes, db.NewOplogMsgs(&claimTypesOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, claimTypesOplogMsgs...)
+			}
 			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
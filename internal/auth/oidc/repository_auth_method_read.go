@@ -167,6 +167,12 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		if agg.AccountClaimMaps != "" {
 			am.AccountClaimMaps = strings.Split(agg.AccountClaimMaps, aggregateDelimiter)
 		}
+		if agg.ClaimAliases != "" {
+			am.ClaimAliases = strings.Split(agg.ClaimAliases, aggregateDelimiter)
+		}
+		if agg.ClaimTypes != "" {
+			am.ClaimTypes = strings.Split(agg.ClaimTypes, aggregateDelimiter)
+		}
 		authMethods = append(authMethods, &am)
 	}
 	return authMethods, nil
@@ -198,6 +204,8 @@ type authMethodAgg struct {
 	Certs                             string
 	ClaimsScopes                      string
 	AccountClaimMaps                  string
+	ClaimAliases                      string
+	ClaimTypes                        string
 }
 
 // TableName returns the table name for gorm
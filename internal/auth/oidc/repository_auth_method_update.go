@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/db"
@@ -37,9 +38,18 @@ const (
 	CertificatesField                      = "Certificates"
 	ClaimsScopesField                      = "ClaimsScopes"
 	AccountClaimMapsField                  = "AccountClaimMaps"
+	ClaimAliasesField                      = "ClaimAliases"
+	ClaimTypesField                        = "ClaimTypes"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
 	KeyIdField                             = "KeyId"
+	DisabledField                          = "Disabled"
+	MatchCaseInsensitiveField              = "MatchCaseInsensitive"
+	FrozenField                            = "Frozen"
+	FilterSyntaxVersionField               = "FilterSyntaxVersion"
+	FilterHasWarningsField                 = "FilterHasWarnings"
+	FilterComplexityField                  = "FilterComplexity"
+	OwnerIdField                           = "OwnerId"
 )
 
 // UpdateAuthMethod will retrieve the auth method from the repository,
@@ -59,8 +69,8 @@ const (
 // be updated.  Fields will be set to NULL if the field is a
 // zero value and included in fieldMask. Name, Description, Issuer,
 // ClientId, ClientSecret, MaxAge are all updatable fields.  The AuthMethod's
-// Value Objects of SigningAlgs, CallbackUrls, AudClaims and Certificates are
-// also updatable. if no updatable fields are included in the fieldMaskPaths,
+// Value Objects of SigningAlgs, CallbackUrls, AudClaims, Certificates,
+// ClaimAliases and ClaimTypes are also updatable. if no updatable fields are included in the fieldMaskPaths,
 // then an error is returned.
 //
 // Options supported:
@@ -109,6 +119,8 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 			CertificatesField:     am.Certificates,
 			ClaimsScopesField:     am.ClaimsScopes,
 			AccountClaimMapsField: am.AccountClaimMaps,
+			ClaimAliasesField:     am.ClaimAliases,
+			ClaimTypesField:       am.ClaimTypes,
 		},
 		fieldMaskPaths,
 		nil,
@@ -193,10 +205,20 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		}
 	}
 
+	addAliases, deleteAliases, err := valueObjectChanges(ctx, origAm.PublicId, ClaimAliasesVO, am.ClaimAliases, origAm.ClaimAliases, dbMask, nullFields)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	addTypes, deleteTypes, err := valueObjectChanges(ctx, origAm.PublicId, ClaimTypesVO, am.ClaimTypes, origAm.ClaimTypes, dbMask, nullFields)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
 	var filteredDbMask, filteredNullFields []string
 	for _, f := range dbMask {
 		switch f {
-		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField:
+		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField, ClaimAliasesField, ClaimTypesField:
 			continue
 		default:
 			filteredDbMask = append(filteredDbMask, f)
@@ -204,7 +226,7 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	}
 	for _, f := range nullFields {
 		switch f {
-		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField:
+		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField, ClaimAliasesField, ClaimTypesField:
 			continue
 		default:
 			filteredNullFields = append(filteredNullFields, f)
@@ -223,7 +245,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		len(addScopes) == 0 &&
 		len(deleteScopes) == 0 &&
 		len(addMaps) == 0 &&
-		len(deleteMaps) == 0 {
+		len(deleteMaps) == 0 &&
+		len(addAliases) == 0 &&
+		len(deleteAliases) == 0 &&
+		len(addTypes) == 0 &&
+		len(deleteTypes) == 0 {
 		return origAm, db.NoRowsAffected, nil
 	}
 
@@ -386,6 +412,47 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 				msgs = append(msgs, addMapsOplogMsgs...)
 			}
 
+			if len(deleteAliases) > 0 {
+				if err := checkRemovedClaimAliasesUnused(ctx, reader, am.PublicId, removedClaimAliases(addAliases, deleteAliases)); err != nil {
+					return err
+				}
+				deleteAliasesOplogMsgs := make([]*oplog.Message, 0, len(deleteAliases))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteAliases, db.NewOplogMsgs(&deleteAliasesOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete claim aliases"))
+				}
+				if rowsDeleted != len(deleteAliases) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("claim aliases deleted %d did not match request for %d", rowsDeleted, len(deleteAliases)))
+				}
+				msgs = append(msgs, deleteAliasesOplogMsgs...)
+			}
+			if len(addAliases) > 0 {
+				addAliasesOplogMsgs := make([]*oplog.Message, 0, len(addAliases))
+				if err := w.CreateItems(ctx, addAliases, db.NewOplogMsgs(&addAliasesOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add claim aliases"))
+				}
+				msgs = append(msgs, addAliasesOplogMsgs...)
+			}
+
+			if len(deleteTypes) > 0 {
+				deleteTypesOplogMsgs := make([]*oplog.Message, 0, len(deleteTypes))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteTypes, db.NewOplogMsgs(&deleteTypesOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete claim types"))
+				}
+				if rowsDeleted != len(deleteTypes) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("claim types deleted %d did not match request for %d", rowsDeleted, len(deleteTypes)))
+				}
+				msgs = append(msgs, deleteTypesOplogMsgs...)
+			}
+			if len(addTypes) > 0 {
+				addTypesOplogMsgs := make([]*oplog.Message, 0, len(addTypes))
+				if err := w.CreateItems(ctx, addTypes, db.NewOplogMsgs(&addTypesOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add claim types"))
+				}
+				msgs = append(msgs, addTypesOplogMsgs...)
+			}
+
 			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -426,12 +493,14 @@ const (
 	AudClaimVO         voName = "AudClaims"
 	ClaimsScopesVO     voName = "ClaimsScopes"
 	AccountClaimMapsVO voName = "AccountClaimMaps"
+	ClaimAliasesVO     voName = "ClaimAliases"
+	ClaimTypesVO       voName = "ClaimTypes"
 )
 
 // validVoName decides if the name is valid
 func validVoName(name voName) bool {
 	switch name {
-	case SigningAlgVO, CertificateVO, AudClaimVO, ClaimsScopesVO, AccountClaimMapsVO:
+	case SigningAlgVO, CertificateVO, AudClaimVO, ClaimsScopesVO, AccountClaimMapsVO, ClaimAliasesVO, ClaimTypesVO:
 		return true
 	default:
 		return false
@@ -478,6 +547,27 @@ var supportedFactories = map[voName]factoryFunc{
 		}
 		return NewAccountClaimMap(ctx, publicId, m.From, to)
 	},
+	ClaimAliasesVO: func(ctx context.Context, publicId string, i any) (any, error) {
+		const op = "oidc.claimAliasFactory"
+		str := fmt.Sprintf("%s", i)
+		alias, selector, ok := strings.Cut(str, "=")
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim alias %q is not of the form alias=selector", str))
+		}
+		return NewClaimAlias(ctx, publicId, alias, selector)
+	},
+	ClaimTypesVO: func(ctx context.Context, publicId string, i any) (any, error) {
+		const op = "oidc.claimTypeFactory"
+		str := fmt.Sprintf("%s", i)
+		types, err := ParseClaimTypes(ctx, str)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for selector, claimType := range types {
+			return NewClaimType(ctx, publicId, selector, claimType)
+		}
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim type %q is not of the form selector=type", str))
+	},
 }
 
 // valueObjectChanges takes the new and old list of VOs (value objects) and
@@ -563,6 +653,46 @@ func valueObjectChanges(
 	return adds, deletes, nil
 }
 
+// removedClaimAliases returns the sorted names of the claim aliases which are
+// deleted and not added back with a different selector.
+func removedClaimAliases(addAliases, deleteAliases []any) []string {
+	added := map[string]bool{}
+	for _, a := range addAliases {
+		added[a.(*ClaimAlias).Alias] = true
+	}
+	var removed []string
+	for _, d := range deleteAliases {
+		if alias := d.(*ClaimAlias).Alias; !added[alias] {
+			removed = append(removed, alias)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// checkRemovedClaimAliasesUnused returns an error with the
+// errors.InvalidParameter code if a managed group of the auth method
+// references one of the removed claim aliases, since its filter could no
+// longer be expanded at login.
+func checkRemovedClaimAliasesUnused(ctx context.Context, reader db.Reader, authMethodId string, removed []string) error {
+	const op = "oidc.checkRemovedClaimAliasesUnused"
+	if len(removed) == 0 {
+		return nil
+	}
+	var mgs []*ManagedGroup
+	if err := reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []any{authMethodId}, db.WithOrder("public_id")); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list managed groups"))
+	}
+	for _, mg := range mgs {
+		for _, ref := range ClaimAliasReferences(mg.Filter) {
+			if strutil.StrListContains(removed, ref) {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("claim alias @%s is referenced by managed group %s", ref, mg.PublicId))
+			}
+		}
+	}
+	return nil
+}
+
 // validateFieldMask check the field mask to ensure all the fields are updatable
 func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 	const op = "validateFieldMask"
@@ -580,6 +710,8 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(CertificatesField, f):
 		case strings.EqualFold(ClaimsScopesField, f):
 		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(ClaimAliasesField, f):
+		case strings.EqualFold(ClaimTypesField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -646,6 +778,22 @@ func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
 				cp.AccountClaimMaps = make([]string, 0, len(new.AccountClaimMaps))
 				cp.AccountClaimMaps = append(cp.AccountClaimMaps, new.AccountClaimMaps...)
 			}
+		case ClaimAliasesField:
+			switch {
+			case len(new.ClaimAliases) == 0:
+				cp.ClaimAliases = nil
+			default:
+				cp.ClaimAliases = make([]string, 0, len(new.ClaimAliases))
+				cp.ClaimAliases = append(cp.ClaimAliases, new.ClaimAliases...)
+			}
+		case ClaimTypesField:
+			switch {
+			case len(new.ClaimTypes) == 0:
+				cp.ClaimTypes = nil
+			default:
+				cp.ClaimTypes = make([]string, 0, len(new.ClaimTypes))
+				cp.ClaimTypes = append(cp.ClaimTypes, new.ClaimTypes...)
+			}
 		}
 	}
 	return cp
@@ -655,6 +655,67 @@ func Test_DisableDiscoveredConfigValidation(t *testing.T) {
 	assert.Equal(false, pubWithoutForce.DisableDiscoveredConfigValidation)
 }
 
+func Test_UpdateAuthMethod_claimAliasInUse(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t,
+		conn, databaseWrapper,
+		org.PublicId,
+		InactiveState,
+		"alice-rp", "alice-secret",
+		WithClaimAliases(map[string]string{"groups": "/token/groups", "email": "/userinfo/email"}),
+	)
+	mg := TestManagedGroup(t, conn, am, `@groups contains "admin"`)
+
+	updateWith := am.Clone()
+	updateWith.ClaimAliases = []string{"email=/userinfo/email"}
+	_, _, err = repo.UpdateAuthMethod(ctx, updateWith, am.Version, []string{ClaimAliasesField})
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	assert.Contains(err.Error(), "claim alias @groups is referenced by managed group "+mg.PublicId)
+
+	// Changing the selector of the alias keeps it defined.
+	updateWith.ClaimAliases = []string{"groups=/userinfo/groups", "email=/userinfo/email"}
+	updated, rowsUpdated, err := repo.UpdateAuthMethod(ctx, updateWith, am.Version, []string{ClaimAliasesField})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+
+	// An alias no managed group references can be deleted.
+	updateWith = updated.Clone()
+	updateWith.ClaimAliases = []string{"groups=/userinfo/groups"}
+	_, rowsUpdated, err = repo.UpdateAuthMethod(ctx, updateWith, updated.Version, []string{ClaimAliasesField})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+}
+
+func Test_removedClaimAliases(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newAliases := func(aliases ...string) []any {
+		var out []any
+		for _, a := range aliases {
+			ca, err := NewClaimAlias(ctx, "amoidc_1234567890", a, "/token/"+a)
+			require.NoError(t, err)
+			out = append(out, ca)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"email", "roles"}, removedClaimAliases(newAliases("groups"), newAliases("roles", "groups", "email")))
+	assert.Empty(t, removedClaimAliases(newAliases("groups"), newAliases("groups")))
+	assert.Empty(t, removedClaimAliases(nil, nil))
+}
+
 func Test_ValidateDiscoveryInfo(t *testing.T) {
 	// do not run these tests with t.Parallel()
 	ctx := context.Background()
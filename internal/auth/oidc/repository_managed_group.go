@@ -6,13 +6,19 @@ package oidc
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
 
 // CreateManagedGroup inserts an ManagedGroup, mg, into the repository and
@@ -21,7 +27,20 @@ import (
 // PublicId is generated and assigned by this method.
 //
 // Both mg.Name and mg.Description are optional. If mg.Name is set, it must be
-// unique within mg.AuthMethodId.
+// unique within mg.AuthMethodId. The FilterSyntaxVersion of the created
+// ManagedGroup is the current FilterSyntaxVersion, its FilterHasWarnings is
+// whether LintManagedGroupFilter returns warnings for mg.Filter and its
+// FilterComplexity is the FilterComplexity of mg.Filter.
+//
+// WithInitialMembers is supported to add the accounts with the provided ids as
+// members of the managed group in the same transaction, whether or not they
+// match its filter, with auth.MembershipSourceSync as their source. The
+// accounts must belong to mg.AuthMethodId, otherwise an error with the code
+// RecordNotFound is returned and nothing is created.
+//
+// WithRoles is supported to add the managed group as a principal to the roles
+// in the same transaction. If it can't be added to all of them nothing is
+// created. WithTags is supported to set its tags in the same transaction.
 func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, opt ...Option) (*ManagedGroup, error) {
 	const op = "oidc.(Repository).CreateManagedGroup"
 	if mg == nil {
@@ -43,7 +62,12 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
 
+	opts := getOpts(opt...)
+
 	mg = mg.Clone()
+	mg.FilterSyntaxVersion = FilterSyntaxVersion
+	mg.FilterHasWarnings = FilterHasWarnings(ctx, mg.Filter)
+	mg.FilterComplexity = FilterComplexity(mg.Filter)
 
 	id, err := newManagedGroupId(ctx)
 	if err != nil {
@@ -58,11 +82,58 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 
 	var newManagedGroup *ManagedGroup
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newManagedGroup = mg.Clone()
 			if err := w.Create(ctx, newManagedGroup, db.WithOplog(oplogWrapper, mg.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if len(opts.withRoles) > 0 {
+				if _, err := iam.AddManagedGroupToRolesTx(ctx, reader, w, r.kms, newManagedGroup.PublicId, opts.withRoles); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add managed group to roles"))
+				}
+			}
+			if len(opts.withTags) > 0 {
+				if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, newManagedGroup.PublicId, opts.withTags, oplogWrapper, newManagedGroup.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+				}
+			}
+			if len(opts.withInitialMembers) == 0 {
+				return nil
+			}
+			var accts []*Account
+			if err := reader.SearchWhere(ctx, &accts, "auth_method_id = ? and public_id in (?)", []any{mg.AuthMethodId, opts.withInitialMembers}, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up initial members"))
+			}
+			found := make(map[string]bool, len(accts))
+			for _, acct := range accts {
+				found[acct.PublicId] = true
+			}
+			var missing []string
+			added := make(map[string]bool, len(accts))
+			toAdd := make([]any, 0, len(accts))
+			for _, acctId := range opts.withInitialMembers {
+				switch {
+				case !found[acctId]:
+					missing = append(missing, acctId)
+					continue
+				case added[acctId]:
+					continue
+				}
+				added[acctId] = true
+				newMember := AllocManagedGroupMemberAccount()
+				newMember.ManagedGroupId = newManagedGroup.PublicId
+				newMember.MemberId = acctId
+				newMember.Source = auth.MembershipSourceSync
+				toAdd = append(toAdd, newMember)
+			}
+			if len(missing) > 0 {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("accounts not found in auth method %s: %s", mg.AuthMethodId, strings.Join(missing, ", ")))
+			}
+			metadata := newManagedGroup.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId)
+			metadata["resource-type"] = []string{"oidc managed group member account"}
+			if err := w.CreateItems(ctx, toAdd, db.WithOplog(oplogWrapper, metadata)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add initial members"))
+			}
 			return nil
 		},
 	)
@@ -96,7 +167,100 @@ func (r *Repository) LookupManagedGroup(ctx context.Context, withPublicId string
 	return a, nil
 }
 
-// ListManagedGroups in an auth method and supports WithLimit option.
+// LookupManagedGroups looks up the managed groups with the provided ids in a
+// single query. Ids which don't match a managed group are left out, so fewer
+// managed groups than ids may be returned, in no particular order. All options
+// are ignored.
+func (r *Repository) LookupManagedGroups(ctx context.Context, withPublicIds []string, _ ...Option) ([]*ManagedGroup, error) {
+	const op = "oidc.(Repository).LookupManagedGroups"
+	if len(withPublicIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public ids")
+	}
+	var mgs []*ManagedGroup
+	if err := r.reader.SearchWhere(ctx, &mgs, "public_id in (?)", []any{withPublicIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
+// LookupManagedGroupByName will look up the managed group with the provided
+// name in the auth method. Names are unique and compared case-sensitively.
+// WithIgnoreNameCase compares them case-insensitively instead, in which case a
+// managed group whose name matches exactly is preferred, and an error with the
+// errors.NotUnique code is returned if several managed groups match only
+// case-insensitively. If the managed group is not found, it will return nil,
+// nil. Supports the WithIgnoreNameCase option.
+func (r *Repository) LookupManagedGroupByName(ctx context.Context, withAuthMethodId, withName string, opt ...Option) (*ManagedGroup, error) {
+	const op = "oidc.(Repository).LookupManagedGroupByName"
+	switch {
+	case withAuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case withName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	opts := getOpts(opt...)
+	where := "auth_method_id = ? and name = ?"
+	if opts.withIgnoreNameCase {
+		where = "auth_method_id = ? and lower(name) = lower(?)"
+	}
+	var mgs []*ManagedGroup
+	if err := r.reader.SearchWhere(ctx, &mgs, where, []any{withAuthMethodId, withName}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s in %s", withName, withAuthMethodId)))
+	}
+	switch len(mgs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return mgs[0], nil
+	}
+	for _, mg := range mgs {
+		if mg.GetName() == withName {
+			return mg, nil
+		}
+	}
+	return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("name %q matches %d managed groups in %s", withName, len(mgs), withAuthMethodId))
+}
+
+// managedGroupIdPrefixRe matches the prefixes LookupManagedGroupByIdPrefix
+// accepts. Ids are alphanumeric after their prefix.
+var managedGroupIdPrefixRe = regexp.MustCompile("^" + globals.OidcManagedGroupPrefix + "_[A-Za-z0-9]+$")
+
+// LookupManagedGroupByIdPrefix will look up the managed group in the auth
+// method whose public id starts with the provided prefix, e.g. an id which was
+// truncated. The prefix must be the oidc managed group id prefix followed by
+// at least one character of the id, and an error with the
+// errors.InvalidParameter code is returned otherwise. A complete id matches
+// only its own managed group. If the prefix matches more than one managed
+// group an error with the errors.NotUnique code is returned. If no managed
+// group matches, it will return nil, nil. All options are ignored.
+func (r *Repository) LookupManagedGroupByIdPrefix(ctx context.Context, withAuthMethodId, withPrefix string, _ ...Option) (*ManagedGroup, error) {
+	const op = "oidc.(Repository).LookupManagedGroupByIdPrefix"
+	switch {
+	case withAuthMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case withPrefix == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id prefix")
+	case !managedGroupIdPrefixRe.MatchString(withPrefix):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("id prefix %q is not %s_ followed by part of an id", withPrefix, globals.OidcManagedGroupPrefix))
+	}
+	var mgs []*ManagedGroup
+	// Only whether more than one matches matters.
+	if err := r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ? and starts_with(public_id, ?)", []any{withAuthMethodId, withPrefix}, db.WithLimit(2)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s in %s", withPrefix, withAuthMethodId)))
+	}
+	switch len(mgs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return mgs[0], nil
+	}
+	return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("id prefix %q matches more than one managed group in %s", withPrefix, withAuthMethodId))
+}
+
+// ListManagedGroups in an auth method and supports WithLimit option. The
+// managed groups listed can be restricted with the WithKind, WithOwnerId,
+// WithTagFilter, WithNoMembers, WithPrincipalInRoles and
+// WithPrincipalInNoRoles options.
 func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroup, error) {
 	const op = "oidc.(Repository).ListManagedGroups"
 	if withAuthMethodId == "" {
@@ -108,17 +272,141 @@ func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId str
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
+	where, args := listManagedGroupsWhere(withAuthMethodId, opts)
 	var mgs []*ManagedGroup
-	err := r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []any{withAuthMethodId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(limit))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	return mgs, nil
 }
 
+// listManagedGroupsWhere returns the where clause, and its arguments, of the
+// managed groups in the auth method which the list options in opts restrict
+// them to.
+func listManagedGroupsWhere(authMethodId string, opts options) (string, []any) {
+	where, args := []string{"auth_method_id = ?"}, []any{authMethodId}
+	if opts.withKind != "" {
+		where = append(where, "kind = ?")
+		args = append(args, opts.withKind)
+	}
+	if opts.withOwnerId != "" {
+		where = append(where, "owner_id = ?")
+		args = append(args, opts.withOwnerId)
+	}
+	// The tags are sorted so the same tags always build the same query.
+	keys := make([]string, 0, len(opts.withTagFilter))
+	for k := range opts.withTagFilter {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		where = append(where, managedGroupHasTagWhere)
+		args = append(args, k, opts.withTagFilter[k])
+	}
+	if opts.withNoMembers {
+		where = append(where, managedGroupWithoutMembersWhere)
+	}
+	if opts.withInRoleIds != nil {
+		where = append(where, managedGroupInRolesWhere)
+		args = append(args, opts.withInRoleIds)
+	}
+	if opts.withNotInRoleIds != nil {
+		where = append(where, "not "+managedGroupInRolesWhere)
+		args = append(args, opts.withNotInRoleIds)
+	}
+	return strings.Join(where, " and "), args
+}
+
+// ListManagedGroupRevisions returns the revisions of the managed group with
+// the provided id, which are the versions of it replaced by updates, newest
+// first. Supports WithLimit which overrides the limits set in the repository
+// or defaults to the repository's limit.
+func (r *Repository) ListManagedGroupRevisions(ctx context.Context, withManagedGroupId string, opt ...Option) ([]*ManagedGroupRevision, error) {
+	const op = "oidc.(Repository).ListManagedGroupRevisions"
+	if withManagedGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var revs []*ManagedGroupRevision
+	err := r.reader.SearchWhere(ctx, &revs, "managed_group_id = ?", []any{withManagedGroupId}, db.WithLimit(limit), db.WithOrder("version desc"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return revs, nil
+}
+
+// CountManagedGroups returns the number of managed groups in an auth method
+// without loading them. It supports the same options restricting the managed
+// groups as ListManagedGroups, and counts the managed groups it would list
+// without a limit.
+func (r *Repository) CountManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) (int, error) {
+	const op = "oidc.(Repository).CountManagedGroups"
+	if withAuthMethodId == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	opts := getOpts(opt...)
+	where, args := listManagedGroupsWhere(withAuthMethodId, opts)
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(managedGroupCountQuery, where), args)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return count, nil
+}
+
+// CountManagedGroupMembers returns the number of member accounts of each
+// managed group in an auth method, keyed by the managed group's public id.
+// Managed groups without members are included with a count of 0.
+func (r *Repository) CountManagedGroupMembers(ctx context.Context, withAuthMethodId string) (map[string]int, error) {
+	const op = "oidc.(Repository).CountManagedGroupMembers"
+	if withAuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rows, err := r.reader.Query(ctx, managedGroupMemberCountsQuery, []any{withAuthMethodId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	counts := make(map[string]int)
+	for rows.Next() {
+		var id string
+		var count int
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		counts[id] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
 // DeleteManagedGroup deletes the managed group for the provided id from the
-// repository returning a count of the number of records deleted. All options
-// are ignored.
+// repository returning a count of the number of records deleted. If the managed
+// group is a principal in any roles an error with the errors.Conflict code
+// listing those roles is returned and nothing is deleted, unless WithForce is
+// provided, in which case the principal associations are removed along with the
+// managed group.
+//
+// If WithVersion is provided, the managed group is only deleted when it is at
+// that version; when it is at another version an error with the
+// errors.Conflict code is returned.
 func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
 	const op = "oidc.(Repository).DeleteManagedGroup"
 	if withPublicId == "" {
@@ -135,21 +423,49 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
 	}
 
+	opts := getOpts(opt...)
+
 	var rowsDeleted int
 	_, err = r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) (err error) {
+		func(reader db.Reader, w db.Writer) (err error) {
+			if !opts.withForce {
+				roleIds, err := managedGroupRoleIds(ctx, reader, withPublicId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if len(roleIds) > 0 {
+					return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("managed group is a principal in roles: %s", strings.Join(roleIds, ", ")))
+				}
+			}
 			metadata := mg.oplog(oplog.OpType_OP_TYPE_DELETE, scopeId)
 			dMg := mg.Clone()
-			rowsDeleted, err = w.Delete(ctx, dMg, db.WithOplog(oplogWrapper, metadata))
+			deleteOpts := []db.Option{db.WithOplog(oplogWrapper, metadata)}
+			if opts.withVersion > 0 {
+				deleteOpts = append(deleteOpts, db.WithWhere("version = ?", opts.withVersion))
+			}
+			rowsDeleted, err = w.Delete(ctx, dMg, deleteOpts...)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
 			if rowsDeleted > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
 			}
+			if rowsDeleted == 0 && opts.withVersion > 0 {
+				// Nothing was deleted, either because the managed group is at
+				// another version or because it no longer exists.
+				current := AllocManagedGroup()
+				current.PublicId = withPublicId
+				if err := reader.LookupById(ctx, current); err != nil {
+					if errors.IsNotFoundError(err) {
+						return nil
+					}
+					return errors.Wrap(ctx, err, op)
+				}
+				return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("managed group is at version %d, not %d", current.Version, opts.withVersion))
+			}
 			return nil
 		},
 	)
@@ -166,12 +482,19 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 // ManagedGroup containing the updated values and a count of the number of
 // records updated. mg is not changed.
 //
-// mg must contain a valid PublicId. Only mg.Name, mg.Description, and mg.Filter
-// can be updated. If mg.Name is set to a non-empty string, it must be unique
-// within mg.AuthMethodId.
+// mg must contain a valid PublicId. Only mg.Name, mg.Description, mg.Filter,
+// mg.Disabled, mg.MatchCaseInsensitive, mg.Frozen and mg.OwnerId can be updated. If mg.Name is set to a non-empty string, it must be unique
+// within mg.AuthMethodId. Updating mg.Filter also sets its FilterSyntaxVersion
+// to the current FilterSyntaxVersion, its FilterHasWarnings to whether
+// LintManagedGroupFilter returns warnings for it and its FilterComplexity.
 //
 // An attribute of a will be set to NULL in the database if the attribute in a
 // is the zero value and it is included in fieldMaskPaths.
+//
+// The version of the managed group being replaced is recorded as a
+// ManagedGroupRevision in the same transaction. WithActorId is supported to
+// record who replaced it. WithTags is supported to replace its tags in the
+// same transaction.
 func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, version uint32, fieldMaskPaths []string, opt ...Option) (*ManagedGroup, int, error) {
 	const op = "oidc.(Repository).UpdateManagedGroup"
 	if mg == nil {
@@ -190,24 +513,43 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
 
+	var filterWritten bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(NameField, f):
 		case strings.EqualFold(DescriptionField, f):
 		case strings.EqualFold(FilterField, f):
+			filterWritten = true
+		case strings.EqualFold(DisabledField, f):
+		case strings.EqualFold(MatchCaseInsensitiveField, f):
+		case strings.EqualFold(FrozenField, f):
+		case strings.EqualFold(OwnerIdField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
+	if filterWritten {
+		// The filter being written was validated under the current syntax.
+		fieldMaskPaths = append(fieldMaskPaths[:len(fieldMaskPaths):len(fieldMaskPaths)], FilterSyntaxVersionField, FilterHasWarningsField, FilterComplexityField)
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			NameField:        mg.Name,
-			DescriptionField: mg.Description,
-			FilterField:      mg.Filter,
+			NameField:                 mg.Name,
+			DescriptionField:          mg.Description,
+			FilterField:               mg.Filter,
+			DisabledField:             mg.Disabled,
+			MatchCaseInsensitiveField: mg.MatchCaseInsensitive,
+			FrozenField:               mg.Frozen,
+			FilterSyntaxVersionField:  FilterSyntaxVersion,
+			FilterHasWarningsField:    FilterHasWarnings(ctx, mg.Filter),
+			FilterComplexityField:     FilterComplexity(mg.Filter),
+			OwnerIdField:              mg.OwnerId,
 		},
 		fieldMaskPaths,
-		nil,
+		// the booleans and the complexity aren't nullable, so the zero value
+		// is written rather than cleared
+		[]string{DisabledField, MatchCaseInsensitiveField, FrozenField, FilterHasWarningsField, FilterComplexityField},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
@@ -219,7 +561,13 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 			errors.WithMsg(("unable to get oplog wrapper")))
 	}
 
+	opts := getOpts(opt...)
 	mg = mg.Clone()
+	if filterWritten {
+		mg.FilterSyntaxVersion = FilterSyntaxVersion
+		mg.FilterHasWarnings = FilterHasWarnings(ctx, mg.Filter)
+		mg.FilterComplexity = FilterComplexity(mg.Filter)
+	}
 
 	metadata := mg.oplog(oplog.OpType_OP_TYPE_UPDATE, scopeId)
 
@@ -228,7 +576,12 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 	var rowsUpdated int
 	var returnedManagedGroup *ManagedGroup
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
+			replaced := AllocManagedGroup()
+			replaced.PublicId = mg.PublicId
+			if err := reader.LookupByPublicId(ctx, replaced); err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op)
+			}
 			returnedManagedGroup = mg.Clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedManagedGroup, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
@@ -238,6 +591,16 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				if err := w.Create(ctx, newManagedGroupRevision(replaced, opts.withActorId)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write managed group revision"))
+				}
+				if opts.withReplaceTags {
+					if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, replaced.PublicId, opts.withTags, oplogWrapper, metadata); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+					}
+				}
+			}
 			return nil
 		},
 	)
@@ -252,3 +615,290 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 
 	return returnedManagedGroup, rowsUpdated, nil
 }
+
+// TouchManagedGroup increments the version of the managed group with the
+// provided id, which also sets its update time, without changing any of its
+// other fields. It returns the touched ManagedGroup and a count of the number
+// of records updated, which is 0 when the managed group doesn't exist or isn't
+// at version.
+//
+// The version of the managed group being replaced is recorded as a
+// ManagedGroupRevision in the same transaction. WithActorId is supported to
+// record who replaced it. WithTags is supported to replace its tags in the
+// same transaction, for an update of only its tags.
+func (r *Repository) TouchManagedGroup(ctx context.Context, scopeId, withPublicId string, version uint32, opt ...Option) (*ManagedGroup, int, error) {
+	const op = "oidc.(Repository).TouchManagedGroup"
+	switch {
+	case withPublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case scopeId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
+			errors.WithMsg(("unable to get oplog wrapper")))
+	}
+
+	opts := getOpts(opt...)
+	mg := AllocManagedGroup()
+	mg.PublicId = withPublicId
+	metadata := mg.oplog(oplog.OpType_OP_TYPE_UPDATE, scopeId)
+
+	var rowsUpdated int
+	var returnedManagedGroup *ManagedGroup
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			replaced := AllocManagedGroup()
+			replaced.PublicId = withPublicId
+			if err := reader.LookupByPublicId(ctx, replaced); err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op)
+			}
+			// Writing the next version is the change; the update time
+			// follows from it.
+			returnedManagedGroup = mg.Clone()
+			returnedManagedGroup.Version = version + 1
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedManagedGroup, []string{"Version"}, nil, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if rowsUpdated == 1 {
+				if err := w.Create(ctx, newManagedGroupRevision(replaced, opts.withActorId)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write managed group revision"))
+				}
+				if opts.withReplaceTags {
+					if err := auth.ReplaceManagedGroupTagsTx(ctx, reader, w, replaced.PublicId, opts.withTags, oplogWrapper, metadata); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set managed group tags"))
+					}
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(withPublicId))
+	}
+	return returnedManagedGroup, rowsUpdated, nil
+}
+
+// ManagedGroupVersion identifies a managed group, in the scope of its auth
+// method, at the version it is expected to be at.
+type ManagedGroupVersion struct {
+	ScopeId  string
+	PublicId string
+	Version  uint32
+}
+
+// SetManagedGroupsDisabled sets the Disabled field of each of the managed
+// groups to disabled in a single transaction, and returns them as updated in
+// the order they were provided. Each managed group must exist and be at its
+// Version, otherwise none of them are updated and an error with the
+// errors.VersionMismatch code is returned.
+//
+// The version of each managed group being replaced is recorded as a
+// ManagedGroupRevision in the same transaction. WithActorId is supported to
+// record who replaced them.
+func (r *Repository) SetManagedGroupsDisabled(ctx context.Context, disabled bool, mgs []ManagedGroupVersion, opt ...Option) ([]*ManagedGroup, error) {
+	const op = "oidc.(Repository).SetManagedGroupsDisabled"
+	if len(mgs) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing managed groups")
+	}
+	seen := make(map[string]bool, len(mgs))
+	oplogWrappers := map[string]wrapping.Wrapper{}
+	for _, mg := range mgs {
+		switch {
+		case mg.PublicId == "":
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+		case mg.Version == 0:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("missing version of managed group %s", mg.PublicId))
+		case mg.ScopeId == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("missing scope id of managed group %s", mg.PublicId))
+		case seen[mg.PublicId]:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("managed group %s is provided more than once", mg.PublicId))
+		}
+		seen[mg.PublicId] = true
+		if _, ok := oplogWrappers[mg.ScopeId]; ok {
+			continue
+		}
+		oplogWrapper, err := r.kms.GetWrapper(ctx, mg.ScopeId, kms.KeyPurposeOplog)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
+				errors.WithMsg(("unable to get oplog wrapper")))
+		}
+		oplogWrappers[mg.ScopeId] = oplogWrapper
+	}
+
+	opts := getOpts(opt...)
+	var returnedManagedGroups []*ManagedGroup
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedManagedGroups = make([]*ManagedGroup, 0, len(mgs))
+			for _, mg := range mgs {
+				replaced := AllocManagedGroup()
+				replaced.PublicId = mg.PublicId
+				if err := reader.LookupByPublicId(ctx, replaced); err != nil && !errors.IsNotFoundError(err) {
+					return errors.Wrap(ctx, err, op)
+				}
+				updated := AllocManagedGroup()
+				updated.PublicId = mg.PublicId
+				updated.Disabled = disabled
+				version := mg.Version
+				rowsUpdated, err := w.Update(ctx, updated, []string{DisabledField}, nil,
+					db.WithOplog(oplogWrappers[mg.ScopeId], updated.oplog(oplog.OpType_OP_TYPE_UPDATE, mg.ScopeId)), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(mg.PublicId))
+				}
+				switch {
+				case rowsUpdated == 0:
+					return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("managed group %s doesn't exist or isn't at version %d", mg.PublicId, mg.Version))
+				case rowsUpdated > 1:
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+				}
+				if err := w.Create(ctx, newManagedGroupRevision(replaced, opts.withActorId)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write managed group revision"))
+				}
+				returnedManagedGroups = append(returnedManagedGroups, updated)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedManagedGroups, nil
+}
+
+// UpsertManagedGroup creates mg if no managed group with mg.Name exists in
+// mg.AuthMethodId, otherwise it updates the description and filter of the
+// existing managed group to match mg. The lookup and the write happen in the
+// same transaction and the version of the existing managed group is used, so
+// callers don't need to track it. It returns the resulting ManagedGroup and
+// whether it was created. mg is not changed. When the existing managed group is
+// updated, the version it replaces is recorded as a ManagedGroupRevision.
+// WithActorId is supported to record who replaced it.
+//
+// mg must contain a valid AuthMethodId, a Name and a Filter. mg must not
+// contain a PublicId.
+func (r *Repository) UpsertManagedGroup(ctx context.Context, scopeId string, mg *ManagedGroup, opt ...Option) (*ManagedGroup, bool, error) {
+	const op = "oidc.(Repository).UpsertManagedGroup"
+	switch {
+	case mg == nil:
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing ManagedGroup")
+	case mg.ManagedGroup == nil:
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing embedded ManagedGroup")
+	case mg.AuthMethodId == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case mg.Name == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	case mg.Filter == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing filter")
+	case mg.PublicId != "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	case scopeId == "":
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	opts := getOpts(opt...)
+	mg = mg.Clone()
+	mg.FilterSyntaxVersion = FilterSyntaxVersion
+	mg.FilterHasWarnings = FilterHasWarnings(ctx, mg.Filter)
+	mg.FilterComplexity = FilterComplexity(mg.Filter)
+	newId, err := newManagedGroupId(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"), errors.WithCode(errors.Encrypt))
+	}
+
+	var created bool
+	var returnedManagedGroup *ManagedGroup
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			found := AllocManagedGroup()
+			err := reader.LookupWhere(ctx, found, "auth_method_id = ? and name = ?", []any{mg.AuthMethodId, mg.Name})
+			switch {
+			case errors.IsNotFoundError(err):
+				created = true
+				returnedManagedGroup = mg.Clone()
+				returnedManagedGroup.PublicId = newId
+				if err := w.Create(ctx, returnedManagedGroup, db.WithOplog(oplogWrapper, returnedManagedGroup.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId))); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				return nil
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			}
+
+			created = false
+			returnedManagedGroup = mg.Clone()
+			returnedManagedGroup.PublicId = found.PublicId
+			dbMask, nullFields := dbw.BuildUpdatePaths(
+				map[string]any{
+					DescriptionField:         returnedManagedGroup.Description,
+					FilterField:              returnedManagedGroup.Filter,
+					FilterSyntaxVersionField: returnedManagedGroup.FilterSyntaxVersion,
+					FilterHasWarningsField:   returnedManagedGroup.FilterHasWarnings,
+					FilterComplexityField:    returnedManagedGroup.FilterComplexity,
+				},
+				[]string{DescriptionField, FilterField, FilterSyntaxVersionField, FilterHasWarningsField, FilterComplexityField},
+				[]string{FilterHasWarningsField, FilterComplexityField},
+			)
+			version := found.Version
+			rowsUpdated, err := w.Update(ctx, returnedManagedGroup, dbMask, nullFields, db.WithOplog(oplogWrapper, returnedManagedGroup.oplog(oplog.OpType_OP_TYPE_UPDATE, scopeId)), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 managed group to be updated, got %d", rowsUpdated))
+			}
+			if err := w.Create(ctx, newManagedGroupRevision(found, opts.withActorId)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write managed group revision"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, false, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf(
+				"in auth method %s: name %q already exists",
+				mg.AuthMethodId, mg.Name))
+		}
+		return nil, false, errors.Wrap(ctx, err, op, errors.WithMsg(mg.AuthMethodId))
+	}
+	return returnedManagedGroup, created, nil
+}
+
+// managedGroupRoleIds returns the ids of the roles the managed group is a
+// principal in, ordered by id.
+func managedGroupRoleIds(ctx context.Context, reader db.Reader, managedGroupId string) ([]string, error) {
+	const op = "oidc.managedGroupRoleIds"
+	rows, err := reader.Query(ctx, managedGroupRoleIdsQuery, []any{managedGroupId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var roleIds []string
+	for rows.Next() {
+		var result struct {
+			RoleId string
+		}
+		if err := reader.ScanRows(ctx, rows, &result); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		roleIds = append(roleIds, result.RoleId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return roleIds, nil
+}
@@ -5,8 +5,11 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -166,6 +169,7 @@ func (r *Repository) SetManagedGroupMemberships(ctx context.Context, am *AuthMet
 					newMg := AllocManagedGroupMemberAccount()
 					newMg.ManagedGroupId = mgId
 					newMg.MemberId = acct.PublicId
+					newMg.Source = auth.MembershipSourceLogin
 					toAdd = append(toAdd, newMg)
 				}
 				if err := w.CreateItems(ctx, toAdd, db.NewOplogMsgs(&addOplogMsgs)); err != nil {
@@ -193,6 +197,265 @@ func (r *Repository) SetManagedGroupMemberships(ctx context.Context, am *AuthMet
 	return currentMemberships, totalRowsAffected, nil
 }
 
+// keepFrozenMemberships returns the managed groups of mgs the account is to
+// be a member of once it matched the managed groups in matched. Frozen and
+// synced managed groups keep their current members whatever matched: they are
+// only returned if the account is already one of their members.
+func (r *Repository) keepFrozenMemberships(ctx context.Context, acct *Account, mgs, matched []*ManagedGroup) ([]*ManagedGroup, error) {
+	const op = "oidc.(Repository).keepFrozenMemberships"
+	frozen := make(map[string]*ManagedGroup)
+	for _, mg := range mgs {
+		if mg.keepsMembers() {
+			frozen[mg.PublicId] = mg
+		}
+	}
+	if len(frozen) == 0 {
+		return matched, nil
+	}
+	out := make([]*ManagedGroup, 0, len(matched))
+	for _, mg := range matched {
+		if _, ok := frozen[mg.PublicId]; !ok {
+			out = append(out, mg)
+		}
+	}
+	current, err := r.ListManagedGroupMembershipsByMember(ctx, acct.PublicId, WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current managed group memberships"))
+	}
+	for _, m := range current {
+		if mg, ok := frozen[m.ManagedGroupId]; ok {
+			out = append(out, mg)
+		}
+	}
+	return out, nil
+}
+
+// ManagedGroupRefresh is the change RefreshManagedGroupMemberships made to the
+// memberships of a single managed group.
+type ManagedGroupRefresh struct {
+	ManagedGroupId string
+	Added          int
+	Removed        int
+}
+
+// RefreshManagedGroupMemberships re-evaluates the filter of every managed
+// group in the auth method against the claims cached on each of its accounts
+// when they last logged in, and adds and removes memberships to match. It
+// runs in a single transaction and returns the change made to each managed
+// group whose memberships changed, ordered by managed group id. The managed
+// groups' filters are expanded with the claim aliases of am. The memberships
+// of frozen and synced managed groups are left as they are.
+//
+// As with SetManagedGroupMemberships, the version of each managed group whose
+// memberships change is incremented, so that a filter updated concurrently
+// fails the refresh rather than leaving memberships computed from the old
+// filter. Managed groups whose memberships are already up to date are left
+// unchanged.
+//
+// Supported options: WithManagedGroupIds, which refreshes only the managed
+// groups with the given ids.
+func (r *Repository) RefreshManagedGroupMemberships(ctx context.Context, am *AuthMethod, opt ...Option) ([]*ManagedGroupRefresh, error) {
+	const op = "oidc.(Repository).RefreshManagedGroupMemberships"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case am.AuthMethod == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	case am.PublicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case am.ScopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method scope id")
+	}
+	opts := getOpts(opt...)
+	if opts.withManagedGroupIds != nil && len(opts.withManagedGroupIds) == 0 {
+		return nil, nil
+	}
+	aliases, err := ParseClaimAliases(ctx, am.ClaimAliases...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var refreshed []*ManagedGroupRefresh
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			refreshed = nil
+			where, args := "auth_method_id = ?", []any{am.PublicId}
+			if opts.withManagedGroupIds != nil {
+				where, args = "auth_method_id = ? and public_id in (?)", append(args, opts.withManagedGroupIds)
+			}
+			var mgs []*ManagedGroup
+			if err := reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list managed groups"))
+			}
+			if len(mgs) == 0 {
+				return nil
+			}
+			var accts []*Account
+			if err := reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []any{am.PublicId}, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list accounts"))
+			}
+			var currentMemberships []*ManagedGroupMemberAccount
+			if err := reader.SearchWhere(ctx, &currentMemberships, managedGroupMembershipsByAuthMethodWhere, []any{am.PublicId}, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list current managed group memberships"))
+			}
+
+			refreshedIds := make(map[string]bool, len(mgs))
+			frozen := make(map[string]bool)
+			for _, mg := range mgs {
+				refreshedIds[mg.PublicId] = true
+				if mg.keepsMembers() {
+					frozen[mg.PublicId] = true
+				}
+			}
+
+			type membership struct{ managedGroupId, memberId string }
+			stale := make(map[membership]bool, len(currentMemberships))
+			for _, m := range currentMemberships {
+				if refreshedIds[m.ManagedGroupId] && !frozen[m.ManagedGroupId] {
+					stale[membership{m.ManagedGroupId, m.MemberId}] = true
+				}
+			}
+			changes := make(map[string]*ManagedGroupRefresh)
+			change := func(managedGroupId string) *ManagedGroupRefresh {
+				c, ok := changes[managedGroupId]
+				if !ok {
+					c = &ManagedGroupRefresh{ManagedGroupId: managedGroupId}
+					changes[managedGroupId] = c
+				}
+				return c
+			}
+			var toAdd []any
+			for _, acct := range accts {
+				evalData, err := accountEvalData(acct)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to decode cached claims of account %s", acct.PublicId)))
+				}
+				matched, err := MatchManagedGroups(ctx, mgs, evalData, WithClaimAliases(aliases))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				for _, mg := range matched {
+					if frozen[mg.PublicId] {
+						continue
+					}
+					m := membership{mg.PublicId, acct.PublicId}
+					if stale[m] {
+						delete(stale, m)
+						continue
+					}
+					newMember := AllocManagedGroupMemberAccount()
+					newMember.ManagedGroupId = mg.PublicId
+					newMember.MemberId = acct.PublicId
+					newMember.Source = auth.MembershipSourceLogin
+					toAdd = append(toAdd, newMember)
+					change(mg.PublicId).Added++
+				}
+			}
+			toDelete := make([]any, 0, len(stale))
+			for m := range stale {
+				delMember := AllocManagedGroupMemberAccount()
+				delMember.ManagedGroupId = m.managedGroupId
+				delMember.MemberId = m.memberId
+				toDelete = append(toDelete, delMember)
+				change(m.managedGroupId).Removed++
+			}
+			if len(changes) == 0 {
+				return nil
+			}
+
+			mgTicket, err := w.GetTicket(ctx, AllocManagedGroup())
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket for oidc managed groups"))
+			}
+			msgs := make([]*oplog.Message, 0, len(changes)+len(toAdd)+len(toDelete))
+			metadata := oplog.Metadata{
+				"op-type":        []string{oplog.OpType_OP_TYPE_UPDATE.String()},
+				"scope-id":       []string{am.ScopeId},
+				"auth-method-id": []string{am.PublicId},
+			}
+			for _, mg := range mgs {
+				if changes[mg.PublicId] == nil {
+					continue
+				}
+				mgToUpdate := AllocManagedGroup()
+				mgToUpdate.PublicId = mg.PublicId
+				mgToUpdate.AuthMethodId = am.PublicId
+				mgToUpdate.Version = mg.Version + 1
+				var mgOplogMsg oplog.Message
+				rowsUpdated, err := w.Update(ctx, mgToUpdate, []string{"Version"}, nil, db.NewOplogMsg(&mgOplogMsg), db.WithVersion(&mg.Version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated oidc managed group and %d rows updated", rowsUpdated))
+				}
+				msgs = append(msgs, &mgOplogMsg)
+			}
+			if len(toDelete) > 0 {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+				deleteOplogMsgs := make([]*oplog.Message, 0, len(toDelete))
+				rowsDeleted, err := w.DeleteItems(ctx, toDelete, db.NewOplogMsgs(&deleteOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete managed group member accounts"))
+				}
+				if rowsDeleted != len(toDelete) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("managed group member accounts deleted %d did not match request for %d", rowsDeleted, len(toDelete)))
+				}
+				msgs = append(msgs, deleteOplogMsgs...)
+			}
+			if len(toAdd) > 0 {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+				addOplogMsgs := make([]*oplog.Message, 0, len(toAdd))
+				if err := w.CreateItems(ctx, toAdd, db.NewOplogMsgs(&addOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add managed group member accounts"))
+				}
+				msgs = append(msgs, addOplogMsgs...)
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, mgTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			for _, c := range changes {
+				refreshed = append(refreshed, c)
+			}
+			sort.Slice(refreshed, func(i, j int) bool {
+				return refreshed[i].ManagedGroupId < refreshed[j].ManagedGroupId
+			})
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return refreshed, nil
+}
+
+// accountEvalData returns the claims cached on the account in the form
+// managed group filters are evaluated against at login.
+func accountEvalData(acct *Account) (map[string]any, error) {
+	tokenClaims, userinfoClaims := map[string]any{}, map[string]any{}
+	if acct.TokenClaims != "" {
+		if err := json.Unmarshal([]byte(acct.TokenClaims), &tokenClaims); err != nil {
+			return nil, err
+		}
+	}
+	if acct.UserinfoClaims != "" {
+		if err := json.Unmarshal([]byte(acct.UserinfoClaims), &userinfoClaims); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]any{
+		"token":    tokenClaims,
+		"userinfo": userinfoClaims,
+	}, nil
+}
+
 // ListManagedGroupMembershipsByMember lists managed group memberships via the
 // member (account) ID and supports WithLimit option.
 func (r *Repository) ListManagedGroupMembershipsByMember(ctx context.Context, withAcctId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
@@ -218,8 +481,34 @@ func (r *Repository) ListManagedGroupMembershipsByMember(ctx context.Context, wi
 	return mgs, nil
 }
 
+// ListManagedGroupsByMember lists the managed groups the member (account) is
+// currently a member of, ordered by id, and supports WithLimit option.
+func (r *Repository) ListManagedGroupsByMember(ctx context.Context, withAcctId string, opt ...Option) ([]*ManagedGroup, error) {
+	const op = "oidc.(Repository).ListManagedGroupsByMember"
+	if withAcctId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var mgs []*ManagedGroup
+	err := r.reader.SearchWhere(ctx, &mgs,
+		"public_id in (select managed_group_id from auth_oidc_managed_group_member_account where member_id = ?)",
+		[]any{withAcctId}, db.WithLimit(limit), db.WithOrder("public_id"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
 // ListManagedGroupMembershipsByGroup lists managed group memberships via the
-// group ID and supports WithLimit option.
+// group ID, ordered by member id, and supports WithLimit option. With
+// WithOrderByCreateTime they're ordered by create time and then member id
+// instead, and WithAfterMember lists the ones after a membership in that
+// order.
 func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, withGroupId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
 	const op = "oidc.(Repository).ListManagedGroupMembershipsByGroup"
 	if withGroupId == "" {
@@ -235,8 +524,50 @@ func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, wit
 	if opts.withReader != nil {
 		reader = opts.withReader
 	}
+	where, args := "managed_group_id = ?", []any{withGroupId}
+	order := "member_id"
+	if opts.withOrderByCreateTime {
+		cmp := ">"
+		order = "create_time, member_id"
+		if !opts.ascending {
+			cmp = "<"
+			order = "create_time desc, member_id desc"
+		}
+		if opts.withAfterCreateMemberId != "" {
+			where += fmt.Sprintf(" and (create_time, member_id) %s (?, ?)", cmp)
+			args = append(args, opts.withAfterCreateTime, opts.withAfterCreateMemberId)
+		}
+	}
+	var mgs []*ManagedGroupMemberAccount
+	err := reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(limit), db.WithOrder(order))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
+// ListManagedGroupMembershipsByAuthMethod lists the memberships of the managed
+// groups of the auth method, ordered by managed group id and then member id,
+// and supports WithLimit and WithAfterMembership options.
+func (r *Repository) ListManagedGroupMembershipsByAuthMethod(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
+	const op = "oidc.(Repository).ListManagedGroupMembershipsByAuthMethod"
+	if withAuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	where, args := managedGroupMembershipsByAuthMethodWhere, []any{withAuthMethodId}
+	if opts.withAfterManagedGroupId != "" {
+		where += " and (managed_group_id, member_id) > (?, ?)"
+		args = append(args, opts.withAfterManagedGroupId, opts.withAfterMemberId)
+	}
 	var mgs []*ManagedGroupMemberAccount
-	err := reader.SearchWhere(ctx, &mgs, "managed_group_id = ?", []any{withGroupId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &mgs, where, args,
+		db.WithLimit(limit), db.WithOrder("managed_group_id, member_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -6,9 +6,11 @@ package oidc_test
 import (
 	"context"
 	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller"
@@ -271,6 +273,7 @@ func Test_ManagedGroupMemberships(t *testing.T) {
 					}
 				}
 				assert.True(found)
+				assert.Equal(auth.MembershipSourceLogin, mship.Source)
 				assert.Contains(finalMgs, mship.ManagedGroupId)
 				delete(finalMgs, mship.ManagedGroupId)
 			}
@@ -292,3 +295,306 @@ func Test_ManagedGroupMemberships(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_RefreshManagedGroupMemberships(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	admins := oidc.TestManagedGroup(t, conn, authMethod, `"/token/groups" contains "admin"`)
+	devs := oidc.TestManagedGroup(t, conn, authMethod, `"/userinfo/team" == "dev"`)
+
+	withClaims := func(subject, tokenClaims, userinfoClaims string) *oidc.Account {
+		acct := oidc.TestAccount(t, conn, authMethod, subject)
+		acct.TokenClaims, acct.UserinfoClaims = tokenClaims, userinfoClaims
+		_, err := rw.Update(ctx, acct, []string{"TokenClaims", "UserinfoClaims"}, nil)
+		require.NoError(t, err)
+		return acct
+	}
+	alice := withClaims("alice", `{"groups":["admin"]}`, `{"team":"dev"}`)
+	bob := withClaims("bob", `{"groups":["user"]}`, `{"team":"dev"}`)
+	// carol has never logged in, so has no cached claims
+	carol := oidc.TestAccount(t, conn, authMethod, "carol")
+	// carol's membership is stale and bob is missing from devs
+	oidc.TestManagedGroupMember(t, conn, admins.GetPublicId(), alice.GetPublicId())
+	oidc.TestManagedGroupMember(t, conn, admins.GetPublicId(), carol.GetPublicId())
+
+	repo, err := oidc.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	refreshed, err := repo.RefreshManagedGroupMemberships(ctx, authMethod)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*oidc.ManagedGroupRefresh{
+		{ManagedGroupId: admins.GetPublicId(), Removed: 1},
+		{ManagedGroupId: devs.GetPublicId(), Added: 2},
+	}, refreshed)
+
+	memberIds := func(mgId string) []string {
+		memberships, err := repo.ListManagedGroupMembershipsByGroup(ctx, mgId)
+		require.NoError(t, err)
+		var ids []string
+		for _, m := range memberships {
+			ids = append(ids, m.GetMemberId())
+		}
+		return ids
+	}
+	assert.ElementsMatch(t, []string{alice.GetPublicId()}, memberIds(admins.GetPublicId()))
+	assert.ElementsMatch(t, []string{alice.GetPublicId(), bob.GetPublicId()}, memberIds(devs.GetPublicId()))
+
+	version := func(mgId string) uint32 {
+		mg, err := repo.LookupManagedGroup(ctx, mgId)
+		require.NoError(t, err)
+		return mg.GetVersion()
+	}
+	assert.Equal(t, admins.GetVersion()+1, version(admins.GetPublicId()))
+	assert.Equal(t, devs.GetVersion()+1, version(devs.GetPublicId()))
+
+	// Refreshing again changes nothing, not even the versions.
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod)
+	require.NoError(t, err)
+	assert.Empty(t, refreshed)
+	assert.Equal(t, admins.GetVersion()+1, version(admins.GetPublicId()))
+
+	// Only the managed groups with the given ids are refreshed.
+	oidc.TestManagedGroupMember(t, conn, admins.GetPublicId(), carol.GetPublicId())
+	oidc.TestManagedGroupMember(t, conn, devs.GetPublicId(), carol.GetPublicId())
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod, oidc.WithManagedGroupIds([]string{admins.GetPublicId()}))
+	require.NoError(t, err)
+	assert.Equal(t, []*oidc.ManagedGroupRefresh{{ManagedGroupId: admins.GetPublicId(), Removed: 1}}, refreshed)
+	assert.ElementsMatch(t, []string{alice.GetPublicId(), bob.GetPublicId(), carol.GetPublicId()}, memberIds(devs.GetPublicId()))
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod, oidc.WithManagedGroupIds([]string{}))
+	require.NoError(t, err)
+	assert.Empty(t, refreshed)
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod)
+	require.NoError(t, err)
+	assert.Equal(t, []*oidc.ManagedGroupRefresh{{ManagedGroupId: devs.GetPublicId(), Removed: 1}}, refreshed)
+
+	// The members of a frozen managed group stay as they are even though its
+	// filter no longer matches them.
+	frozen, err := repo.LookupManagedGroup(ctx, devs.GetPublicId())
+	require.NoError(t, err)
+	frozen.Frozen = true
+	frozen.Filter = `"/userinfo/team" == "ops"`
+	_, _, err = repo.UpdateManagedGroup(ctx, org.GetPublicId(), frozen, frozen.GetVersion(), []string{"Frozen", "Filter"})
+	require.NoError(t, err)
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod)
+	require.NoError(t, err)
+	assert.Empty(t, refreshed)
+	assert.ElementsMatch(t, []string{alice.GetPublicId(), bob.GetPublicId()}, memberIds(devs.GetPublicId()))
+
+	// Likewise for a synced managed group, whose members aren't matched
+	// against its filter.
+	synced := oidc.TestManagedGroup(t, conn, authMethod, `"/token/groups" contains "admin"`, oidc.WithKind("synced"))
+	oidc.TestManagedGroupMember(t, conn, synced.GetPublicId(), bob.GetPublicId())
+	refreshed, err = repo.RefreshManagedGroupMemberships(ctx, authMethod)
+	require.NoError(t, err)
+	assert.Empty(t, refreshed)
+	assert.ElementsMatch(t, []string{bob.GetPublicId()}, memberIds(synced.GetPublicId()))
+
+	_, err = repo.RefreshManagedGroupMemberships(ctx, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_ListManagedGroupsByMember(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	alice := oidc.TestAccount(t, conn, authMethod, "alice")
+	bob := oidc.TestAccount(t, conn, authMethod, "bob")
+	var aliceGroupIds []string
+	for i := 0; i < 3; i++ {
+		mg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), alice.GetPublicId())
+		aliceGroupIds = append(aliceGroupIds, mg.GetPublicId())
+	}
+	sort.Strings(aliceGroupIds)
+	bobGroup := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, bobGroup.GetPublicId(), bob.GetPublicId())
+
+	repo, err := oidc.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	groupIds := func(mgs []*oidc.ManagedGroup) []string {
+		var ids []string
+		for _, mg := range mgs {
+			assert.Equal(t, authMethod.GetPublicId(), mg.GetAuthMethodId())
+			ids = append(ids, mg.GetPublicId())
+		}
+		return ids
+	}
+	got, err := repo.ListManagedGroupsByMember(ctx, alice.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, aliceGroupIds, groupIds(got))
+
+	got, err = repo.ListManagedGroupsByMember(ctx, alice.GetPublicId(), oidc.WithLimit(2))
+	require.NoError(t, err)
+	assert.Equal(t, aliceGroupIds[:2], groupIds(got))
+
+	got, err = repo.ListManagedGroupsByMember(ctx, bob.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, []string{bobGroup.GetPublicId()}, groupIds(got))
+
+	carol := oidc.TestAccount(t, conn, authMethod, "carol")
+	got, err = repo.ListManagedGroupsByMember(ctx, carol.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = repo.ListManagedGroupsByMember(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_ListManagedGroupMembershipsByAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	otherAuthMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"bob-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.bob.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.bob.com/callback")[0]),
+	)
+	alice := oidc.TestAccount(t, conn, authMethod, "alice")
+	bob := oidc.TestAccount(t, conn, authMethod, "bob")
+	type membership struct{ groupId, memberId string }
+	var want []membership
+	for i := 0; i < 3; i++ {
+		mg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+		for _, acct := range []*oidc.Account{alice, bob}[:i] {
+			oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), acct.GetPublicId())
+			want = append(want, membership{mg.GetPublicId(), acct.GetPublicId()})
+		}
+	}
+	sort.Slice(want, func(i, j int) bool {
+		if want[i].groupId != want[j].groupId {
+			return want[i].groupId < want[j].groupId
+		}
+		return want[i].memberId < want[j].memberId
+	})
+	otherGroup := oidc.TestManagedGroup(t, conn, otherAuthMethod, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, otherGroup.GetPublicId(), oidc.TestAccount(t, conn, otherAuthMethod, "carol").GetPublicId())
+
+	repo, err := oidc.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	memberships := func(ms []*oidc.ManagedGroupMemberAccount) []membership {
+		var out []membership
+		for _, m := range ms {
+			assert.NotNil(t, m.GetCreateTime())
+			out = append(out, membership{m.GetManagedGroupId(), m.GetMemberId()})
+		}
+		return out
+	}
+	got, err := repo.ListManagedGroupMembershipsByAuthMethod(ctx, authMethod.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, want, memberships(got))
+
+	got, err = repo.ListManagedGroupMembershipsByAuthMethod(ctx, authMethod.GetPublicId(), oidc.WithLimit(2))
+	require.NoError(t, err)
+	assert.Equal(t, want[:2], memberships(got))
+
+	got, err = repo.ListManagedGroupMembershipsByAuthMethod(ctx, authMethod.GetPublicId(), oidc.WithLimit(2), oidc.WithAfterMembership(want[1].groupId, want[1].memberId))
+	require.NoError(t, err)
+	assert.Equal(t, want[2:], memberships(got))
+
+	_, err = repo.ListManagedGroupMembershipsByAuthMethod(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_ListManagedGroupMembershipsByGroup_afterMember(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	for _, subject := range []string{"alice", "bob", "carol"} {
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), oidc.TestAccount(t, conn, authMethod, subject).GetPublicId())
+	}
+
+	repo, err := oidc.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	all, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId(), oidc.WithOrderByCreateTime(true))
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	for i := 1; i < len(all); i++ {
+		prev, cur := all[i-1].GetCreateTime().AsTime(), all[i].GetCreateTime().AsTime()
+		assert.True(t, prev.Before(cur) || prev.Equal(cur) && all[i-1].GetMemberId() < all[i].GetMemberId())
+	}
+
+	// Each page continues after the last membership of the previous one.
+	var got []*oidc.ManagedGroupMemberAccount
+	opts := []oidc.Option{oidc.WithOrderByCreateTime(true), oidc.WithLimit(1)}
+	for {
+		page, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId(), opts...)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		got = append(got, page...)
+		last := page[len(page)-1]
+		opts = []oidc.Option{oidc.WithOrderByCreateTime(true), oidc.WithLimit(1), oidc.WithAfterMember(last.GetCreateTime().AsTime(), last.GetMemberId())}
+	}
+	assert.Equal(t, all, got)
+
+	// Descending, the cursor continues towards the earliest membership.
+	last := all[2]
+	page, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId(), oidc.WithOrderByCreateTime(false), oidc.WithAfterMember(last.GetCreateTime().AsTime(), last.GetMemberId()))
+	require.NoError(t, err)
+	assert.Equal(t, []*oidc.ManagedGroupMemberAccount{all[1], all[0]}, page)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupManagedGroupTags returns the tags of the managed group with the
+// provided id. A managed group without tags, or which doesn't exist, has none.
+func (r *Repository) LookupManagedGroupTags(ctx context.Context, withPublicId string) (map[string]string, error) {
+	const op = "oidc.(Repository).LookupManagedGroupTags"
+	if withPublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	rows, err := r.reader.Query(ctx, managedGroupTagsQuery, []any{withPublicId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	tags := make(map[string]string)
+	for rows.Next() {
+		var k, v string
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		tags[k] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tags, nil
+}
+
+// ListManagedGroupTags returns the tags of each managed group in an auth
+// method, keyed by the managed group's public id. Managed groups without tags
+// are left out.
+func (r *Repository) ListManagedGroupTags(ctx context.Context, withAuthMethodId string) (map[string]map[string]string, error) {
+	const op = "oidc.(Repository).ListManagedGroupTags"
+	if withAuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rows, err := r.reader.Query(ctx, managedGroupTagsByAuthMethodQuery, []any{withAuthMethodId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	tags := make(map[string]map[string]string)
+	for rows.Next() {
+		var id, k, v string
+		if err := rows.Scan(&id, &k, &v); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if tags[id] == nil {
+			tags[id] = make(map[string]string)
+		}
+		tags[id][k] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tags, nil
+}
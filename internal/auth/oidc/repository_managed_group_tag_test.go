@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ManagedGroupTags(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	newMg := func(opt ...Option) *ManagedGroup {
+		mg, err := NewManagedGroup(ctx, authMethod.GetPublicId(), TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		mg, err = repo.CreateManagedGroup(ctx, org.PublicId, mg, opt...)
+		require.NoError(t, err)
+		return mg
+	}
+	mg1 := newMg(WithTags(map[string]string{"team": "eng", "env": "prod"}))
+	mg2 := newMg(WithTags(map[string]string{"team": "ops"}))
+	untagged := newMg()
+
+	got, err := repo.LookupManagedGroupTags(ctx, mg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "eng", "env": "prod"}, got)
+	got, err = repo.LookupManagedGroupTags(ctx, untagged.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	all, err := repo.ListManagedGroupTags(ctx, authMethod.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		mg1.GetPublicId(): {"team": "eng", "env": "prod"},
+		mg2.GetPublicId(): {"team": "ops"},
+	}, all)
+
+	// The tags are replaced along with an update.
+	upd := mg1.Clone()
+	upd.Description = "updated"
+	mg1, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, upd, mg1.Version, []string{DescriptionField}, WithTags(map[string]string{"env": "dev"}))
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroupTags(ctx, mg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "dev"}, got)
+
+	// An update without WithTags keeps them.
+	upd = mg1.Clone()
+	upd.Description = "updated again"
+	mg1, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, upd, mg1.Version, []string{DescriptionField})
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroupTags(ctx, mg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "dev"}, got)
+
+	// Touching replaces them for an update of only the tags.
+	mg1, _, err = repo.TouchManagedGroup(ctx, org.PublicId, mg1.GetPublicId(), mg1.Version, WithTags(nil))
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroupTags(ctx, mg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	// A failed write of the tags leaves the managed group and its tags as
+	// they were.
+	_, _, err = repo.TouchManagedGroup(ctx, org.PublicId, mg2.GetPublicId(), mg2.Version, WithTags(map[string]string{"team": "eng", "env": strings.Repeat("x", 257)}))
+	require.Error(t, err)
+	got, err = repo.LookupManagedGroupTags(ctx, mg2.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops"}, got)
+	found, err := repo.LookupManagedGroup(ctx, mg2.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, mg2.Version, found.Version)
+
+	// The tags are oplogged with their managed group.
+	assert.Equal(t, 4, auth.TestManagedGroupTagOplogEntries(t, rw, mg1.GetPublicId()))
+	assert.Equal(t, 1, auth.TestManagedGroupTagOplogEntries(t, rw, mg2.GetPublicId()))
+	assert.Zero(t, auth.TestManagedGroupTagOplogEntries(t, rw, untagged.GetPublicId()))
+
+	// Tags are removed along with their managed group.
+	_, err = repo.DeleteManagedGroup(ctx, org.PublicId, mg2.GetPublicId())
+	require.NoError(t, err)
+	all, err = repo.ListManagedGroupTags(ctx, authMethod.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	all, err = repo.ListManagedGroupTags(ctx, globals.OidcAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	_, err = repo.LookupManagedGroupTags(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidPublicId), err))
+	_, err = repo.ListManagedGroupTags(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
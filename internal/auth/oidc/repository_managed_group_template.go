@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateManagedGroupTemplate inserts a ManagedGroupTemplate, t, into the
+// repository and returns a new ManagedGroupTemplate containing its PublicId.
+// t is not changed. t must contain a valid ScopeId, Name and Filter and must
+// not contain a PublicId. The PublicId is generated and assigned by this
+// method. t.Name must be unique within t.ScopeId.
+func (r *Repository) CreateManagedGroupTemplate(ctx context.Context, t *ManagedGroupTemplate, _ ...Option) (*ManagedGroupTemplate, error) {
+	const op = "oidc.(Repository).CreateManagedGroupTemplate"
+	if t == nil || t.ManagedGroupTemplate == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing ManagedGroupTemplate")
+	}
+	if t.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if err := t.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+
+	t = t.Clone()
+	id, err := newManagedGroupTemplateId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"), errors.WithCode(errors.Encrypt))
+	}
+
+	var newTemplate *ManagedGroupTemplate
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newTemplate = t.Clone()
+			if err := w.Create(ctx, newTemplate, db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf(
+				"in scope %s: name %q already exists",
+				t.ScopeId, t.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(t.ScopeId))
+	}
+	return newTemplate, nil
+}
+
+// LookupManagedGroupTemplate will look up a managed group template in the
+// repository. If the template is not found, it will return nil, nil. All
+// options are ignored.
+func (r *Repository) LookupManagedGroupTemplate(ctx context.Context, withPublicId string, _ ...Option) (*ManagedGroupTemplate, error) {
+	const op = "oidc.(Repository).LookupManagedGroupTemplate"
+	if withPublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	t := AllocManagedGroupTemplate()
+	t.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, t); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", withPublicId)))
+	}
+	return t, nil
+}
+
+// ListManagedGroupTemplates returns the managed group templates of a scope
+// ordered by name. Supports WithLimit which overrides the limits set in the
+// repository or defaults to the repository's limit.
+func (r *Repository) ListManagedGroupTemplates(ctx context.Context, withScopeId string, opt ...Option) ([]*ManagedGroupTemplate, error) {
+	const op = "oidc.(Repository).ListManagedGroupTemplates"
+	if withScopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var ts []*ManagedGroupTemplate
+	err := r.reader.SearchWhere(ctx, &ts, "scope_id = ?", []any{withScopeId}, db.WithLimit(limit), db.WithOrder("name"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ts, nil
+}
+
+// DeleteManagedGroupTemplate deletes the managed group template for the
+// provided id from the repository returning a count of the number of records
+// deleted. Managed groups created from the template are not changed.
+func (r *Repository) DeleteManagedGroupTemplate(ctx context.Context, scopeId, withPublicId string, _ ...Option) (int, error) {
+	const op = "oidc.(Repository).DeleteManagedGroupTemplate"
+	if withPublicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	t := AllocManagedGroupTemplate()
+	t.PublicId = withPublicId
+	t.ScopeId = scopeId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dt := t.Clone()
+			rowsDeleted, err = w.Delete(ctx, dt, db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(withPublicId))
+	}
+	return rowsDeleted, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRepository_ManagedGroupTemplates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	other, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tmpl, err := NewManagedGroupTemplate(ctx, org.PublicId, "admins", `"admin" in "/token/groups"`, WithDescription("the admins"))
+	require.NoError(t, err)
+	created, err := repo.CreateManagedGroupTemplate(ctx, tmpl)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.PublicId, globals.OidcManagedGroupTemplatePrefix+"_"))
+	assert.Empty(t, tmpl.PublicId)
+	assert.NoError(t, db.TestVerifyOplog(t, rw, created.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+	// Names are unique within a scope.
+	_, err = repo.CreateManagedGroupTemplate(ctx, tmpl)
+	assert.True(t, errors.Match(errors.T(errors.NotUnique), err), "unexpected error: %v", err)
+	tmpl.ScopeId = other.PublicId
+	_, err = repo.CreateManagedGroupTemplate(ctx, tmpl)
+	require.NoError(t, err)
+
+	_, err = repo.CreateManagedGroupTemplate(ctx, created)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	found, err := repo.LookupManagedGroupTemplate(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(created.ManagedGroupTemplate, found.ManagedGroupTemplate))
+
+	second := TestManagedGroupTemplate(t, conn, org.PublicId, "accounting", `"accounting" in "/token/groups"`)
+	listed, err := repo.ListManagedGroupTemplates(ctx, org.PublicId)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, second.PublicId, listed[0].PublicId)
+	assert.Equal(t, created.PublicId, listed[1].PublicId)
+
+	deleted, err := repo.DeleteManagedGroupTemplate(ctx, org.PublicId, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	found, err = repo.LookupManagedGroupTemplate(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	deleted, err = repo.DeleteManagedGroupTemplate(ctx, org.PublicId, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/db"
 	dbassert "github.com/hashicorp/boundary/internal/db/assert"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestRepository_CreateManagedGroup(t *testing.T) {
@@ -213,6 +215,147 @@ func TestRepository_CreateManagedGroup(t *testing.T) {
 	}
 }
 
+func TestRepository_CreateManagedGroup_initialMembers(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	otherAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"bob-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.bob.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.bob.com/callback")[0]),
+	)
+	alice := TestAccount(t, conn, authMethod, "alice")
+	carol := TestAccount(t, conn, authMethod, "carol")
+	other := TestAccount(t, conn, otherAuthMethod, "dave")
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	newMg := func(name string) *ManagedGroup {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithName(name))
+		require.NoError(t, err)
+		return mg
+	}
+
+	t.Run("seeded", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.CreateManagedGroup(ctx, org.GetPublicId(), newMg("seeded"), WithInitialMembers(alice.PublicId, carol.PublicId, alice.PublicId))
+		require.NoError(err)
+		members, err := repo.ListManagedGroupMembershipsByGroup(ctx, got.PublicId)
+		require.NoError(err)
+		var memberIds []string
+		for _, m := range members {
+			memberIds = append(memberIds, m.MemberId)
+			assert.Equal(auth.MembershipSourceSync, m.Source)
+		}
+		assert.ElementsMatch([]string{alice.PublicId, carol.PublicId}, memberIds)
+	})
+
+	for _, acctId := range []string{other.PublicId, globals.OidcAccountPrefix + "_DoesntExis"} {
+		t.Run("unknown "+acctId, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, err := repo.CreateManagedGroup(ctx, org.GetPublicId(), newMg("unknown"), WithInitialMembers(alice.PublicId, acctId))
+			require.Error(err)
+			assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "Unexpected error %s", err)
+			assert.Contains(err.Error(), acctId)
+			assert.NotContains(err.Error(), alice.PublicId)
+
+			// Nothing is created when an account is unknown.
+			found, err := repo.LookupManagedGroupByName(ctx, authMethod.PublicId, "unknown")
+			require.NoError(err)
+			assert.Nil(found)
+		})
+	}
+}
+
+func TestRepository_CreateManagedGroup_roles(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	orgRole := iam.TestRole(t, conn, org.GetPublicId())
+	projRole := iam.TestRole(t, conn, proj.GetPublicId())
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	newMg := func(name string) *ManagedGroup {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithName(name))
+		require.NoError(t, err)
+		return mg
+	}
+	lookupRole := func(t *testing.T, id string) (*iam.Role, []*iam.PrincipalRole) {
+		t.Helper()
+		role, principals, _, err := iamRepo.LookupRole(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, role)
+		return role, principals
+	}
+
+	t.Run("added", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r1, _ := lookupRole(t, orgRole.GetPublicId())
+		r2, _ := lookupRole(t, projRole.GetPublicId())
+		got, err := repo.CreateManagedGroup(ctx, org.GetPublicId(), newMg("added"), WithRoles(r1, r2))
+		require.NoError(err)
+		for _, r := range []*iam.Role{r1, r2} {
+			after, principals := lookupRole(t, r.GetPublicId())
+			assert.Equal(r.GetVersion()+1, after.GetVersion())
+			require.Len(principals, 1)
+			assert.Equal(got.GetPublicId(), principals[0].GetPrincipalId())
+		}
+	})
+
+	t.Run("stale role", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r1, before := lookupRole(t, orgRole.GetPublicId())
+		r2, _ := lookupRole(t, projRole.GetPublicId())
+		stale := r2.Clone().(*iam.Role)
+		stale.Version--
+		_, err := repo.CreateManagedGroup(ctx, org.GetPublicId(), newMg("stale"), WithRoles(r1, stale))
+		require.Error(err)
+
+		// Neither the managed group nor any of the role additions are kept.
+		found, err := repo.LookupManagedGroupByName(ctx, authMethod.PublicId, "stale")
+		require.NoError(err)
+		assert.Nil(found)
+		after, principals := lookupRole(t, r1.GetPublicId())
+		assert.Equal(r1.GetVersion(), after.GetVersion())
+		assert.Len(principals, len(before))
+	})
+}
+
 func TestRepository_LookupManagedGroup(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -279,6 +422,294 @@ func TestRepository_LookupManagedGroup(t *testing.T) {
 	}
 }
 
+func TestRepository_LookupManagedGroups(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	authMethod1 := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice1.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	authMethod2 := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice2.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg1 := TestManagedGroup(t, conn, authMethod1, TestFakeManagedGroupFilter)
+	mg2 := TestManagedGroup(t, conn, authMethod2, TestFakeManagedGroupFilter)
+	_ = TestManagedGroup(t, conn, authMethod2, TestFakeManagedGroupFilter)
+
+	// The lookup isn't bounded by the repo limit
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, WithLimit(1))
+	require.NoError(t, err)
+
+	got, err := repo.LookupManagedGroups(ctx, []string{mg1.PublicId, mg2.PublicId, globals.OidcManagedGroupPrefix + "_doesntexist"})
+	require.NoError(t, err)
+	var gotIds []string
+	for _, mg := range got {
+		gotIds = append(gotIds, mg.PublicId)
+	}
+	assert.ElementsMatch(t, []string{mg1.PublicId, mg2.PublicId}, gotIds)
+
+	_, err = repo.LookupManagedGroups(ctx, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_LookupManagedGroupByName(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("named"))
+	upperMg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("Admins"))
+	TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("admins"))
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		mgName       string
+		opt          []Option
+		want         *ManagedGroup
+		wantIsErr    errors.Code
+		wantErrMsg   string
+	}{
+		{
+			name:       "With no auth method id",
+			mgName:     "named",
+			wantIsErr:  errors.InvalidParameter,
+			wantErrMsg: "oidc.(Repository).LookupManagedGroupByName: missing auth method id: parameter violation: error #100",
+		},
+		{
+			name:         "With no name",
+			authMethodId: authMethod.GetPublicId(),
+			wantIsErr:    errors.InvalidParameter,
+			wantErrMsg:   "oidc.(Repository).LookupManagedGroupByName: missing name: parameter violation: error #100",
+		},
+		{
+			name:         "With non existing name",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "unknown",
+		},
+		{
+			name:         "With existing name",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "named",
+			want:         mg,
+		},
+		{
+			name:         "With differently cased name",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "NaMeD",
+		},
+		{
+			name:         "With exact name among case variants",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "Admins",
+			want:         upperMg,
+		},
+		{
+			name:         "With differently cased name ignoring case",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "NaMeD",
+			opt:          []Option{WithIgnoreNameCase(true)},
+			want:         mg,
+		},
+		{
+			name:         "With exact name among case variants ignoring case",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "Admins",
+			opt:          []Option{WithIgnoreNameCase(true)},
+			want:         upperMg,
+		},
+		{
+			name:         "With name matching case variants ignoring case",
+			authMethodId: authMethod.GetPublicId(),
+			mgName:       "ADMINS",
+			opt:          []Option{WithIgnoreNameCase(true)},
+			wantIsErr:    errors.NotUnique,
+			wantErrMsg:   fmt.Sprintf("oidc.(Repository).LookupManagedGroupByName: name \"ADMINS\" matches 2 managed groups in %s: integrity violation: error #1002", authMethod.GetPublicId()),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(ctx, rw, rw, kmsCache)
+			assert.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.LookupManagedGroupByName(context.Background(), tt.authMethodId, tt.mgName, tt.opt...)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "Unexpected error %s", err)
+				assert.Equal(tt.wantErrMsg, err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.EqualValues(tt.want, got)
+		})
+	}
+}
+
+func TestRepository_LookupManagedGroupByIdPrefix(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	otherAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"bob-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.bob.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.bob.com/callback")[0]),
+	)
+	// The ids are set so which of them share a prefix is known.
+	newMg := func(am *AuthMethod, id string) *ManagedGroup {
+		mg, err := NewManagedGroup(ctx, am.GetPublicId(), TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		mg.PublicId = id
+		require.NoError(t, rw.Create(ctx, mg))
+		return mg
+	}
+	mg := newMg(authMethod, "mgoidc_abc1234567")
+	newMg(authMethod, "mgoidc_xyz1234567")
+	newMg(authMethod, "mgoidc_xyz7654321")
+	newMg(otherAuthMethod, "mgoidc_abd1234567")
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		prefix       string
+		want         *ManagedGroup
+		wantIsErr    errors.Code
+		wantErrMsg   string
+	}{
+		{
+			name:       "With no auth method id",
+			prefix:     "mgoidc_abc",
+			wantIsErr:  errors.InvalidParameter,
+			wantErrMsg: "oidc.(Repository).LookupManagedGroupByIdPrefix: missing auth method id: parameter violation: error #100",
+		},
+		{
+			name:         "With no prefix",
+			authMethodId: authMethod.GetPublicId(),
+			wantIsErr:    errors.InvalidParameter,
+			wantErrMsg:   "oidc.(Repository).LookupManagedGroupByIdPrefix: missing id prefix: parameter violation: error #100",
+		},
+		{
+			name:         "With only the managed group prefix",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgoidc_",
+			wantIsErr:    errors.InvalidParameter,
+			wantErrMsg:   `oidc.(Repository).LookupManagedGroupByIdPrefix: id prefix "mgoidc_" is not mgoidc_ followed by part of an id: parameter violation: error #100`,
+		},
+		{
+			name:         "With an ldap managed group prefix",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgldap_abc",
+			wantIsErr:    errors.InvalidParameter,
+			wantErrMsg:   `oidc.(Repository).LookupManagedGroupByIdPrefix: id prefix "mgldap_abc" is not mgoidc_ followed by part of an id: parameter violation: error #100`,
+		},
+		{
+			name:         "With a like wildcard",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgoidc_%",
+			wantIsErr:    errors.InvalidParameter,
+			wantErrMsg:   `oidc.(Repository).LookupManagedGroupByIdPrefix: id prefix "mgoidc_%" is not mgoidc_ followed by part of an id: parameter violation: error #100`,
+		},
+		{
+			name:         "With unique prefix",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgoidc_a",
+			want:         mg,
+		},
+		{
+			name:         "With full id",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       mg.GetPublicId(),
+			want:         mg,
+		},
+		{
+			name:         "With prefix only in other auth method",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgoidc_abd",
+		},
+		{
+			name:         "With ambiguous prefix",
+			authMethodId: authMethod.GetPublicId(),
+			prefix:       "mgoidc_xyz",
+			wantIsErr:    errors.NotUnique,
+			wantErrMsg:   fmt.Sprintf("oidc.(Repository).LookupManagedGroupByIdPrefix: id prefix \"mgoidc_xyz\" matches more than one managed group in %s: integrity violation: error #1002", authMethod.GetPublicId()),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(ctx, rw, rw, kmsCache)
+			assert.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.LookupManagedGroupByIdPrefix(context.Background(), tt.authMethodId, tt.prefix)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "Unexpected error %s", err)
+				assert.Equal(tt.wantErrMsg, err.Error())
+				return
+			}
+			require.NoError(err)
+			if tt.want == nil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(tt.want.GetPublicId(), got.GetPublicId())
+		})
+	}
+}
+
 func TestRepository_DeleteManagedGroup(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -299,12 +730,14 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
 	)
 	mg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	versionedMg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
 	newMgId, err := newManagedGroupId(ctx)
 	require.NoError(t, err)
 	tests := []struct {
 		name       string
 		scopeId    string
 		in         string
+		opts       []Option
 		want       int
 		wantIsErr  errors.Code
 		wantErrMsg string
@@ -328,6 +761,27 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 			in:      newMgId,
 			want:    0,
 		},
+		{
+			name:      "With a different version",
+			scopeId:   org.GetPublicId(),
+			in:        versionedMg.GetPublicId(),
+			opts:      []Option{WithVersion(versionedMg.GetVersion() + 1)},
+			wantIsErr: errors.Conflict,
+		},
+		{
+			name:    "With non existing managed group id and a version",
+			scopeId: org.GetPublicId(),
+			in:      newMgId,
+			opts:    []Option{WithVersion(1)},
+			want:    0,
+		},
+		{
+			name:    "With the current version",
+			scopeId: org.GetPublicId(),
+			in:      versionedMg.GetPublicId(),
+			opts:    []Option{WithVersion(versionedMg.GetVersion())},
+			want:    1,
+		},
 		{
 			name:    "With existing managed group id",
 			scopeId: org.GetPublicId(),
@@ -343,10 +797,12 @@ func TestRepository_DeleteManagedGroup(t *testing.T) {
 			repo, err := NewRepository(ctx, rw, rw, kmsCache)
 			assert.NoError(err)
 			require.NotNil(repo)
-			got, err := repo.DeleteManagedGroup(context.Background(), tt.scopeId, tt.in)
+			got, err := repo.DeleteManagedGroup(context.Background(), tt.scopeId, tt.in, tt.opts...)
 			if tt.wantIsErr != 0 {
 				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "Unexpected error %s", err)
-				assert.Equal(tt.wantErrMsg, err.Error())
+				if tt.wantErrMsg != "" {
+					assert.Equal(tt.wantErrMsg, err.Error())
+				}
 				return
 			}
 			require.NoError(err)
@@ -460,7 +916,7 @@ func TestRepository_ListManagedGroups(t *testing.T) {
 	}
 }
 
-func TestRepository_ListManagedGroups_Limits(t *testing.T) {
+func TestRepository_ListManagedGroups_restricted(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
@@ -472,7 +928,6 @@ func TestRepository_ListManagedGroups_Limits(t *testing.T) {
 
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
 	require.NoError(t, err)
-
 	am := TestAuthMethod(
 		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
 		"alice-rp", "fido",
@@ -480,14 +935,137 @@ func TestRepository_ListManagedGroups_Limits(t *testing.T) {
 		WithIssuer(TestConvertToUrls(t, "https://www.alice1.com")[0]),
 		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
 	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
 
-	mgCount := 10
-	for i := 0; i < mgCount; i++ {
-		TestManagedGroup(t, conn, am, fmt.Sprintf(`"/foo/%d" == "bar"`, i))
-	}
+	synced := TestManagedGroup(t, conn, am, TestFakeManagedGroupFilter, WithKind(auth.ManagedGroupKindSynced))
+	owned := TestManagedGroup(t, conn, am, TestFakeManagedGroupFilter, WithOwnerId("team-a"))
+	newTagged, err := NewManagedGroup(ctx, am.GetPublicId(), TestFakeManagedGroupFilter)
+	require.NoError(t, err)
+	tagged, err := repo.CreateManagedGroup(ctx, org.GetPublicId(), newTagged, WithTags(map[string]string{"team": "eng", "env": "prod"}))
+	require.NoError(t, err)
+	withMember := TestManagedGroup(t, conn, am, TestFakeManagedGroupFilter)
+	TestManagedGroupMember(t, conn, withMember.GetPublicId(), TestAccount(t, conn, am, "alice").GetPublicId())
+	role1, role2 := iam.TestRole(t, conn, org.GetPublicId()), iam.TestRole(t, conn, org.GetPublicId())
+	inRole1 := TestManagedGroup(t, conn, am, TestFakeManagedGroupFilter)
+	iam.TestManagedGroupRole(t, conn, role1.GetPublicId(), inRole1.GetPublicId())
+	inRole2 := TestManagedGroup(t, conn, am, TestFakeManagedGroupFilter)
+	iam.TestManagedGroupRole(t, conn, role2.GetPublicId(), inRole2.GetPublicId())
+	all := []*ManagedGroup{synced, owned, tagged, withMember, inRole1, inRole2}
 
-	tests := []struct {
-		name     string
+	without := func(excluded ...*ManagedGroup) []*ManagedGroup {
+		var out []*ManagedGroup
+		for _, mg := range all {
+			kept := true
+			for _, e := range excluded {
+				kept = kept && mg.GetPublicId() != e.GetPublicId()
+			}
+			if kept {
+				out = append(out, mg)
+			}
+		}
+		return out
+	}
+	ids := func(mgs []*ManagedGroup) []string {
+		out := []string{}
+		for _, mg := range mgs {
+			out = append(out, mg.GetPublicId())
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []*ManagedGroup
+	}{
+		{
+			name: "unrestricted",
+			want: all,
+		},
+		{
+			name: "kind",
+			opts: []Option{WithKind(auth.ManagedGroupKindSynced)},
+			want: []*ManagedGroup{synced},
+		},
+		{
+			name: "owner id",
+			opts: []Option{WithOwnerId("team-a")},
+			want: []*ManagedGroup{owned},
+		},
+		{
+			name: "tag",
+			opts: []Option{WithTagFilter(map[string]string{"team": "eng"})},
+			want: []*ManagedGroup{tagged},
+		},
+		{
+			name: "tags not all held",
+			opts: []Option{WithTagFilter(map[string]string{"team": "eng", "env": "dev"})},
+		},
+		{
+			name: "no members",
+			opts: []Option{WithNoMembers(true)},
+			want: without(withMember),
+		},
+		{
+			name: "principal in roles",
+			opts: []Option{WithPrincipalInRoles([]string{role1.GetPublicId()})},
+			want: []*ManagedGroup{inRole1},
+		},
+		{
+			name: "principal in no given roles",
+			opts: []Option{WithPrincipalInRoles([]string{})},
+		},
+		{
+			name: "principal in none of the roles",
+			opts: []Option{WithPrincipalInNoRoles([]string{role1.GetPublicId()})},
+			want: without(inRole1),
+		},
+		{
+			name: "combined",
+			opts: []Option{WithNoMembers(true), WithPrincipalInNoRoles([]string{role1.GetPublicId(), role2.GetPublicId()})},
+			want: without(withMember, inRole1, inRole2),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ListManagedGroups(ctx, am.GetPublicId(), tt.opts...)
+			require.NoError(err)
+			assert.ElementsMatch(ids(tt.want), ids(got))
+		})
+	}
+}
+
+func TestRepository_ListManagedGroups_Limits(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice1.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+
+	mgCount := 10
+	for i := 0; i < mgCount; i++ {
+		TestManagedGroup(t, conn, am, fmt.Sprintf(`"/foo/%d" == "bar"`, i))
+	}
+
+	tests := []struct {
+		name     string
 		repoOpts []Option
 		listOpts []Option
 		wantLen  int
@@ -544,6 +1122,106 @@ func TestRepository_ListManagedGroups_Limits(t *testing.T) {
 	}
 }
 
+func TestRepository_CountManagedGroups(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	authMethod1 := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice1.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	authMethod2 := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice2.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	for i := 0; i < 3; i++ {
+		TestManagedGroup(t, conn, authMethod1, TestFakeManagedGroupFilter)
+	}
+	TestManagedGroup(t, conn, authMethod2, TestFakeManagedGroupFilter)
+
+	// The count isn't bounded by the repo limit
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, WithLimit(1))
+	require.NoError(t, err)
+
+	got, err := repo.CountManagedGroups(ctx, authMethod1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+
+	// The count is restricted like the list
+	TestManagedGroup(t, conn, authMethod1, TestFakeManagedGroupFilter, WithKind(auth.ManagedGroupKindSynced))
+	got, err = repo.CountManagedGroups(ctx, authMethod1.GetPublicId(), WithKind(auth.ManagedGroupKindSynced))
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	got, err = repo.CountManagedGroups(ctx, globals.OidcAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	_, err = repo.CountManagedGroups(ctx, "")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_CountManagedGroupMembers(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg1 := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	mg2 := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	empty := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	for _, sub := range []string{"alice", "bob"} {
+		acct := TestAccount(t, conn, authMethod, sub)
+		TestManagedGroupMember(t, conn, mg1.GetPublicId(), acct.GetPublicId())
+		if sub == "alice" {
+			TestManagedGroupMember(t, conn, mg2.GetPublicId(), acct.GetPublicId())
+		}
+	}
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	got, err := repo.CountManagedGroupMembers(ctx, authMethod.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{mg1.GetPublicId(): 2, mg2.GetPublicId(): 1, empty.GetPublicId(): 0}, got)
+
+	got, err = repo.CountManagedGroupMembers(ctx, globals.OidcAuthMethodPrefix+"_doesntexist")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = repo.CountManagedGroupMembers(ctx, "")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
 func TestRepository_UpdateManagedGroup(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -974,3 +1652,610 @@ func TestRepository_UpdateManagedGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_UpdateManagedGroup_filterVersion(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	mg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	got, err := repo.LookupManagedGroup(ctx, mg.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.FilterVersion)
+
+	update := func(filter, name string, fieldMask ...string) *ManagedGroup {
+		t.Helper()
+		current, err := repo.LookupManagedGroup(ctx, mg.PublicId)
+		require.NoError(t, err)
+		upd := AllocManagedGroup()
+		upd.PublicId = mg.PublicId
+		upd.Filter = filter
+		upd.Name = name
+		updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, current.Version, fieldMask)
+		require.NoError(t, err)
+		require.Equal(t, 1, rowsUpdated)
+		return updated
+	}
+
+	// Changing the name bumps the version but not the filter version.
+	updated := update("", "admins", NameField)
+	assert.Equal(t, uint32(2), updated.Version)
+	assert.Equal(t, uint32(1), updated.FilterVersion)
+
+	updated = update(`"/token/sub" == "alice"`, "", FilterField)
+	assert.Equal(t, uint32(3), updated.Version)
+	assert.Equal(t, uint32(2), updated.FilterVersion)
+
+	// Setting the filter it already has isn't a change.
+	updated = update(`"/token/sub" == "alice"`, "admins", FilterField, NameField)
+	assert.Equal(t, uint32(2), updated.FilterVersion)
+
+	// The filter version can't be set directly.
+	_, err = rw.Exec(ctx, "update auth_oidc_managed_group set filter_version = 10 where public_id = ?", []any{mg.PublicId})
+	require.NoError(t, err)
+	got, err = repo.LookupManagedGroup(ctx, mg.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), got.FilterVersion)
+}
+
+func TestRepository_ManagedGroupFilterSyntaxVersion(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	require.NotEmpty(t, FilterSyntaxVersion)
+
+	mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter)
+	require.NoError(t, err)
+	created, err := repo.CreateManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.Equal(t, FilterSyntaxVersion, created.FilterSyntaxVersion)
+
+	// Managed groups written before the version was recorded have none until
+	// their filter is written.
+	_, err = rw.Exec(ctx, "update auth_oidc_managed_group set filter_syntax_version = null where public_id = ?", []any{created.PublicId})
+	require.NoError(t, err)
+	update := func(filter, name string, fieldMask ...string) *ManagedGroup {
+		t.Helper()
+		current, err := repo.LookupManagedGroup(ctx, created.PublicId)
+		require.NoError(t, err)
+		upd := AllocManagedGroup()
+		upd.PublicId = created.PublicId
+		upd.Filter = filter
+		upd.Name = name
+		updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, current.Version, fieldMask)
+		require.NoError(t, err)
+		require.Equal(t, 1, rowsUpdated)
+		return updated
+	}
+	updated := update("", "admins", NameField)
+	assert.Empty(t, updated.FilterSyntaxVersion)
+
+	updated = update(`"/token/sub" == "alice"`, "", FilterField)
+	assert.Equal(t, FilterSyntaxVersion, updated.FilterSyntaxVersion)
+	got, err := repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, FilterSyntaxVersion, got.FilterSyntaxVersion)
+
+	// Upserting writes the filter too.
+	_, err = rw.Exec(ctx, "update auth_oidc_managed_group set filter_syntax_version = null where public_id = ?", []any{created.PublicId})
+	require.NoError(t, err)
+	upserted, err := NewManagedGroup(ctx, authMethod.PublicId, `"/token/sub" == "bob"`, WithName("admins"))
+	require.NoError(t, err)
+	out, wasCreated, err := repo.UpsertManagedGroup(ctx, org.PublicId, upserted)
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.Equal(t, FilterSyntaxVersion, out.FilterSyntaxVersion)
+}
+
+func TestRepository_ManagedGroupFilterHasWarnings(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	// The selector of the fake filter doesn't start with "token" or
+	// "userinfo", which is linted.
+	mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithName("admins"))
+	require.NoError(t, err)
+	created, err := repo.CreateManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.True(t, created.FilterHasWarnings)
+
+	update := func(filter, name string, fieldMask ...string) *ManagedGroup {
+		t.Helper()
+		current, err := repo.LookupManagedGroup(ctx, created.PublicId)
+		require.NoError(t, err)
+		upd := AllocManagedGroup()
+		upd.PublicId = created.PublicId
+		upd.Filter = filter
+		upd.Name = name
+		updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, current.Version, fieldMask)
+		require.NoError(t, err)
+		require.Equal(t, 1, rowsUpdated)
+		return updated
+	}
+	// Only writing the filter changes it.
+	updated := update("", "admins2", NameField)
+	assert.True(t, updated.FilterHasWarnings)
+
+	updated = update(`"/token/sub" == "alice"`, "", FilterField)
+	assert.False(t, updated.FilterHasWarnings)
+	got, err := repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.False(t, got.FilterHasWarnings)
+
+	updated = update(`"/token/groups" == "admin"`, "", FilterField)
+	assert.True(t, updated.FilterHasWarnings)
+
+	// Upserting writes the filter too.
+	upserted, err := NewManagedGroup(ctx, authMethod.PublicId, `"/token/sub" == "bob"`, WithName("admins2"))
+	require.NoError(t, err)
+	out, wasCreated, err := repo.UpsertManagedGroup(ctx, org.PublicId, upserted)
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.False(t, out.FilterHasWarnings)
+	got, err = repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.False(t, got.FilterHasWarnings)
+}
+
+func TestRepository_ManagedGroupFilterComplexity(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	mg, err := NewManagedGroup(ctx, authMethod.PublicId, `"/token/sub" == "alice"`, WithName("admins"))
+	require.NoError(t, err)
+	created, err := repo.CreateManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), created.FilterComplexity)
+
+	update := func(filter, name string, fieldMask ...string) *ManagedGroup {
+		t.Helper()
+		current, err := repo.LookupManagedGroup(ctx, created.PublicId)
+		require.NoError(t, err)
+		upd := AllocManagedGroup()
+		upd.PublicId = created.PublicId
+		upd.Filter = filter
+		upd.Name = name
+		updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, current.Version, fieldMask)
+		require.NoError(t, err)
+		require.Equal(t, 1, rowsUpdated)
+		return updated
+	}
+	// Only writing the filter changes it.
+	updated := update("", "admins2", NameField)
+	assert.Equal(t, uint32(1), updated.FilterComplexity)
+
+	updated = update(`"/token/sub" == "alice" or ("admin" in "/token/groups" and not "/token/sub" == "eve")`, "", FilterField)
+	assert.Equal(t, uint32(6), updated.FilterComplexity)
+	got, err := repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6), got.FilterComplexity)
+
+	// Upserting writes the filter too.
+	upserted, err := NewManagedGroup(ctx, authMethod.PublicId, `"/token/sub" == "bob" and "/token/email" == "bob@example.com"`, WithName("admins2"))
+	require.NoError(t, err)
+	out, wasCreated, err := repo.UpsertManagedGroup(ctx, org.PublicId, upserted)
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.Equal(t, uint32(3), out.FilterComplexity)
+	got, err = repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), got.FilterComplexity)
+}
+
+func TestRepository_UpdateManagedGroup_fieldUpdateTimes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	mg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("default"), WithDescription("default"))
+	created := mg.GetCreateTime().AsTime()
+	assert.Equal(t, created, mg.GetNameUpdateTime().AsTime())
+	assert.Equal(t, created, mg.GetDescriptionUpdateTime().AsTime())
+	assert.Equal(t, created, mg.GetFilterUpdateTime().AsTime())
+
+	update := func(name, description, filter string, fieldMask ...string) *ManagedGroup {
+		t.Helper()
+		current, err := repo.LookupManagedGroup(ctx, mg.PublicId)
+		require.NoError(t, err)
+		upd := AllocManagedGroup()
+		upd.PublicId = mg.PublicId
+		upd.Name = name
+		upd.Description = description
+		upd.Filter = filter
+		updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, current.Version, fieldMask)
+		require.NoError(t, err)
+		require.Equal(t, 1, rowsUpdated)
+		return updated
+	}
+
+	// Only the changed field records the update.
+	updated := update("admins", "", "", NameField)
+	nameChanged := updated.GetUpdateTime().AsTime()
+	assert.True(t, nameChanged.After(created))
+	assert.Equal(t, nameChanged, updated.GetNameUpdateTime().AsTime())
+	assert.Equal(t, created, updated.GetDescriptionUpdateTime().AsTime())
+	assert.Equal(t, created, updated.GetFilterUpdateTime().AsTime())
+
+	// Setting a field to the value it already has isn't a change.
+	updated = update("admins", "", `"/token/sub" == "alice"`, NameField, FilterField)
+	filterChanged := updated.GetUpdateTime().AsTime()
+	assert.Equal(t, nameChanged, updated.GetNameUpdateTime().AsTime())
+	assert.Equal(t, created, updated.GetDescriptionUpdateTime().AsTime())
+	assert.Equal(t, filterChanged, updated.GetFilterUpdateTime().AsTime())
+
+	// Clearing a field is a change.
+	updated = update("", "", "", DescriptionField)
+	assert.Equal(t, nameChanged, updated.GetNameUpdateTime().AsTime())
+	assert.Equal(t, updated.GetUpdateTime().AsTime(), updated.GetDescriptionUpdateTime().AsTime())
+	assert.Equal(t, filterChanged, updated.GetFilterUpdateTime().AsTime())
+
+	// The times can't be set directly.
+	_, err = rw.Exec(ctx, "update auth_oidc_managed_group set name_update_time = now() + interval '1 day' where public_id = ?", []any{mg.PublicId})
+	require.NoError(t, err)
+	got, err := repo.LookupManagedGroup(ctx, mg.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, nameChanged, got.GetNameUpdateTime().AsTime())
+}
+
+func TestRepository_TouchManagedGroup(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	mg := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("default"), WithDescription("default"))
+
+	touched, rowsUpdated, err := repo.TouchManagedGroup(ctx, org.PublicId, mg.PublicId, mg.Version, WithActorId("u_1234567890"))
+	require.NoError(t, err)
+	require.Equal(t, 1, rowsUpdated)
+	assert.Equal(t, mg.Version+1, touched.Version)
+	assert.True(t, touched.GetUpdateTime().AsTime().After(mg.GetUpdateTime().AsTime()))
+
+	// Nothing else changed.
+	want := mg.Clone()
+	want.Version = touched.Version
+	want.UpdateTime = touched.UpdateTime
+	assert.True(t, proto.Equal(want.ManagedGroup, touched.ManagedGroup))
+
+	// The touched version is recorded like an update.
+	revs, err := repo.ListManagedGroupRevisions(ctx, mg.PublicId)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.Equal(t, mg.Version, revs[0].Version)
+	assert.Equal(t, "u_1234567890", revs[0].ReplacedBy)
+
+	// The version is checked.
+	_, rowsUpdated, err = repo.TouchManagedGroup(ctx, org.PublicId, mg.PublicId, mg.Version)
+	require.NoError(t, err)
+	assert.Equal(t, 0, rowsUpdated)
+
+	_, _, err = repo.TouchManagedGroup(ctx, org.PublicId, "", touched.Version)
+	assert.True(t, errors.Match(errors.T(errors.InvalidPublicId), err))
+	_, _, err = repo.TouchManagedGroup(ctx, org.PublicId, mg.PublicId, 0)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, _, err = repo.TouchManagedGroup(ctx, "", mg.PublicId, touched.Version)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_SetManagedGroupsDisabled(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	mg1 := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("one"))
+	mg2 := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter, WithName("two"))
+
+	got, err := repo.SetManagedGroupsDisabled(ctx, true, []ManagedGroupVersion{
+		{ScopeId: org.PublicId, PublicId: mg2.PublicId, Version: mg2.Version},
+		{ScopeId: org.PublicId, PublicId: mg1.PublicId, Version: mg1.Version},
+	}, WithActorId("u_1234567890"))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, mg2.PublicId, got[0].PublicId)
+	assert.Equal(t, mg1.PublicId, got[1].PublicId)
+	for _, mg := range got {
+		assert.True(t, mg.Disabled)
+		assert.Equal(t, uint32(2), mg.Version)
+	}
+
+	// The replaced versions are recorded like updates.
+	revs, err := repo.ListManagedGroupRevisions(ctx, mg1.PublicId)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.False(t, revs[0].Disabled)
+	assert.Equal(t, "u_1234567890", revs[0].ReplacedBy)
+
+	// A managed group at the wrong version rolls back the others.
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, []ManagedGroupVersion{
+		{ScopeId: org.PublicId, PublicId: mg1.PublicId, Version: 2},
+		{ScopeId: org.PublicId, PublicId: mg2.PublicId, Version: 1},
+	})
+	assert.Truef(t, errors.Match(errors.T(errors.VersionMismatch), err), "Unexpected error %s", err)
+	found, err := repo.LookupManagedGroup(ctx, mg1.PublicId)
+	require.NoError(t, err)
+	assert.True(t, found.Disabled)
+	assert.Equal(t, uint32(2), found.Version)
+
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, []ManagedGroupVersion{{ScopeId: org.PublicId, Version: 2}})
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, []ManagedGroupVersion{{ScopeId: org.PublicId, PublicId: mg1.PublicId}})
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, []ManagedGroupVersion{{PublicId: mg1.PublicId, Version: 2}})
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = repo.SetManagedGroupsDisabled(ctx, false, []ManagedGroupVersion{
+		{ScopeId: org.PublicId, PublicId: mg1.PublicId, Version: 2},
+		{ScopeId: org.PublicId, PublicId: mg1.PublicId, Version: 2},
+	})
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_UpsertManagedGroup(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("missing-name", func(t *testing.T) {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		_, _, err = repo.UpsertManagedGroup(ctx, org.PublicId, mg)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	})
+
+	mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithName("upserted"), WithDescription("first"))
+	require.NoError(t, err)
+	created, wasCreated, err := repo.UpsertManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.True(t, wasCreated)
+	assert.True(t, strings.HasPrefix(created.PublicId, globals.OidcManagedGroupPrefix))
+	assert.Equal(t, uint32(1), created.Version)
+
+	const newFilter = `"/token/sub" == "bob"`
+	mg, err = NewManagedGroup(ctx, authMethod.PublicId, newFilter, WithName("upserted"))
+	require.NoError(t, err)
+	updated, wasCreated, err := repo.UpsertManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.Equal(t, created.PublicId, updated.PublicId)
+	assert.Equal(t, uint32(2), updated.Version)
+	assert.Equal(t, newFilter, updated.Filter)
+	assert.Empty(t, updated.Description)
+
+	found, err := repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, newFilter, found.Filter)
+	assert.Empty(t, found.Description)
+}
+
+func TestRepository_ListManagedGroupRevisions(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	_, err = repo.ListManagedGroupRevisions(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithName("history"))
+	require.NoError(t, err)
+	created, _, err := repo.UpsertManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+
+	// Nothing has been replaced yet.
+	revs, err := repo.ListManagedGroupRevisions(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Empty(t, revs)
+
+	upd := AllocManagedGroup()
+	upd.PublicId = created.PublicId
+	upd.Filter = `"/token/sub" == "bob"`
+	updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, upd, created.Version, []string{FilterField}, WithActorId("u_1234567890"))
+	require.NoError(t, err)
+	require.Equal(t, 1, rowsUpdated)
+
+	// An update at the wrong version replaces nothing.
+	_, rowsUpdated, err = repo.UpdateManagedGroup(ctx, org.PublicId, upd, created.Version, []string{FilterField})
+	require.NoError(t, err)
+	require.Equal(t, 0, rowsUpdated)
+
+	mg, err = NewManagedGroup(ctx, authMethod.PublicId, `"/token/sub" == "carol"`, WithName("history"), WithDescription("upserted"))
+	require.NoError(t, err)
+	_, _, err = repo.UpsertManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(t, err)
+
+	revs, err = repo.ListManagedGroupRevisions(ctx, created.PublicId)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, uint32(2), revs[0].Version)
+	assert.Equal(t, `"/token/sub" == "bob"`, revs[0].Filter)
+	assert.Equal(t, uint32(2), revs[0].FilterVersion)
+	assert.Empty(t, revs[0].ReplacedBy)
+	assert.Equal(t, updated.UpdateTime.AsTime(), revs[0].ValidFromTime.AsTime())
+	assert.Equal(t, uint32(1), revs[1].Version)
+	assert.Equal(t, TestFakeManagedGroupFilter, revs[1].Filter)
+	assert.Equal(t, uint32(1), revs[1].FilterVersion)
+	assert.Equal(t, "history", revs[1].Name)
+	assert.Equal(t, "u_1234567890", revs[1].ReplacedBy)
+	assert.Equal(t, created.UpdateTime.AsTime(), revs[1].ValidFromTime.AsTime())
+	assert.False(t, revs[1].ReplacedTime.AsTime().Before(revs[1].ValidFromTime.AsTime()))
+
+	revs, err = repo.ListManagedGroupRevisions(ctx, created.PublicId, WithLimit(1))
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.Equal(t, uint32(2), revs[0].Version)
+
+	// Revisions are append only.
+	_, err = rw.Exec(ctx, "update auth_oidc_managed_group_revision set filter = ? where managed_group_id = ?",
+		[]any{`"/token/sub" == "mallory"`, created.PublicId})
+	require.Error(t, err)
+
+	// and are deleted along with their managed group.
+	_, err = repo.DeleteManagedGroup(ctx, org.PublicId, created.PublicId)
+	require.NoError(t, err)
+	revs, err = repo.ListManagedGroupRevisions(ctx, created.PublicId)
+	require.NoError(t, err)
+	assert.Empty(t, revs)
+}
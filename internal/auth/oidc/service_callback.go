@@ -14,8 +14,6 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/cap/oidc"
-	"github.com/hashicorp/go-bexpr"
-	"github.com/mitchellh/pointerstructure"
 )
 
 // Callback is an oidc domain service function for processing a successful OIDC
@@ -197,26 +195,17 @@ func Callback(
 		return "", errors.Wrap(ctx, err, op)
 	}
 	if len(mgs) > 0 {
-		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
 		evalData := map[string]any{
 			"token":    idTkClaims,
 			"userinfo": userInfoClaims,
 		}
-		// Iterate through and check claims against filters
-		for _, mg := range mgs {
-			eval, err := bexpr.CreateEvaluator(mg.Filter)
-			if err != nil {
-				// We check all filters on ingress so this should never happen,
-				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
-			}
-			match, err := eval.Evaluate(evalData)
-			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
-			}
-			if match {
-				matchedMgs = append(matchedMgs, mg)
-			}
+		matchedMgs, err := resolveMemberships(ctx, am, mgs, evalData)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		matchedMgs, err = r.keepFrozenMemberships(ctx, acct, mgs, matchedMgs)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
 		}
 		// We always pass it in, even if none match, because in that case we
 		// need to remove any mappings that exist
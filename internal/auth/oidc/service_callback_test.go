@@ -698,6 +698,7 @@ func Test_ManagedGroupFiltering(t *testing.T) {
 	tests := []struct {
 		name        string
 		filters     []string        // Should always be length 2, and specify the filters to use for the test
+		frozen      []bool          // Which of the managed groups are frozen, none if unset
 		matchingMgs []*ManagedGroup // The public IDs of the managed groups we expect the user to be in
 	}{
 		{
@@ -747,6 +748,25 @@ func Test_ManagedGroupFiltering(t *testing.T) {
 			},
 			matchingMgs: mgs[1:2],
 		},
+		{
+			// The previous test left the user in the second managed group.
+			name: "frozen keeps members",
+			filters: []string{
+				TestFakeManagedGroupFilter,
+				TestFakeManagedGroupFilter,
+			},
+			frozen:      []bool{false, true},
+			matchingMgs: mgs[1:2],
+		},
+		{
+			name: "frozen adds no members",
+			filters: []string{
+				`"/token/nonce" == "nonce"`,
+				`"/token/nonce" == "nonce"`,
+			},
+			frozen:      []bool{true, false},
+			matchingMgs: mgs[1:2],
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -775,7 +795,8 @@ func Test_ManagedGroupFiltering(t *testing.T) {
 			}
 			for i, filter := range tt.filters {
 				mgs[i].Filter = filter
-				_, numUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, mgs[i], currVersionMap[mgs[i].PublicId], []string{"Filter"})
+				mgs[i].Frozen = len(tt.frozen) > i && tt.frozen[i]
+				_, numUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, mgs[i], currVersionMap[mgs[i].PublicId], []string{"Filter", "Frozen"})
 				require.Equal(numUpdated, 1)
 				require.NoError(err)
 			}
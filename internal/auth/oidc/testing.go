@@ -123,6 +123,26 @@ func TestAuthMethod(
 		require.NoError(rw.CreateItems(ctx, newAccountClaimMaps))
 		require.Equal(len(opts.withAccountClaimMap), len(authMethod.AccountClaimMaps))
 	}
+	if len(opts.withClaimAliases) > 0 {
+		newClaimAliases := make([]any, 0, len(opts.withClaimAliases))
+		for alias, selector := range opts.withClaimAliases {
+			ca, err := NewClaimAlias(ctx, authMethod.PublicId, alias, selector)
+			require.NoError(err)
+			newClaimAliases = append(newClaimAliases, ca)
+		}
+		require.NoError(rw.CreateItems(ctx, newClaimAliases))
+		require.Equal(len(opts.withClaimAliases), len(authMethod.ClaimAliases))
+	}
+	if len(opts.withClaimTypes) > 0 {
+		newClaimTypes := make([]any, 0, len(opts.withClaimTypes))
+		for selector, claimType := range opts.withClaimTypes {
+			ct, err := NewClaimType(ctx, authMethod.PublicId, selector, claimType)
+			require.NoError(err)
+			newClaimTypes = append(newClaimTypes, ct)
+		}
+		require.NoError(rw.CreateItems(ctx, newClaimTypes))
+		require.Equal(len(opts.withClaimTypes), len(authMethod.ClaimTypes))
+	}
 	authMethod.OperationalState = string(state)
 	rowsUpdated, err := rw.Update(ctx, authMethod, []string{OperationalStateField}, nil)
 	require.NoError(err)
@@ -199,6 +219,24 @@ func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, filter string,
 	return mg
 }
 
+// TestManagedGroupTemplate creates a test oidc managed group template.
+func TestManagedGroupTemplate(t testing.TB, conn *db.DB, scopeId, name, filter string, opt ...Option) *ManagedGroupTemplate {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mgt, err := NewManagedGroupTemplate(ctx, scopeId, name, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupTemplateId(ctx)
+	require.NoError(err)
+	mgt.PublicId = id
+
+	require.NoError(rw.Create(ctx, mgt))
+	return mgt
+}
+
 // TestManagedGroupMember adds given account IDs to a managed group
 func TestManagedGroupMember(t testing.TB, conn *db.DB, managedGroupId, memberId string, opt ...Option) *ManagedGroupMemberAccount {
 	t.Helper()
@@ -28,4 +28,30 @@ type Account interface {
 type ManagedGroup interface {
 	boundary.Resource
 	GetAuthMethodId() string
+	GetKind() string
+	GetOwnerId() string
 }
+
+// The kinds of managed group, which say how their members are found.
+const (
+	// ManagedGroupKindLogin managed groups are matched against accounts when
+	// they log in. Managed groups are of this kind unless set otherwise.
+	ManagedGroupKindLogin = "login"
+
+	// ManagedGroupKindSynced managed groups have their members synced from an
+	// external directory, so they aren't matched at login.
+	ManagedGroupKindSynced = "synced"
+)
+
+// The sources of managed group memberships, which say how an account became a
+// member.
+const (
+	// MembershipSourceLogin memberships were added because the account
+	// matched the managed group when it logged in or memberships were
+	// refreshed.
+	MembershipSourceLogin = "login"
+
+	// MembershipSourceSync memberships were added from outside Boundary
+	// rather than by matching the account, e.g. as initial members.
+	MembershipSourceSync = "sync"
+)
@@ -56,3 +56,30 @@ func TestManagedGroupMemberAccounts(t *testing.T, conn *db.DB, managedGroupId st
 	TestSortManagedGroupMemberAccounts(t, mgmAccts)
 	return mgmAccts
 }
+
+// TestManagedGroupTagOplogEntries returns the number of oplog entries which
+// write tags of the specified managed group.
+func TestManagedGroupTagOplogEntries(t testing.TB, r db.Reader, managedGroupId string) int {
+	t.Helper()
+	const query = `
+select count(distinct entry_id)
+  from oplog_metadata
+ where key = 'resource-type'
+   and value = 'managed group tag'
+   and entry_id in (
+     select entry_id
+       from oplog_metadata
+      where key = 'resource-public-id'
+        and value = ?
+   )
+`
+	rows, err := r.Query(context.Background(), query, []any{managedGroupId})
+	require.NoError(t, err)
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		require.NoError(t, rows.Scan(&count))
+	}
+	require.NoError(t, rows.Err())
+	return count
+}
@@ -31,6 +31,8 @@ type extraOidcCmdVars struct {
 	flagAllowedAudiences                  []string
 	flagClaimsScopes                      []string
 	flagAccountClaimMaps                  []string
+	flagClaimAliases                      []string
+	flagClaimTypes                        []string
 	flagDisableDiscoveredConfigValidation bool
 	flagDryRun                            bool
 }
@@ -47,6 +49,8 @@ const (
 	allowedAudienceFlagName                   = "allowed-audience"
 	claimsScopes                              = "claims-scopes"
 	accountClaimMaps                          = "account-claim-maps"
+	claimAliases                              = "claim-aliases"
+	claimTypes                                = "claim-types"
 	stateFlagName                             = "state"
 	disableDiscoveredConfigValidationFlagName = "disable-discovered-config-validation"
 	dryRunFlagName                            = "dry-run"
@@ -65,6 +69,8 @@ func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 			allowedAudienceFlagName,
 			claimsScopes,
 			accountClaimMaps,
+			claimAliases,
+			claimTypes,
 		},
 		"change-state": {
 			idFlagName,
@@ -141,6 +147,18 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 				Target: &c.flagAccountClaimMaps,
 				Usage:  `The optional account claim maps from custom claims to the standard claims of sub, name and email.  These maps are represented as key=value where the key equals the Provider from-claim and the value equals the Boundary to-claim.  For example "oid=sub". May be specified multiple times for different to-claims.`,
 			})
+		case claimAliases:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   claimAliases,
+				Target: &c.flagClaimAliases,
+				Usage:  `The optional claim aliases which managed group filters can reference as @alias instead of repeating a selector. These aliases are represented as alias=selector where the selector is a JSON pointer.  For example "groups=/token/groups". May be specified multiple times.`,
+			})
+		case claimTypes:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   claimTypes,
+				Target: &c.flagClaimTypes,
+				Usage:  `The optional types of claims, used to reject managed group filters which compare a claim in a way that never matches a claim of its type. These types are represented as selector=type where the selector is a JSON pointer and the type is one of string, number, bool or list.  For example "/token/groups=list". May be specified multiple times.`,
+			})
 		case stateFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   stateFlagName,
@@ -277,6 +295,20 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 	default:
 		*opts = append(*opts, authmethods.WithOidcAuthMethodAccountClaimMaps(c.flagAccountClaimMaps))
 	}
+	switch {
+	case len(c.flagClaimAliases) == 0:
+	case len(c.flagClaimAliases) == 1 && c.flagClaimAliases[0] == "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodClaimAliases())
+	default:
+		*opts = append(*opts, authmethods.WithOidcAuthMethodClaimAliases(c.flagClaimAliases))
+	}
+	switch {
+	case len(c.flagClaimTypes) == 0:
+	case len(c.flagClaimTypes) == 1 && c.flagClaimTypes[0] == "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodClaimTypes())
+	default:
+		*opts = append(*opts, authmethods.WithOidcAuthMethodClaimTypes(c.flagClaimTypes))
+	}
 	if c.flagDisableDiscoveredConfigValidation {
 		*opts = append(*opts, authmethods.WithOidcAuthMethodDisableDiscoveredConfigValidation(c.flagDisableDiscoveredConfigValidation))
 	}
@@ -216,6 +216,60 @@ type Controller struct {
 
 	// License is the license used by HCP builds
 	License string `hcl:"license"`
+
+	// HideUnauthorizedManagedGroups makes requests on a managed group which
+	// the caller isn't authorized to perform fail as if the managed group
+	// didn't exist.
+	HideUnauthorizedManagedGroups bool `hcl:"hide_unauthorized_managed_groups"`
+
+	// ManagedGroupsMutationRateLimit is the sustained number of managed group
+	// create, update and delete requests per second allowed for a single user,
+	// who can make ManagedGroupsMutationBurst of them at once. 0 doesn't limit
+	// mutations.
+	ManagedGroupsMutationRateLimit float64 `hcl:"managed_groups_mutation_rate_limit"`
+	ManagedGroupsMutationBurst     int     `hcl:"managed_groups_mutation_burst"`
+
+	// ManagedGroupsDefaultListLimit is the maximum number of managed groups
+	// returned by a list request which doesn't set a page size. 0 returns
+	// every managed group.
+	ManagedGroupsDefaultListLimit int `hcl:"managed_groups_default_list_limit"`
+
+	// ManagedGroupsDefaultSort is the field managed groups are ordered by in a
+	// list request which doesn't set one.
+	ManagedGroupsDefaultSort string `hcl:"managed_groups_default_sort"`
+
+	// ManagedGroupsMaxPerAuthMethod is the number of managed groups an auth
+	// method can hold. 0 uses the default and -1 removes the cap.
+	ManagedGroupsMaxPerAuthMethod int `hcl:"managed_groups_max_per_auth_method"`
+
+	// ManagedGroupsMaxListProcessed is the number of managed groups a list
+	// request processes before it returns a truncated list. 0 processes every
+	// managed group.
+	ManagedGroupsMaxListProcessed int `hcl:"managed_groups_max_list_processed"`
+
+	// ManagedGroupsMaxScopeFanOut is the number of auth methods a managed
+	// group request made for a whole scope covers before it returns a
+	// truncated response. 0 covers every auth method of the scope.
+	ManagedGroupsMaxScopeFanOut int `hcl:"managed_groups_max_scope_fan_out"`
+
+	// ManagedGroupsUniqueFilters rejects OIDC managed groups whose filter is
+	// equivalent to the filter of another managed group of the same auth
+	// method.
+	ManagedGroupsUniqueFilters bool `hcl:"managed_groups_unique_filters"`
+
+	// ManagedGroupsRejectMatchAllFilters rejects creating OIDC managed groups
+	// whose filter appears to match every authenticated user, unless the
+	// request allows it.
+	ManagedGroupsRejectMatchAllFilters bool `hcl:"managed_groups_reject_match_all_filters"`
+
+	// ManagedGroupsCompressListOver is the number of managed groups a list
+	// response must exceed to be sent compressed with gzip to clients which
+	// accept it. 0 leaves compression to the transport.
+	ManagedGroupsCompressListOver int `hcl:"managed_groups_compress_list_over"`
+
+	// ManagedGroupsRpcObservations writes an observation event at the start
+	// and the end of every managed group request.
+	ManagedGroupsRpcObservations bool `hcl:"managed_groups_rpc_observations"`
 }
 
 func (c *Controller) InitNameIfEmpty(ctx context.Context) error {
@@ -353,6 +353,22 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	return
 }
 
+// Anonymous reports whether the request carries no token, so it can only ever
+// be authorized as the anonymous user. Unlike Verify it performs no lookups,
+// so handlers can use it to decide how to report a failure which happened
+// before they found the resource to authorize the request against. A request
+// with a token which later fails to validate isn't considered anonymous here.
+func Anonymous(ctx context.Context) bool {
+	v, ok := ctx.Value(verifierKey).(*verifier)
+	if !ok {
+		return false
+	}
+	if v.requestInfo.DisableAuthEntirely {
+		return false
+	}
+	return v.requestInfo.TokenFormat == uint32(AuthTokenTypeUnknown)
+}
+
 func (v *verifier) decryptToken(ctx context.Context) {
 	const op = "auth.(verifier).decryptToken"
 	switch v.requestInfo.TokenFormat {
@@ -684,6 +700,48 @@ func (r *VerifyResults) FetchActionSetForId(ctx context.Context, id string, avai
 	return r.fetchActions(id, resource.Unknown, availableActions, opt...)
 }
 
+// FetchActionSetsForIds returns the allowed actions for each of the given IDs,
+// the same as calling FetchActionSetForId for each of them. Only the IDs named
+// by a grant in the resource's scope are evaluated one by one; the actions for
+// every other ID are evaluated once and shared. IDs with no allowed actions map
+// to nil.
+func (r *VerifyResults) FetchActionSetsForIds(ctx context.Context, ids []string, availableActions action.ActionSet, opt ...Option) map[string]action.ActionSet {
+	ret := make(map[string]action.ActionSet, len(ids))
+	if len(ids) == 0 {
+		return ret
+	}
+	opts := getOpts(opt...)
+	res := opts.withResource
+	if res == nil {
+		res = r.v.res
+	}
+	if res == nil {
+		res = new(perms.Resource)
+	}
+	// fetchActions sets the ID on the resource it's given, so give it a copy
+	// to leave the caller's alone.
+	perId := func(id string) action.ActionSet {
+		res := *res
+		return r.fetchActions(id, resource.Unknown, availableActions, WithResource(&res))
+	}
+
+	granted := r.ACL().GrantedIds(res.ScopeId)
+	var shared action.ActionSet
+	var sharedFetched bool
+	for _, id := range ids {
+		switch {
+		case granted[id]:
+			ret[id] = perId(id)
+		case !sharedFetched:
+			shared, sharedFetched = perId(id), true
+			fallthrough
+		default:
+			ret[id] = shared
+		}
+	}
+	return ret
+}
+
 // FetchActionSetForType returns the allowed actions for a given collection type
 // using the current set of ACLs and all other parameters the same (user, etc.)
 func (r *VerifyResults) FetchActionSetForType(ctx context.Context, typ resource.Type, availableActions action.ActionSet, opt ...Option) action.ActionSet {
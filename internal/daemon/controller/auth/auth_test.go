@@ -20,8 +20,12 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/tests/api"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/util/template"
 	"github.com/hashicorp/eventlogger/filters/encrypt"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -263,3 +267,102 @@ func TestVerify_AuditEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestAnonymous(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	verifierCtx := func(requestInfo *authpb.RequestInfo) context.Context {
+		return NewVerifierContext(ctx, nil, nil, nil, nil, requestInfo)
+	}
+
+	assert.False(t, Anonymous(ctx), "no verifier")
+	assert.True(t, Anonymous(verifierCtx(&authpb.RequestInfo{})), "no token")
+	assert.False(t, Anonymous(verifierCtx(&authpb.RequestInfo{
+		TokenFormat: uint32(AuthTokenTypeBearer),
+		PublicId:    "at_1234567890",
+		Token:       "token",
+	})), "bearer token")
+	assert.False(t, Anonymous(verifierCtx(&authpb.RequestInfo{
+		TokenFormat:    uint32(AuthTokenTypeRecoveryKms),
+		EncryptedToken: "token",
+	})), "recovery token")
+	assert.False(t, Anonymous(verifierCtx(&authpb.RequestInfo{DisableAuthEntirely: true})), "auth disabled")
+}
+
+func TestFetchActionSetsForIds(t *testing.T) {
+	ctx := context.Background()
+	var grants []perms.Grant
+	for _, g := range []string{
+		"id=*;type=managed-group;actions=read",
+		"id=mgoidc_2;actions=update,delete",
+		"ids=mgoidc_3;actions=*",
+		"id=mgoidc_other;actions=update",
+	} {
+		grant, err := perms.Parse(ctx, "o_1", g)
+		require.NoError(t, err)
+		grants = append(grants, grant)
+	}
+	userId := "u_1234567890"
+	ids := []string{"mgoidc_1", "mgoidc_2", "mgoidc_3", "mgoidc_4"}
+	available := action.ActionSet{action.NoOp, action.Read, action.Update, action.Delete}
+	res := &perms.Resource{ScopeId: "o_1", Type: resource.ManagedGroup, Pin: "amoidc_1"}
+
+	cases := []struct {
+		name        string
+		requestInfo *authpb.RequestInfo
+		userId      *string
+		want        map[string]action.ActionSet
+	}{
+		{
+			name:        "grants",
+			requestInfo: &authpb.RequestInfo{},
+			userId:      &userId,
+			want: map[string]action.ActionSet{
+				"mgoidc_1": {action.Read},
+				"mgoidc_2": {action.Read, action.Update, action.Delete},
+				"mgoidc_3": available,
+				"mgoidc_4": {action.Read},
+			},
+		},
+		{
+			name:        "auth disabled",
+			requestInfo: &authpb.RequestInfo{DisableAuthEntirely: true},
+			userId:      &userId,
+			want: map[string]action.ActionSet{
+				"mgoidc_1": available,
+				"mgoidc_2": available,
+				"mgoidc_3": available,
+				"mgoidc_4": available,
+			},
+		},
+		{
+			name:        "no user",
+			requestInfo: &authpb.RequestInfo{},
+			want: map[string]action.ActionSet{
+				"mgoidc_1": nil,
+				"mgoidc_2": nil,
+				"mgoidc_3": nil,
+				"mgoidc_4": nil,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &VerifyResults{
+				UserData: template.Data{User: template.User{Id: tc.userId}},
+				v:        &verifier{requestInfo: tc.requestInfo, acl: perms.NewACL(grants...)},
+			}
+			got := r.FetchActionSetsForIds(ctx, ids, available, WithResource(res))
+			assert.Equal(t, tc.want, got)
+			// The caller's resource is left alone.
+			assert.Empty(t, res.Id)
+
+			// Each ID gets what it gets on its own.
+			for _, id := range ids {
+				res := *res
+				assert.Equal(t, r.FetchActionSetForId(ctx, id, available, WithResource(&res)), got[id], id)
+			}
+		})
+	}
+	assert.Empty(t, (&VerifyResults{v: &verifier{}}).FetchActionSetsForIds(ctx, nil, available))
+}
@@ -108,7 +108,15 @@ func newGrpcServer(
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(
-				streamCtxInterceptor,
+				streamCtxInterceptor,                                // populated requestInfo from headers into the request ctx
+				errorStreamInterceptor(ctx),                         // convert domain and api errors into headers for the http proxy
+				subtypes.AttributeTransformerStreamInterceptor(ctx), // convert to/from generic attributes from/to subtype specific attributes
+				auditRequestStreamInterceptor(ctx),                  // audit each request msg
+				statusCodeStreamInterceptor(ctx),                    // convert nil msgs into empty responses with a 204 status code for the http proxy
+				auditResponseStreamInterceptor(ctx),                 // audit each response msg
+				grpc_recovery.StreamServerInterceptor( // recover from panics with a grpc internal error
+					grpc_recovery.WithRecoveryHandlerContext(recoveryHandler()),
+				),
 			),
 		),
 		grpc.UnaryInterceptor(
@@ -50,6 +50,7 @@ import (
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mr-tron/base58"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -257,7 +258,29 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterSessionServiceServer(s, ss)
 	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn)
+		var mgOpts []managed_groups.Option
+		if c.conf.RawConfig != nil && c.conf.RawConfig.Controller != nil {
+			mgOpts = append(mgOpts,
+				managed_groups.WithHideUnauthorized(c.conf.RawConfig.Controller.HideUnauthorizedManagedGroups),
+				managed_groups.WithDefaultListLimit(c.conf.RawConfig.Controller.ManagedGroupsDefaultListLimit),
+				managed_groups.WithMaxListProcessed(c.conf.RawConfig.Controller.ManagedGroupsMaxListProcessed),
+				managed_groups.WithMaxScopeFanOut(c.conf.RawConfig.Controller.ManagedGroupsMaxScopeFanOut),
+				managed_groups.WithUniqueFilters(c.conf.RawConfig.Controller.ManagedGroupsUniqueFilters),
+				managed_groups.WithRejectMatchAllFilters(c.conf.RawConfig.Controller.ManagedGroupsRejectMatchAllFilters),
+				managed_groups.WithCompressListOver(c.conf.RawConfig.Controller.ManagedGroupsCompressListOver),
+				managed_groups.WithRpcObservations(c.conf.RawConfig.Controller.ManagedGroupsRpcObservations),
+			)
+			if sortBy := c.conf.RawConfig.Controller.ManagedGroupsDefaultSort; sortBy != "" {
+				mgOpts = append(mgOpts, managed_groups.WithDefaultSort(sortBy))
+			}
+			if limit := c.conf.RawConfig.Controller.ManagedGroupsMutationRateLimit; limit > 0 {
+				mgOpts = append(mgOpts, managed_groups.WithMutationRateLimit(rate.Limit(limit), c.conf.RawConfig.Controller.ManagedGroupsMutationBurst))
+			}
+			if max := c.conf.RawConfig.Controller.ManagedGroupsMaxPerAuthMethod; max != 0 {
+				mgOpts = append(mgOpts, managed_groups.WithMaxManagedGroupsPerAuthMethod(max))
+			}
+		}
+		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn, c.IamRepoFn, mgOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
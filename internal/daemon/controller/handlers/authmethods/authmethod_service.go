@@ -862,6 +862,8 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 			AllowedAudiences:  i.GetAudClaims(),
 			ClaimsScopes:      i.GetClaimsScopes(),
 			AccountClaimMaps:  i.GetAccountClaimMaps(),
+			ClaimAliases:      i.GetClaimAliases(),
+			ClaimTypes:        i.GetClaimTypes(),
 		}
 		if i.DisableDiscoveredConfigValidation {
 			attrs.DisableDiscoveredConfigValidation = true
@@ -1061,6 +1063,16 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 						foundTo[m.To] = true
 					}
 				}
+				if len(attrs.GetClaimAliases()) > 0 {
+					if _, err := oidc.ParseClaimAliases(ctx, attrs.GetClaimAliases()...); err != nil {
+						badFields[claimAliasesField] = fmt.Sprintf("Contains invalid alias %q", err.Error())
+					}
+				}
+				if len(attrs.GetClaimTypes()) > 0 {
+					if _, err := oidc.ParseClaimTypes(ctx, attrs.GetClaimTypes()...); err != nil {
+						badFields[claimTypesField] = fmt.Sprintf("Contains invalid type %q", err.Error())
+					}
+				}
 			}
 		case ldap.Subtype:
 			if len(req.GetItem().GetLdapAuthMethodsAttributes().GetUrls()) == 0 {
@@ -1192,6 +1204,16 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 						}
 					}
 				}
+				if len(attrs.GetClaimAliases()) > 0 {
+					if _, err := oidc.ParseClaimAliases(ctx, attrs.GetClaimAliases()...); err != nil {
+						badFields[claimAliasesField] = fmt.Sprintf("Contains invalid alias %q", err.Error())
+					}
+				}
+				if len(attrs.GetClaimTypes()) > 0 {
+					if _, err := oidc.ParseClaimTypes(ctx, attrs.GetClaimTypes()...); err != nil {
+						badFields[claimTypesField] = fmt.Sprintf("Contains invalid type %q", err.Error())
+					}
+				}
 			}
 		case ldap.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != ldap.Subtype {
@@ -46,6 +46,8 @@ const (
 	codeField                              = "attributes.code"
 	claimsScopesField                      = "attributes.claims_scopes"
 	accountClaimMapsField                  = "attributes.account_claim_maps"
+	claimAliasesField                      = "attributes.claim_aliases"
+	claimTypesField                        = "attributes.claim_types"
 )
 
 var oidcMaskManager handlers.MaskManager
@@ -483,6 +485,22 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 		opts = append(opts, oidc.WithAccountClaimMap(claimsMap))
 	}
 
+	if len(attrs.GetClaimAliases()) > 0 {
+		aliases, err := oidc.ParseClaimAliases(ctx, attrs.GetClaimAliases()...)
+		if err != nil {
+			return nil, false, false, errors.Wrap(ctx, err, op)
+		}
+		opts = append(opts, oidc.WithClaimAliases(aliases))
+	}
+
+	if len(attrs.GetClaimTypes()) > 0 {
+		types, err := oidc.ParseClaimTypes(ctx, attrs.GetClaimTypes()...)
+		if err != nil {
+			return nil, false, false, errors.Wrap(ctx, err, op)
+		}
+		opts = append(opts, oidc.WithClaimTypes(types))
+	}
+
 	u, err := oidc.NewAuthMethod(ctx, scopeId, clientId, clientSecret, opts...)
 	if err != nil {
 		return nil, false, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
@@ -19,6 +19,7 @@ import (
 	pberrors "github.com/hashicorp/boundary/internal/gen/errors"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/mr-tron/base58"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -57,6 +58,43 @@ func (e *ApiError) Error() string {
 	return res
 }
 
+// GRPCStatus returns the status gRPC reports for the error. Each invalid
+// request field is attached as a google.rpc.BadRequest field violation. The
+// message is the one returned by Error, which the gateway falls back to parsing
+// when the error isn't passed along in a header.
+func (e *ApiError) GRPCStatus() *status.Status {
+	const op = "handlers.(ApiError).GRPCStatus"
+	st := status.New(codeFromKind(e.Inner.GetKind()), e.Error())
+	fields := e.Inner.GetDetails().GetRequestFields()
+	if len(fields) == 0 {
+		return st
+	}
+	br := &errdetails.BadRequest{}
+	for _, rf := range fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       rf.GetName(),
+			Description: rf.GetDescription(),
+		})
+	}
+	withDetails, err := st.WithDetails(br)
+	if err != nil {
+		event.WriteError(context.TODO(), op, err, event.WithInfoMsg("unable to attach field violations to status"))
+		return st
+	}
+	return withDetails
+}
+
+// codeFromKind returns the code whose name is kind, or codes.Unknown when
+// there is none.
+func codeFromKind(kind string) codes.Code {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if c.String() == kind {
+			return c
+		}
+	}
+	return codes.Unknown
+}
+
 func (e *ApiError) Is(target error) bool {
 	var tApiErr *ApiError
 	if !errors.As(target, &tApiErr) {
@@ -18,6 +18,7 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -251,3 +252,33 @@ func TestApiErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestApiError_GRPCStatus(t *testing.T) {
+	err := InvalidArgumentErrorf("Error in provided request.", map[string]string{
+		"name": "Too long.",
+		"id":   "This is a read only field.",
+	})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, err.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+	want := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "id", Description: "This is a read only field."},
+		{Field: "name", Description: "Too long."},
+	}}
+	assert.Empty(t, cmp.Diff(want, st.Details()[0], protocmp.Transform()))
+
+	// The status is found through wrapping errors.
+	st = status.Convert(fmt.Errorf("wrapped: %w", err))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 1)
+
+	// Errors without fields have no details.
+	st = status.Convert(InvalidArgumentErrorf("Error in provided request.", nil))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Empty(t, st.Details())
+
+	assert.Equal(t, codes.NotFound, status.Code(NotFoundError()))
+	assert.Equal(t, codes.Unauthenticated, status.Code(UnauthenticatedError()))
+	assert.Equal(t, codes.Unknown, status.Code(&ApiError{Inner: &pb.Error{Kind: "Madeup"}}))
+}
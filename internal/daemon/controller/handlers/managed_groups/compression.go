@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// compressLargeList asks the transport to send the response to the list
+// request ctx carries compressed with gzip when it holds more than threshold
+// items and the client accepts gzip. A threshold of 0 leaves compression to
+// the transport. It reports whether compression was requested; failing to
+// request it only leaves the response uncompressed, so it never fails the
+// request.
+func compressLargeList(ctx context.Context, op event.Op, threshold, items int) bool {
+	if threshold <= 0 || items <= threshold {
+		return false
+	}
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		// Not called through a gRPC transport, e.g. in tests.
+		return false
+	}
+	for _, name := range accepted {
+		if name != gzip.Name {
+			continue
+		}
+		if err := grpc.SetSendCompressor(ctx, gzip.Name); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to compress managed group list response", "items", items))
+			return false
+		}
+		return true
+	}
+	return false
+}
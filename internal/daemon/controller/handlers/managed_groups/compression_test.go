@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestCompressLargeList(t *testing.T) {
+	const op = "managed_groups.TestCompressLargeList"
+	ctx := context.Background()
+
+	// Outside of a gRPC transport nothing is compressed.
+	assert.False(t, compressLargeList(ctx, op, 1, 10))
+
+	// compressed records what compressLargeList returned for each call.
+	compressed := make(chan bool, 1)
+	var items int
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+			return err
+		}
+		compressed <- compressLargeList(stream.Context(), op, 2, items)
+		return stream.SendMsg(&emptypb.Empty{})
+	}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	for _, tc := range []struct {
+		name  string
+		items int
+		want  bool
+	}{
+		{name: "at threshold", items: 2},
+		{name: "over threshold", items: 3, want: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			items = tc.items
+			require.NoError(t, conn.Invoke(ctx, "/test.Service/List", &emptypb.Empty{}, &emptypb.Empty{}))
+			assert.Equal(t, tc.want, <-compressed)
+		})
+	}
+
+	// Without a threshold the transport decides.
+	assert.False(t, compressLargeList(ctx, op, 0, 10))
+}
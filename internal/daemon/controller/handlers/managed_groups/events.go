@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+const (
+	rpcStageStart = "start"
+	rpcStageEnd   = "end"
+
+	// kinds of changes to a managed group
+	changeCreated = "created"
+	changeUpdated = "updated"
+	changeDeleted = "deleted"
+)
+
+// rpcEvents writes observation events at the start and the end of a managed
+// group RPC when the service is configured to observe them. Both hold the id
+// of the request, which the eventer also uses to correlate them with the
+// request's other events, so the handling of a request can be followed from
+// its logs.
+//
+// The events only hold ids and the outcome of the RPC, never the request's
+// attributes: filters can hold sensitive selectors and values.
+type rpcEvents struct {
+	op      event.Op
+	observe bool
+	// id is the id of the resource the RPC is performed on: the managed
+	// group, or the auth method for RPCs on its collection of managed groups.
+	id      string
+	scopeId string
+}
+
+// startRpcEvents writes the start event of the RPC performed on the resource
+// with the provided id.
+func (s Service) startRpcEvents(ctx context.Context, op event.Op, id string) *rpcEvents {
+	e := &rpcEvents{op: op, observe: s.rpcObservations, id: id}
+	e.write(ctx, rpcStageStart)
+	return e
+}
+
+// end writes the end event of the RPC which returned err. The error returned
+// by a handler is translated into an API error which doesn't say where or why
+// it happened, so it is also written as an error event, attributed to the
+// RPC's op, unless writeError skips it.
+func (e *rpcEvents) end(ctx context.Context, err error) {
+	if err == nil {
+		e.write(ctx, rpcStageEnd, "outcome", "ok")
+		return
+	}
+	e.writeError(ctx, err, "")
+	e.write(ctx, rpcStageEnd, "outcome", "error", "error_kind", handlers.ToApiError(err).GetKind())
+}
+
+// itemFailed writes an error event for err, the failure of a single item of
+// the RPC which is reported in its response rather than returned, so isn't
+// written by end.
+func (e *rpcEvents) itemFailed(ctx context.Context, err error, msg string, args ...any) {
+	e.writeError(ctx, err, msg, args...)
+}
+
+// writeError writes err as an error event. Domain errors were already written
+// when they were created and API errors are the caller's to act on, so neither
+// is written again.
+func (e *rpcEvents) writeError(ctx context.Context, err error, msg string, args ...any) {
+	var domainErr *errors.Err
+	var apiErr *handlers.ApiError
+	if errors.As(err, &domainErr) || errors.As(err, &apiErr) {
+		return
+	}
+	info := append([]any{"resource_id", e.id, "scope_id", e.scopeId}, args...)
+	event.WriteError(ctx, e.op, err, event.WithInfoMsg(msg, info...))
+}
+
+// changed writes an event notifying subscribers of the event pipeline that
+// the managed group with the provided id was changed by the user with the
+// provided id, e.g. so they can resynchronize what depends on its filter. It
+// must only be called once the change is committed, so no notification is
+// written for a change which was rolled back. Failing to write it doesn't fail
+// the change, which already happened, so the failure is only written as an
+// error event.
+func (e *rpcEvents) changed(ctx context.Context, change, id, userId string) {
+	if _, ok := event.EventerFromContext(ctx); !ok && event.SysEventer() == nil {
+		return
+	}
+	details := []any{
+		"change", change,
+		"resource_id", id,
+		"resource_type", resource.ManagedGroup.String(),
+		"user_id", userId,
+	}
+	if e.scopeId != "" {
+		details = append(details, "scope_id", e.scopeId)
+	}
+	if err := event.WriteObservation(ctx, e.op, event.WithDetails(details...)); err != nil {
+		event.WriteError(ctx, e.op, err, event.WithInfoMsg("unable to write managed group change notification", "resource_id", id, "change", change))
+	}
+}
+
+func (e *rpcEvents) write(ctx context.Context, stage string, args ...any) {
+	if !e.observe {
+		return
+	}
+	if _, ok := event.EventerFromContext(ctx); !ok && event.SysEventer() == nil {
+		// Nothing can be written, e.g. in tests.
+		return
+	}
+	details := []any{"stage", stage, "resource_id", e.id}
+	if info, ok := event.RequestInfoFromContext(ctx); ok {
+		details = append(details, "request_id", info.Id)
+	}
+	if e.scopeId != "" {
+		details = append(details, "scope_id", e.scopeId)
+	}
+	details = append(details, args...)
+	if err := event.WriteObservation(ctx, e.op, event.WithDetails(details...)); err != nil {
+		event.WriteError(ctx, e.op, err, event.WithInfoMsg("unable to write managed group rpc observation"))
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpcEvents(t *testing.T) {
+	c := event.TestEventerConfig(t, "TestRpcEvents", event.TestWithObservationSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	testEventer, err := event.NewEventer(testLogger, testLock, "TestRpcEvents", c.EventerConfig)
+	require.NoError(t, err)
+	ctx, err := event.NewEventerContext(context.Background(), testEventer)
+	require.NoError(t, err)
+	ctx, err = event.NewRequestInfoContext(ctx, &event.RequestInfo{EventId: "test-event-id", Id: "test-request-id"})
+	require.NoError(t, err)
+
+	const sensitive = `"/token/ssn" == "123-45-6789"`
+
+	s := Service{rpcObservations: true}
+	rpc := s.startRpcEvents(ctx, "managed_groups.(Service).TestOp", "mgoidc_1234567890")
+	rpc.scopeId = "o_1234567890"
+	rpc.end(ctx, errors.New(ctx, errors.Internal, "managed_groups.(Service).TestOp", "invalid filter "+sensitive))
+
+	rpc = s.startRpcEvents(ctx, "managed_groups.(Service).OtherOp", "mgoidc_0987654321")
+	rpc.end(ctx, handlers.NotFoundError())
+
+	rpc = s.startRpcEvents(ctx, "managed_groups.(Service).ThirdOp", "mgoidc_1122334455")
+	rpc.end(ctx, stderrors.New("other failure"))
+
+	// Without observations only the error is written.
+	rpc = Service{}.startRpcEvents(ctx, "managed_groups.(Service).UnobservedOp", "mgoidc_5544332211")
+	rpc.end(ctx, stderrors.New("unobserved failure"))
+	// The observations of a request are written once it is flushed.
+	require.NoError(t, event.WriteObservation(ctx, "TestRpcEvents", event.WithFlush()))
+
+	observations, err := os.ReadFile(c.ObservationEvents.Name())
+	require.NoError(t, err)
+	got := string(observations)
+	for _, want := range []string{
+		"managed_groups.(Service).TestOp",
+		`"request_id":"test-request-id"`,
+		`"resource_id":"mgoidc_1234567890"`,
+		`"scope_id":"o_1234567890"`,
+		`"stage":"start"`,
+		`"stage":"end"`,
+		`"outcome":"error"`,
+		`"error_kind":"Internal"`,
+		`"error_kind":"NotFound"`,
+	} {
+		assert.Contains(t, got, want)
+	}
+	assert.NotContains(t, got, "123-45-6789")
+	assert.NotContains(t, got, "UnobservedOp")
+
+	errs, err := os.ReadFile(c.ErrorEvents.Name())
+	require.NoError(t, err)
+	gotErrs := string(errs)
+	// The domain error was written when it was created, and not again.
+	assert.Equal(t, 1, strings.Count(gotErrs, `"Msg":"invalid filter `))
+	// The error which is neither is written with its details.
+	assert.Equal(t, 1, strings.Count(gotErrs, `"error":"other failure"`))
+	assert.Contains(t, gotErrs, `"resource_id":"mgoidc_1122334455"`)
+	assert.Contains(t, gotErrs, "unobserved failure")
+}
+
+func TestRpcEvents_changed(t *testing.T) {
+	c := event.TestEventerConfig(t, "TestRpcEvents_changed", event.TestWithObservationSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	testEventer, err := event.NewEventer(testLogger, testLock, "TestRpcEvents_changed", c.EventerConfig)
+	require.NoError(t, err)
+	ctx, err := event.NewEventerContext(context.Background(), testEventer)
+	require.NoError(t, err)
+	ctx, err = event.NewRequestInfoContext(ctx, &event.RequestInfo{EventId: "test-event-id", Id: "test-request-id"})
+	require.NoError(t, err)
+
+	rpc := &rpcEvents{op: "managed_groups.(Service).UpdateManagedGroup", id: "mgoidc_1234567890", scopeId: "o_1234567890"}
+	rpc.changed(ctx, changeUpdated, "mgoidc_1234567890", "u_1234567890")
+	require.NoError(t, event.WriteObservation(ctx, "TestRpcEvents_changed", event.WithFlush()))
+
+	observations, err := os.ReadFile(c.ObservationEvents.Name())
+	require.NoError(t, err)
+	got := string(observations)
+	for _, want := range []string{
+		`"change":"updated"`,
+		`"resource_id":"mgoidc_1234567890"`,
+		`"resource_type":"managed-group"`,
+		`"user_id":"u_1234567890"`,
+		`"scope_id":"o_1234567890"`,
+	} {
+		assert.Contains(t, got, want)
+	}
+
+	// Without an eventer nothing is written and nothing fails.
+	rpc.changed(context.Background(), changeDeleted, "mgoidc_1234567890", "u_1234567890")
+}
+
+func TestRpcEvents_itemFailed(t *testing.T) {
+	c := event.TestEventerConfig(t, "TestRpcEvents_itemFailed")
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	testEventer, err := event.NewEventer(testLogger, testLock, "TestRpcEvents_itemFailed", c.EventerConfig)
+	require.NoError(t, err)
+	ctx, err := event.NewEventerContext(context.Background(), testEventer)
+	require.NoError(t, err)
+
+	rpc := &rpcEvents{op: "managed_groups.(Service).ImportManagedGroups", id: "amoidc_1234567890", scopeId: "o_1234567890"}
+	// The domain error is written when it's created, and not again.
+	rpc.itemFailed(ctx, errors.New(ctx, errors.Internal, "managed_groups.(Service).ImportManagedGroups", "domain failure"), "unable to import managed group")
+	rpc.itemFailed(ctx, handlers.NotFoundErrorf("api failure"), "unable to import managed group")
+	rpc.itemFailed(ctx, stderrors.New("other failure"), "unable to import managed group", "name", "admins")
+
+	errs, err := os.ReadFile(c.ErrorEvents.Name())
+	require.NoError(t, err)
+	got := string(errs)
+	assert.Equal(t, 1, strings.Count(got, `"Msg":"domain failure"`))
+	assert.NotContains(t, got, "api failure")
+	assert.Equal(t, 1, strings.Count(got, `"error":"other failure"`))
+	assert.Contains(t, got, `"name":"admins"`)
+	assert.Contains(t, got, `"resource_id":"amoidc_1234567890"`)
+}
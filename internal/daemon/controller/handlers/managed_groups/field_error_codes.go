@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
+	"google.golang.org/grpc/codes"
+)
+
+// Codes reported alongside the description of each invalid request field.
+// These are part of the API and must not change once released.
+const (
+	FieldErrorRequired          = "required"
+	FieldErrorInvalidId         = "invalid_id"
+	FieldErrorInvalidFilter     = "invalid_filter"
+	FieldErrorReadOnly          = "read_only"
+	FieldErrorTypeMismatch      = "type_mismatch"
+	FieldErrorConflictingFields = "conflicting_fields"
+	FieldErrorInvalidValue      = "invalid_value"
+	FieldErrorAlreadyExists     = "already_exists"
+	FieldErrorAmbiguous         = "ambiguous"
+)
+
+// invalidField is why a field of a request is invalid: one of the codes
+// above and a description.
+type invalidField struct {
+	code        string
+	description string
+}
+
+// invalidFields holds the invalid fields of a request by name. Each field is
+// added with its code where the validator finds it invalid.
+type invalidFields map[string]invalidField
+
+// add records that field is invalid, reported with code and description.
+func (f invalidFields) add(field, code, description string) {
+	f[field] = invalidField{code: code, description: description}
+}
+
+// descriptions returns the description of each invalid field, as taken by the
+// shared handler validators.
+func (f invalidFields) descriptions() map[string]string {
+	out := make(map[string]string, len(f))
+	for name, field := range f {
+		out[name] = field.description
+	}
+	return out
+}
+
+// err returns an invalid argument error reporting each invalid field with its
+// code, or nil if there are none.
+func (f invalidFields) err() error {
+	return f.errWithMessage("Error in provided request.")
+}
+
+// errWithMessage is err with msg as the message of the error.
+func (f invalidFields) errWithMessage(msg string) error {
+	if len(f) == 0 {
+		return nil
+	}
+	return withFieldErrorCodes(handlers.InvalidArgumentErrorf(msg, f.descriptions()), f, nil)
+}
+
+// invalidFieldError returns an invalid argument error reporting the single
+// invalid field with code and description.
+func invalidFieldError(field, code, description string) error {
+	return invalidFields{field: {code: code, description: description}}.err()
+}
+
+// The codes of the fields which the shared handler validators report
+// themselves, by validator. The name and description checks they share are
+// reported as invalid_value.
+var (
+	createRequestFieldCodes = map[string]string{
+		globals.IdField:          FieldErrorReadOnly,
+		globals.CreatedTimeField: FieldErrorReadOnly,
+		globals.UpdatedTimeField: FieldErrorReadOnly,
+		globals.VersionField:     FieldErrorReadOnly,
+	}
+	getRequestFieldCodes = map[string]string{
+		globals.IdField: FieldErrorInvalidId,
+	}
+	deleteRequestFieldCodes = getRequestFieldCodes
+)
+
+// updateRequestFieldCodes returns the codes of the fields which
+// handlers.ValidateUpdateRequest reports itself for the update of item. The
+// id is reported as read only when the item sets it, which takes precedence
+// over the id of the path being malformed.
+func updateRequestFieldCodes(item *pb.ManagedGroup) map[string]string {
+	codes := map[string]string{
+		globals.IdField:          FieldErrorInvalidId,
+		"update_mask":            FieldErrorRequired,
+		globals.VersionField:     FieldErrorRequired,
+		globals.CreatedTimeField: FieldErrorReadOnly,
+		globals.UpdatedTimeField: FieldErrorReadOnly,
+	}
+	if item.GetId() != "" {
+		codes[globals.IdField] = FieldErrorReadOnly
+	}
+	return codes
+}
+
+// withFieldErrorCodes sets the code of each invalid field of err, an invalid
+// argument error returned by a shared handler validator, which only has
+// descriptions. A field reported by the managed group validator has the code
+// it was added to fields with; otherwise its code is taken from shared, the
+// codes of the fields the shared validator reports, or it is invalid_value.
+// Other errors are returned as is.
+func withFieldErrorCodes(err error, fields invalidFields, shared map[string]string) error {
+	var apiErr *handlers.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Inner.GetKind() != codes.InvalidArgument.String() {
+		return err
+	}
+	for _, f := range apiErr.Inner.GetDetails().GetRequestFields() {
+		field, ok := fields[f.GetName()]
+		switch {
+		case ok && field.description == f.GetDescription():
+			f.Code = field.code
+		case shared[f.GetName()] != "":
+			f.Code = shared[f.GetName()]
+		default:
+			f.Code = FieldErrorInvalidValue
+		}
+	}
+	return err
+}
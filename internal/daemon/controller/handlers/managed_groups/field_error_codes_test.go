@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func Test_invalidFields(t *testing.T) {
+	t.Parallel()
+	t.Run("none", func(t *testing.T) {
+		assert.NoError(t, invalidFields{}.err())
+	})
+	t.Run("codes", func(t *testing.T) {
+		badFields := invalidFields{}
+		badFields.add(globals.NameField, FieldErrorAlreadyExists, "A managed group with this name already exists in the auth method.")
+		badFields.add(attrFilterField, FieldErrorInvalidFilter, "Error evaluating submitted filter expression.")
+		err := badFields.err()
+		require.Error(t, err)
+
+		var apiErr *handlers.ApiError
+		require.True(t, errors.As(err, &apiErr))
+		got := map[string]string{}
+		for _, f := range apiErr.Inner.GetDetails().GetRequestFields() {
+			got[f.GetName()] = f.GetCode()
+		}
+		assert.Equal(t, map[string]string{
+			globals.NameField: FieldErrorAlreadyExists,
+			attrFilterField:   FieldErrorInvalidFilter,
+		}, got)
+	})
+}
+
+func Test_withFieldErrorCodes(t *testing.T) {
+	t.Parallel()
+	t.Run("invalid argument", func(t *testing.T) {
+		err := validateCreateRequest(context.Background(), &pbs.CreateManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				CreatedTime:  timestamppb.Now(),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: "foobar"},
+				},
+			},
+		}, false)
+		require.Error(t, err)
+
+		var apiErr *handlers.ApiError
+		require.True(t, errors.As(err, &apiErr))
+		got := map[string]string{}
+		for _, f := range apiErr.Inner.GetDetails().GetRequestFields() {
+			got[f.GetName()] = f.GetCode()
+		}
+		assert.Equal(t, map[string]string{
+			globals.CreatedTimeField: FieldErrorReadOnly,
+			attrFilterField:          FieldErrorInvalidFilter,
+		}, got)
+	})
+	t.Run("other errors", func(t *testing.T) {
+		err := handlers.NotFoundError()
+		assert.Same(t, err, withFieldErrorCodes(err, nil, nil))
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"strings"
+	"unicode"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+)
+
+const (
+	filterDiffEqual  = "equal"
+	filterDiffDelete = "delete"
+	filterDiffInsert = "insert"
+
+	// maxFilterDiffCells bounds the size of the table used to find the
+	// tokens two filters have in common. Filters with more tokens than fit
+	// are diffed as one deleted and one inserted segment.
+	maxFilterDiffCells = 1 << 20
+)
+
+// filterTokens splits filter into tokens: quoted strings, runs of
+// whitespace, parentheses and runs of any other characters. Joining the
+// tokens gives back filter.
+func filterTokens(filter string) []string {
+	var tokens []string
+	rs := []rune(filter)
+	for i := 0; i < len(rs); {
+		j := i + 1
+		switch r := rs[i]; {
+		case r == '"' || r == '`':
+			for j < len(rs) && rs[j] != r {
+				if r == '"' && rs[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(rs) {
+				// Include the closing quote.
+				j++
+			}
+			if j > len(rs) {
+				j = len(rs)
+			}
+		case unicode.IsSpace(r):
+			for j < len(rs) && unicode.IsSpace(rs[j]) {
+				j++
+			}
+		case r == '(' || r == ')':
+		default:
+			for j < len(rs) && !unicode.IsSpace(rs[j]) && !strings.ContainsRune("\"`()", rs[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(rs[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+// diffFilters returns the segments which turn the filter from into the
+// filter to, keeping the longest common sequence of tokens. Adjacent tokens
+// with the same op are joined into one segment.
+func diffFilters(from, to string) []*pbs.ManagedGroupFilterDiffSegment {
+	a, b := filterTokens(from), filterTokens(to)
+	var segs []*pbs.ManagedGroupFilterDiffSegment
+	add := func(op, text string) {
+		if text == "" {
+			return
+		}
+		if n := len(segs); n > 0 && segs[n-1].GetOp() == op {
+			segs[n-1].Text += text
+			return
+		}
+		segs = append(segs, &pbs.ManagedGroupFilterDiffSegment{Op: op, Text: text})
+	}
+	if (len(a)+1)*(len(b)+1) > maxFilterDiffCells {
+		add(filterDiffDelete, from)
+		add(filterDiffInsert, to)
+		return segs
+	}
+
+	// common[i][j] is the length of the longest common sequence of a[i:]
+	// and b[j:].
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(filterDiffEqual, a[i])
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			add(filterDiffDelete, a[i])
+			i++
+		default:
+			add(filterDiffInsert, b[j])
+			j++
+		}
+	}
+	add(filterDiffDelete, strings.Join(a[i:], ""))
+	add(filterDiffInsert, strings.Join(b[j:], ""))
+	return segs
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFilterTokens(t *testing.T) {
+	t.Parallel()
+	filter := `("/token/sub" == "a \"b\" c")  and "admin" in "/token/groups" or ` + "`x y`"
+	got := filterTokens(filter)
+	assert.Equal(t, []string{
+		"(", `"/token/sub"`, " ", "==", " ", `"a \"b\" c"`, ")", "  ", "and", " ",
+		`"admin"`, " ", "in", " ", `"/token/groups"`, " ", "or", " ", "`x y`",
+	}, got)
+	assert.Equal(t, filter, strings.Join(got, ""))
+
+	// An unterminated quote runs to the end.
+	assert.Equal(t, []string{`"/token/sub"`, " ", "==", " ", `"alice`}, filterTokens(`"/token/sub" == "alice`))
+	assert.Empty(t, filterTokens(""))
+}
+
+func TestDiffFilters(t *testing.T) {
+	t.Parallel()
+	seg := func(op, text string) *pbs.ManagedGroupFilterDiffSegment {
+		return &pbs.ManagedGroupFilterDiffSegment{Op: op, Text: text}
+	}
+	cases := []struct {
+		name     string
+		from, to string
+		want     []*pbs.ManagedGroupFilterDiffSegment
+	}{
+		{
+			name: "same",
+			from: `"/token/sub" == "alice"`,
+			to:   `"/token/sub" == "alice"`,
+			want: []*pbs.ManagedGroupFilterDiffSegment{seg("equal", `"/token/sub" == "alice"`)},
+		},
+		{
+			name: "changed value",
+			from: `"/token/sub" == "alice"`,
+			to:   `"/token/sub" == "bob"`,
+			want: []*pbs.ManagedGroupFilterDiffSegment{
+				seg("equal", `"/token/sub" == `),
+				seg("delete", `"alice"`),
+				seg("insert", `"bob"`),
+			},
+		},
+		{
+			name: "added clause",
+			from: `"/token/sub" == "alice"`,
+			to:   `"/token/sub" == "alice" and "admin" in "/token/groups"`,
+			want: []*pbs.ManagedGroupFilterDiffSegment{
+				seg("equal", `"/token/sub" == "alice"`),
+				seg("insert", ` and "admin" in "/token/groups"`),
+			},
+		},
+		{
+			name: "removed clause",
+			from: `"admin" in "/token/groups" and "/token/sub" == "alice"`,
+			to:   `"/token/sub" == "alice"`,
+			want: []*pbs.ManagedGroupFilterDiffSegment{
+				seg("delete", `"admin" in "/token/groups" and `),
+				seg("equal", `"/token/sub" == "alice"`),
+			},
+		},
+		{
+			name: "from empty",
+			to:   `"/token/sub" == "alice"`,
+			want: []*pbs.ManagedGroupFilterDiffSegment{seg("insert", `"/token/sub" == "alice"`)},
+		},
+		{
+			name: "both empty",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := diffFilters(tc.from, tc.to)
+			assert.Empty(t, cmp.Diff(tc.want, got, protocmp.Transform()))
+
+			// The kept and removed segments make up from, and the kept and
+			// inserted ones make up to.
+			var from, to strings.Builder
+			for _, s := range got {
+				if s.GetOp() != filterDiffInsert {
+					from.WriteString(s.GetText())
+				}
+				if s.GetOp() != filterDiffDelete {
+					to.WriteString(s.GetText())
+				}
+			}
+			assert.Equal(t, tc.from, from.String())
+			assert.Equal(t, tc.to, to.String())
+		})
+	}
+
+	// Filters too large to compare token by token are replaced whole.
+	large := strings.Repeat(`"/token/sub" == "alice" or `, 500)
+	got := diffFilters(large+`"x"`, large+`"y"`)
+	want := []*pbs.ManagedGroupFilterDiffSegment{seg("delete", large+`"x"`), seg("insert", large+`"y"`)}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
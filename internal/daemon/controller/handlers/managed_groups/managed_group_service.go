@@ -7,6 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
@@ -17,31 +20,151 @@ import (
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
+	pbauthmethods "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
-	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	// oidc field names
-	attrFilterField     = "attributes.filter"
-	attrGroupNamesField = "attributes.group_names"
+	attrFilterField        = "attributes.filter"
+	attrDisabledField      = "attributes.disabled"
+	attrGroupNamesField    = "attributes.group_names"
+	attrFilterVersionField = "attributes.filter_version"
+	attrFrozenField        = "attributes.frozen"
+
+	attrFilterSyntaxVersionField = "attributes.filter_syntax_version"
+	attrFilterHasWarningsField   = "attributes.filter_has_warnings"
+	attrFilterComplexityField    = "attributes.filter_complexity"
+
+	attrMatchOptionsField         = "attributes.match_options"
+	attrMatchCaseInsensitiveField = "attributes.match_options.case_insensitive"
 
 	domain = "auth"
+
+	// maxPreviewManagedGroups bounds the number of managed groups whose filter
+	// is evaluated by a single PreviewManagedGroupMatches request.
+	maxPreviewManagedGroups = 1000
+
+	// exportDocumentVersion is the version of the ManagedGroupsDocument format
+	// produced by ExportManagedGroups. It must be incremented whenever the
+	// meaning of the document changes.
+	exportDocumentVersion = 1
+
+	// statuses of a ManagedGroupImportResult
+	importStatusCreated     = "created"
+	importStatusWouldCreate = "would_create"
+	importStatusSkipped     = "skipped"
+	importStatusFailed      = "failed"
+
+	// fields ListManagedGroups can order managed groups by
+	sortById          = "id"
+	sortByName        = "name"
+	sortByCreatedTime = "created_time"
+	sortByUpdatedTime = "updated_time"
+	sortByMemberCount = "member_count"
+
+	// role associations ListManagedGroups can be restricted to
+	roleAssociationAny  = "any"
+	roleAssociationNone = "none"
+
+	// statuses of a ManagedGroupFilterReplacement
+	replaceStatusReplaced     = "replaced"
+	replaceStatusWouldReplace = "would_replace"
+	replaceStatusFailed       = "failed"
+
+	// maxAddToRoles bounds the number of roles a single
+	// AddManagedGroupToRoles request can add a managed group to.
+	maxAddToRoles = 100
+
+	// maxInitialMembers bounds the number of accounts a single
+	// CreateManagedGroup request can seed the members of a managed group with.
+	maxInitialMembers = 1000
+
+	// maxBatchGetIds bounds the number of managed groups a single
+	// BatchGetManagedGroups request can get.
+	maxBatchGetIds = 100
+
+	// maxSetEnabledItems bounds the number of managed groups a single
+	// SetManagedGroupsEnabled request can enable or disable.
+	maxSetEnabledItems = 100
+
+	// maxTagKeyLength and maxTagValueLength bound the number of characters
+	// of the keys and values of managed group tags, as the database does.
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+
+	// maxListMembersPageSize bounds the number of members a single
+	// ListManagedGroupMembers request returns.
+	maxListMembersPageSize = 1000
+
+	// membershipReportPageSize is the number of memberships read at a time
+	// while a GenerateManagedGroupMembershipReport request streams them.
+	membershipReportPageSize = 1000
+
+	// pageTokenField is the field of a list request continuing from the page
+	// a previous request returned.
+	pageTokenField = "page_token"
+
+	// initialMembersField is the field of a create request holding the ids of
+	// the accounts to seed the members of the managed group with.
+	initialMembersField = "initial_members"
+
+	// roleIdsField is the field of a request holding the ids of the roles to
+	// add a managed group to.
+	roleIdsField = "role_ids"
+
+	// itemsField is the field of a SetManagedGroupsEnabled request holding the
+	// managed groups to enable or disable.
+	itemsField = "items"
+
+	// afterAuthMethodIdField is the field of a ValidateStoredFilters request
+	// continuing from the auth method a truncated response stopped at.
+	afterAuthMethodIdField = "after_auth_method_id"
+
+	// fields of a managed group template request
+	templateIdField = "template_id"
+	filterField     = "filter"
+
+	// statuses of a ManagedGroupRoleAddition
+	addToRoleStatusAdded            = "added"
+	addToRoleStatusAlreadyPrincipal = "already_principal"
+	addToRoleStatusFailed           = "failed"
+
+	// statuses of a ManagedGroupEnabledResult
+	setEnabledStatusUpdated   = "updated"
+	setEnabledStatusUnchanged = "unchanged"
+	setEnabledStatusFailed    = "failed"
 )
 
 var (
 	oidcMaskManager handlers.MaskManager
 	ldapMaskManager handlers.MaskManager
 
+	// diffFields are the fields DiffManagedGroupVersions compares. They are
+	// the fields a managed group's history records.
+	diffFields = []string{
+		globals.NameField,
+		globals.DescriptionField,
+		attrFilterField,
+		attrDisabledField,
+		attrMatchCaseInsensitiveField,
+	}
+
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
 	IdActions = map[subtypes.Subtype]action.ActionSet{
@@ -72,7 +195,7 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(
 		context.Background(),
 		handlers.MaskDestination{&oidcstore.ManagedGroup{}},
-		handlers.MaskSource{&pb.ManagedGroup{}, &pb.OidcManagedGroupAttributes{}},
+		handlers.MaskSource{&pb.ManagedGroup{}, &pb.OidcManagedGroupAttributes{}, &pb.OidcManagedGroupMatchOptions{}},
 	); err != nil {
 		panic(err)
 	}
@@ -91,38 +214,123 @@ type Service struct {
 
 	oidcRepoFn common.OidcAuthRepoFactory
 	ldapRepoFn common.LdapAuthRepoFactory
+	iamRepoFn  common.IamRepoFactory
+
+	mutationLimiter     *principalLimiter
+	readLimiter         *principalLimiter
+	hideUnauthorized    bool
+	allowCreateDisabled bool
+	defaultListLimit    int
+	defaultSort         string
+	maxListProcessed    int
+	maxScopeFanOut      int
+	uniqueFilters       bool
+	rejectMatchAll      bool
+	compressListOver    int
+	rpcObservations     bool
+	quota               *managedGroupQuota
 }
 
 var _ pbs.ManagedGroupServiceServer = (*Service)(nil)
 
 // NewService returns a managed group service which handles managed group related requests to boundary.
-func NewService(ctx context.Context, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory) (Service, error) {
+func NewService(ctx context.Context, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, iamRepo common.IamRepoFactory, opt ...Option) (Service, error) {
 	const op = "managed_groups.NewService"
+	opts := getOpts(opt...)
 	switch {
 	case oidcRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository provided")
 	case ldapRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ldap repository provided")
+	case iamRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository provided")
+	case opts.withDefaultListLimit < 0:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "default list limit must not be negative")
+	case !validSortField(opts.withDefaultSort):
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported default sort %q", opts.withDefaultSort))
+	case opts.withMaxPerAuthMethod <= 0 && opts.withMaxPerAuthMethod != UnlimitedManagedGroups:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "max managed groups per auth method must be positive or unlimited")
+	case opts.withMaxListProcessed < 0:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "max managed groups processed per list must not be negative")
+	case opts.withMaxScopeFanOut < 0:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "max auth methods per scope request must not be negative")
+	case opts.withCompressListOver < 0:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "list compression threshold must not be negative")
 	}
-	return Service{oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo}, nil
+	return Service{
+		oidcRepoFn:          oidcRepo,
+		ldapRepoFn:          ldapRepo,
+		iamRepoFn:           iamRepo,
+		mutationLimiter:     newPrincipalLimiter(opts.withMutationRateLimit, opts.withMutationBurst),
+		readLimiter:         newPrincipalLimiter(opts.withReadRateLimit, opts.withReadBurst),
+		hideUnauthorized:    opts.withHideUnauthorized,
+		allowCreateDisabled: opts.withAllowCreateDisabled,
+		defaultListLimit:    opts.withDefaultListLimit,
+		defaultSort:         opts.withDefaultSort,
+		maxListProcessed:    opts.withMaxListProcessed,
+		maxScopeFanOut:      opts.withMaxScopeFanOut,
+		uniqueFilters:       opts.withUniqueFilters,
+		rejectMatchAll:      opts.withRejectMatchAll,
+		compressListOver:    opts.withCompressListOver,
+		rpcObservations:     opts.withRpcObservations,
+		quota:               newManagedGroupQuota(opts.withMaxPerAuthMethod),
+	}, nil
 }
 
 // ListManagedGroups implements the interface pbs.ManagedGroupsServiceServer.
-func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGroupsRequest) (*pbs.ListManagedGroupsResponse, error) {
+func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGroupsRequest) (_ *pbs.ListManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).ListManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	sortBy := req.GetSortBy()
+	if sortBy == "" {
+		sortBy = s.defaultSort
+	}
+	var after *listPageToken
+	if req.GetPageToken() != "" {
+		// validateListRequest already decoded the token.
+		t, _ := decodeListPageToken(req.GetAuthMethodId(), req.GetPageToken())
+		if t.SortBy != sortBy {
+			return nil, invalidFieldError(pageTokenField, FieldErrorInvalidValue, "Invalid page token; list from the first page again.")
+		}
+		after = &t
+	}
+	listOpts, err := s.listOptionsFromRepo(ctx, authResults, req)
+	if err != nil {
+		return nil, err
+	}
+	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), listOpts)
 	if err != nil {
 		return nil, err
 	}
 	if len(ul) == 0 {
 		return &pbs.ListManagedGroupsResponse{}, nil
 	}
+	var memberCounts map[string]int
+	if sortBy == sortByMemberCount {
+		if memberCounts, err = s.memberCountsFromRepo(ctx, req.GetAuthMethodId()); err != nil {
+			return nil, err
+		}
+	}
+	position := func(mg auth.ManagedGroup) listPageToken {
+		return listPageTokenOf(req.GetAuthMethodId(), sortBy, mg, memberCounts)
+	}
+	ul = orderForPage(ul, position, after)
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = s.defaultListLimit
+	}
 
 	filter, err := handlers.NewFilter(ctx, req.GetFilter())
 	if err != nil {
@@ -135,12 +343,267 @@ func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGrou
 		Type:    resource.ManagedGroup,
 		Pin:     req.GetAuthMethodId(),
 	}
+	actions := listActions(ctx, authResults, res, ul)
+	// The managed groups after a full page are still filtered until one would
+	// be returned, so the response is only truncated when one is left out.
+	var truncated bool
+	var last auth.ManagedGroup
+	for processed, mg := range ul {
+		full := limit > 0 && len(finalItems) == limit
+		if full && truncated {
+			break
+		}
+		if s.maxListProcessed > 0 && processed == s.maxListProcessed {
+			// Every managed group processed since the last one returned
+			// didn't match, so the next page starts after them.
+			truncated = true
+			last = ul[processed-1]
+			break
+		}
+		// Building and filtering every managed group of a large auth method
+		// takes a while, which is wasted once the client is gone.
+		if err := ctx.Err(); err != nil {
+			return nil, requestContextError(err)
+		}
+		item, err := listItem(ctx, authResults, &res, mg, actions, req, filter, listOpts.roleCounts, listOpts.tags)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			continue
+		}
+		if full {
+			truncated = true
+			continue
+		}
+		finalItems = append(finalItems, item)
+		last = mg
+	}
+	var nextPageToken string
+	if truncated {
+		nextPageToken = position(last).encode()
+	}
+	var total int
+	if req.GetIncludeEstimatedTotal() {
+		if total, err = s.countFromRepo(ctx, req.GetAuthMethodId(), listOpts); err != nil {
+			return nil, err
+		}
+	}
+	compressLargeList(ctx, op, s.compressListOver, len(finalItems))
+	return &pbs.ListManagedGroupsResponse{Items: finalItems, EstimatedTotalItems: uint32(total), Truncated: truncated, ItemCount: uint32(len(finalItems)), NextPageToken: nextPageToken}, nil
+}
+
+// StreamManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) StreamManagedGroups(req *pbs.StreamManagedGroupsRequest, stream pbs.ManagedGroupService_StreamManagedGroupsServer) (retErr error) {
+	const op = "managed_groups.(Service).StreamManagedGroups"
+	ctx := stream.Context()
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateStreamRequest(ctx, req); err != nil {
+		return err
+	}
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	if authResults.Error != nil {
+		return authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return err
+	}
+	listOpts, err := s.listOptionsFromRepo(ctx, authResults, req)
+	if err != nil {
+		return err
+	}
+	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), listOpts)
+	if err != nil {
+		return err
+	}
+	filter, err := handlers.NewFilter(ctx, req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	res := perms.Resource{
+		ScopeId: authResults.Scope.Id,
+		Type:    resource.ManagedGroup,
+		Pin:     req.GetAuthMethodId(),
+	}
+	actions := listActions(ctx, authResults, res, ul)
+	for _, mg := range ul {
+		if err := ctx.Err(); err != nil {
+			return requestContextError(err)
+		}
+		item, err := listItem(ctx, authResults, &res, mg, actions, req, filter, listOpts.roleCounts, listOpts.tags)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+		// Send blocks while the stream's flow control window is full, so a
+		// slow client holds at most a window's worth of items in flight.
+		if err := stream.Send(&pbs.StreamManagedGroupsResponse{Item: item}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listItemRequest holds what ListManagedGroups and StreamManagedGroups
+// requests share about how each managed group is listed.
+type listItemRequest interface {
+	GetFilter() string
+	GetIdOnly() bool
+	GetFields() []string
+	GetIncludePopulatedFields() bool
+	GetAttributesAsJson() bool
+	GetIncludeRoleCount() bool
+}
+
+// listActions returns the actions the caller is authorized to take on each
+// of mgs, evaluated for all of them at once. res is the listed auth method's
+// resource.
+func listActions(ctx context.Context, authResults requestauth.VerifyResults, res perms.Resource, mgs []auth.ManagedGroup) map[string]action.ActionSet {
+	idsBySubtype := make(map[subtypes.Subtype][]string)
+	for _, mg := range mgs {
+		subtype := subtypes.SubtypeFromId(domain, mg.GetPublicId())
+		idsBySubtype[subtype] = append(idsBySubtype[subtype], mg.GetPublicId())
+	}
+	ret := make(map[string]action.ActionSet, len(mgs))
+	for subtype, ids := range idsBySubtype {
+		for id, actions := range authResults.FetchActionSetsForIds(ctx, ids, IdActions[subtype], requestauth.WithResource(&res)) {
+			ret[id] = actions
+		}
+	}
+	return ret
+}
+
+// listItem returns mg as it's listed for req, or nil when the caller isn't
+// authorized to see it or it doesn't match filter. res is the listed auth
+// method's resource; its Id is set to mg's. The authorized actions are
+// taken from actions, as built by listActions, and fetched for mg alone
+// when it has no entry there. tags holds the tags of the listed managed
+// groups.
+func listItem(ctx context.Context, authResults requestauth.VerifyResults, res *perms.Resource, mg auth.ManagedGroup, actions map[string]action.ActionSet, req listItemRequest, filter *handlers.Filter, roleCounts map[string]int, tags map[string]map[string]string) (*pb.ManagedGroup, error) {
+	res.Id = mg.GetPublicId()
+	mgActions, ok := actions[mg.GetPublicId()]
+	if !ok {
+		mgActions = authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())], requestauth.WithResource(res))
+	}
+	authorizedActions := mgActions.Strings()
+	if len(authorizedActions) == 0 {
+		return nil, nil
+	}
+
+	outputFields := authResults.FetchOutputFields(*res, action.List).SelfOrDefaults(authResults.UserId)
+	requestedFields := restrictOutputFields(outputFields, req.GetFields())
+	if req.GetIdOnly() && req.GetFilter() == "" {
+		// Without a filter to evaluate there is no need to build the
+		// full item.
+		return idOnlyProto(mg, requestedFields), nil
+	}
+	build := func(outputFields *perms.OutputFields, attributesAsJson bool) (*pb.ManagedGroup, error) {
+		outputOpts := make([]handlers.Option, 0, 7)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields), handlers.WithPopulatedFields(req.GetIncludePopulatedFields()), handlers.WithAttributesJson(attributesAsJson), handlers.WithTags(tags[mg.GetPublicId()]))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+		if req.GetIncludeRoleCount() {
+			outputOpts = append(outputOpts, handlers.WithRoleCount(uint32(roleCounts[mg.GetPublicId()])))
+		}
+		return toProto(ctx, mg, outputOpts...)
+	}
+	if req.GetFilter() == "" {
+		return build(requestedFields, req.GetAttributesAsJson())
+	}
+
+	// The filter is evaluated against every field the caller is
+	// authorized to see, not only the requested ones, and against the
+	// attributes object even when they are returned as JSON.
+	item, err := build(outputFields, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// This comes last so that we can use item fields in the filter after
+	// the allowed fields are populated above
+	filterable, err := subtypes.Filterable(item)
+	if err != nil {
+		return nil, err
+	}
+	if !filter.Match(filterable) {
+		return nil, nil
+	}
+	switch {
+	case req.GetIdOnly():
+		item = idOnlyProto(mg, requestedFields)
+	case len(req.GetFields()) > 0 || req.GetAttributesAsJson():
+		if item, err = build(requestedFields, req.GetAttributesAsJson()); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
+}
+
+// ListManagedGroupsByMember implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ListManagedGroupsByMember(ctx context.Context, req *pbs.ListManagedGroupsByMemberRequest) (_ *pbs.ListManagedGroupsByMemberResponse, retErr error) {
+	const op = "managed_groups.(Service).ListManagedGroupsByMember"
+	rpc := s.startRpcEvents(ctx, op, req.GetAccountId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateListByMemberRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authMethodId, err := s.memberAuthMethodIdFromRepo(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, unauthenticatedError(ctx, err)
+	}
+	_, _, authResults := s.authResult(ctx, authMethodId, nil, action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	ul, err := s.listByMemberFromRepo(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	var after string
+	if req.GetPageToken() != "" {
+		// validateListByMemberRequest already decoded the token.
+		t, _ := decodeMemberOfPageToken(req.GetAccountId(), req.GetPageToken())
+		after = t.ManagedGroupId
+	}
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = s.defaultListLimit
+	}
+
+	resp := &pbs.ListManagedGroupsByMemberResponse{}
+	res := perms.Resource{
+		ScopeId: authResults.Scope.Id,
+		Type:    resource.ManagedGroup,
+		Pin:     authMethodId,
+	}
 	for _, mg := range ul {
+		// listByMemberFromRepo orders by id.
+		if mg.GetPublicId() <= after {
+			continue
+		}
 		res.Id = mg.GetPublicId()
 		authorizedActions := authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())], requestauth.WithResource(&res)).Strings()
 		if len(authorizedActions) == 0 {
 			continue
 		}
+		if limit > 0 && len(resp.Items) == limit {
+			// Another authorized managed group remains for the next page.
+			resp.NextPageToken = memberOfPageToken{AccountId: req.GetAccountId(), ManagedGroupId: resp.Items[limit-1].GetId()}.encode()
+			break
+		}
 
 		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
 		outputOpts := make([]handlers.Option, 0, 3)
@@ -151,38 +614,149 @@ func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGrou
 		if outputFields.Has(globals.AuthorizedActionsField) {
 			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
 		}
-
 		item, err := toProto(ctx, mg, outputOpts...)
 		if err != nil {
 			return nil, err
 		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
 
-		// This comes last so that we can use item fields in the filter after
-		// the allowed fields are populated above
-		filterable, err := subtypes.Filterable(item)
-		if err != nil {
-			return nil, err
-		}
-		if filter.Match(filterable) {
-			finalItems = append(finalItems, item)
-		}
+// GenerateManagedGroupMembershipReport implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) GenerateManagedGroupMembershipReport(req *pbs.GenerateManagedGroupMembershipReportRequest, stream pbs.ManagedGroupService_GenerateManagedGroupMembershipReportServer) (retErr error) {
+	const op = "managed_groups.(Service).GenerateManagedGroupMembershipReport"
+	ctx := stream.Context()
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateMembershipReportRequest(ctx, req); err != nil {
+		return err
+	}
+	// Without an id this requires read on every managed group of the auth
+	// method, e.g. id=*;type=managed-group;actions=read.
+	_, _, authResults := s.authResult(ctx, req.GetAuthMethodId(), nil, action.Read)
+	if authResults.Error != nil {
+		return authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	// The report is for scope admins, so update on the auth method itself is
+	// required as well, e.g. id=*;type=auth-method;actions=update.
+	amRes := &perms.Resource{
+		ScopeId: authResults.Scope.GetId(),
+		Type:    resource.AuthMethod,
+		Id:      req.GetAuthMethodId(),
+	}
+	if !authResults.FetchActionSetForId(ctx, req.GetAuthMethodId(), action.ActionSet{action.Update}, requestauth.WithResource(amRes)).HasAction(action.Update) {
+		return handlers.ForbiddenError()
+	}
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return err
+	}
+	return s.sendMembershipsByAuthMethod(ctx, req.GetAuthMethodId(), stream.Send)
+}
+
+// ListManagedGroupMembers implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ListManagedGroupMembers(ctx context.Context, req *pbs.ListManagedGroupMembersRequest) (_ *pbs.ListManagedGroupMembersResponse, retErr error) {
+	const op = "managed_groups.(Service).ListManagedGroupMembers"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateListMembersRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, mg, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = s.defaultListLimit
+	}
+	if limit <= 0 || limit > maxListMembersPageSize {
+		limit = maxListMembersPageSize
+	}
+	var after *memberPageToken
+	if req.GetPageToken() != "" {
+		// The request has been validated, so the token decodes.
+		t, _ := decodeMemberPageToken(req.GetId(), req.GetPageToken())
+		after = &t
 	}
-	return &pbs.ListManagedGroupsResponse{Items: finalItems}, nil
+	// One more member than the page holds tells whether another page remains.
+	members, err := s.memberPageFromRepo(ctx, mg, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbs.ListManagedGroupMembersResponse{Items: members}
+	if len(members) > limit {
+		resp.Items = members[:limit]
+		resp.NextPageToken = memberPageTokenOf(req.GetId(), resp.Items[limit-1]).encode()
+	}
+	return resp, nil
 }
 
 // GetManagedGroup implements the interface pbs.ManagedGroupServiceServer.
-func (s Service) GetManagedGroup(ctx context.Context, req *pbs.GetManagedGroupRequest) (*pbs.GetManagedGroupResponse, error) {
+func (s Service) GetManagedGroup(ctx context.Context, req *pbs.GetManagedGroupRequest) (_ *pbs.GetManagedGroupResponse, retErr error) {
 	const op = "managed_groups.(Service).GetManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
 
 	if err := validateGetRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
-	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	var am auth.AuthMethod
+	var mg auth.ManagedGroup
+	var authResults requestauth.VerifyResults
+	if req.GetAuthMethodId() == "" {
+		am, mg, authResults = s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+		if authResults.Error != nil {
+			return nil, s.resourceAuthError(authResults.Error)
+		}
+		if err := s.readLimiter.allow(authResults.UserId); err != nil {
+			return nil, err
+		}
+	} else {
+		// Resolving an id prefix or a name reveals which managed groups the
+		// auth method has, so it requires listing them and is limited before
+		// anything is looked up.
+		_, _, listResults := s.authResult(ctx, req.GetAuthMethodId(), nil, action.List)
+		if listResults.Error != nil {
+			return nil, s.resourceAuthError(listResults.Error)
+		}
+		if err := s.readLimiter.allow(listResults.UserId); err != nil {
+			return nil, err
+		}
+		var found auth.ManagedGroup
+		var err error
+		if req.GetId() != "" {
+			// The id may be a unique prefix of the id of a managed group in
+			// the auth method.
+			if found, err = s.lookupByIdPrefixFromRepo(ctx, req.GetAuthMethodId(), req.GetId()); err != nil {
+				return nil, err
+			}
+			if found == nil {
+				return nil, s.lookupNotFoundError(ctx, "No ManagedGroup in auth method %q has an id starting with %q.", req.GetAuthMethodId(), req.GetId())
+			}
+		} else {
+			if found, err = s.lookupByNameFromRepo(ctx, req.GetAuthMethodId(), req.GetName(), true); err != nil {
+				return nil, err
+			}
+			if found == nil {
+				return nil, s.lookupNotFoundError(ctx, "ManagedGroup %q doesn't exist in auth method %q.", req.GetName(), req.GetAuthMethodId())
+			}
+		}
+		am, mg, authResults = s.authResult(ctx, found.GetAuthMethodId(), found, action.Read)
+		if authResults.Error != nil {
+			return nil, s.resourceAuthError(authResults.Error)
+		}
 	}
-	mg, memberIds, err := s.getFromRepo(ctx, req.GetId())
+	rpc.scopeId = authResults.Scope.GetId()
+	rpc.id = mg.GetPublicId()
+	memberIds, err := s.memberIdsFromRepo(ctx, mg)
 	if err != nil {
 		return nil, err
 	}
@@ -191,9 +765,10 @@ func (s Service) GetManagedGroup(ctx context.Context, req *pbs.GetManagedGroupRe
 	if !ok {
 		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
 	}
+	outputFields = restrictOutputFields(outputFields, req.GetFields())
 
-	outputOpts := make([]handlers.Option, 0, 3)
-	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	outputOpts := make([]handlers.Option, 0, 6)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields), handlers.WithPopulatedFields(req.GetIncludePopulatedFields()), handlers.WithAttributesJson(req.GetAttributesAsJson()), handlers.WithUri(fmt.Sprintf("managed-groups/%s", mg.GetPublicId())))
 	if outputFields.Has(globals.ScopeField) {
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
@@ -203,6 +778,16 @@ func (s Service) GetManagedGroup(ctx context.Context, req *pbs.GetManagedGroupRe
 	if outputFields.Has(globals.MemberIdsField) {
 		outputOpts = append(outputOpts, handlers.WithMemberIds(memberIds))
 	}
+	if outputFields.Has(globals.TagsField) {
+		tags, err := s.tagsFromRepo(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithTags(tags))
+	}
+	if req.GetWithAuthMethod() && outputFields.Has(globals.AuthMethodField) {
+		outputOpts = append(outputOpts, handlers.WithAuthMethod(toAuthMethodInfo(am)))
+	}
 
 	item, err := toProto(ctx, mg, outputOpts...)
 	if err != nil {
@@ -212,30 +797,153 @@ func (s Service) GetManagedGroup(ctx context.Context, req *pbs.GetManagedGroupRe
 	return &pbs.GetManagedGroupResponse{Item: item}, nil
 }
 
+// BatchGetManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) BatchGetManagedGroups(ctx context.Context, req *pbs.BatchGetManagedGroupsRequest) (_ *pbs.BatchGetManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).BatchGetManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, "")
+	defer func() { rpc.end(ctx, retErr) }()
+
+	if err := validateBatchGetRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	mgs, err := s.lookupManyFromRepo(ctx, req.GetIds())
+	if err != nil {
+		return nil, unauthenticatedError(ctx, err)
+	}
+
+	// The managed groups may be in several auth methods, so the caller is
+	// authorized once per auth method and then for each managed group.
+	found := make(map[string]*pb.ManagedGroup, len(mgs))
+	byAuthMethod := make(map[string][]auth.ManagedGroup)
+	var amIds []string
+	for _, mg := range mgs {
+		amId := mg.GetAuthMethodId()
+		if _, ok := byAuthMethod[amId]; !ok {
+			amIds = append(amIds, amId)
+		}
+		byAuthMethod[amId] = append(byAuthMethod[amId], mg)
+	}
+	allowed := false
+	for _, amId := range amIds {
+		_, _, authResults := s.authResult(ctx, amId, nil, action.Read)
+		if authResults.Error != nil {
+			switch {
+			case authResults.Error == handlers.ForbiddenError() && authResults.AuthenticationFinished:
+				// Grants on the ids of the managed groups are checked below,
+				// and allow reading them without allowing it in the whole
+				// auth method.
+			case errors.Is(authResults.Error, handlers.NotFoundError()):
+				// The auth method was deleted along with its managed groups
+				// after they were looked up.
+				continue
+			default:
+				return nil, authResults.Error
+			}
+		}
+		if !allowed {
+			if err := s.readLimiter.allow(authResults.UserId); err != nil {
+				return nil, err
+			}
+			allowed = true
+		}
+		for _, mg := range byAuthMethod[amId] {
+			res := &perms.Resource{
+				ScopeId: authResults.Scope.GetId(),
+				Type:    resource.ManagedGroup,
+				Pin:     amId,
+				Id:      mg.GetPublicId(),
+			}
+			actions := authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())], requestauth.WithResource(res))
+			if !actions.HasAction(action.Read) {
+				continue
+			}
+			outputFields := authResults.FetchOutputFields(*res, action.Read).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(actions.Strings()))
+			}
+			item, err := toProto(ctx, mg, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+			found[mg.GetPublicId()] = item
+		}
+	}
+	if !allowed && requestauth.Anonymous(ctx) {
+		return nil, handlers.UnauthenticatedError()
+	}
+
+	resp := &pbs.BatchGetManagedGroupsResponse{}
+	for _, id := range req.GetIds() {
+		if item, ok := found[id]; ok {
+			resp.Items = append(resp.Items, item)
+			continue
+		}
+		resp.MissingIds = append(resp.MissingIds, id)
+	}
+	return resp, nil
+}
+
 // CreateManagedGroup implements the interface pbs.ManagedGroupServiceServer.
-func (s Service) CreateManagedGroup(ctx context.Context, req *pbs.CreateManagedGroupRequest) (*pbs.CreateManagedGroupResponse, error) {
+func (s Service) CreateManagedGroup(ctx context.Context, req *pbs.CreateManagedGroupRequest) (_ *pbs.CreateManagedGroupResponse, retErr error) {
 	const op = "managed_groups.(Service).CreateManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetItem().GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
 
-	if err := validateCreateRequest(ctx, req); err != nil {
+	if err := validateCreateRequest(ctx, req, s.rejectMatchAll); err != nil {
 		return nil, err
 	}
+	if req.GetItem().GetOidcManagedGroupAttributes().GetDisabled() && !s.allowCreateDisabled {
+		return nil, invalidFieldError(attrDisabledField, FieldErrorReadOnly, "Cannot specify this field in a create request.")
+	}
+	warnings := filterWarnings(ctx, req.GetItem())
 
-	authMeth, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetAuthMethodId(), action.Create)
+	authMeth, _, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetAuthMethodId(), action.Create)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	mg, err := s.createInRepo(ctx, authMeth, req.GetItem())
+	rpc.scopeId = authResults.Scope.GetId()
+	if authMeth == nil {
+		// The parent is resolved while authorizing, so this shouldn't happen,
+		// but nothing must be written for an auth method which wasn't found.
+		return nil, handlers.NotFoundErrorf("Auth method %q not found.", req.GetItem().GetAuthMethodId())
+	}
+	// The scope the caller expects is only checked once the caller has been
+	// authorized in the auth method's actual scope, so a mismatch can't be used
+	// to learn the scopes of auth methods the caller can't create in.
+	if id := req.GetScopeId(); id != "" && id != authMeth.GetScopeId() {
+		return nil, invalidFieldError(globals.ScopeIdField, FieldErrorInvalidValue, fmt.Sprintf("Auth method %q is not in scope %q.", authMeth.GetPublicId(), id))
+	}
+	if err := validateClaimAliasReferences(ctx, authMeth, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	if err := validateClaimTypes(ctx, authMeth, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	roles, err := s.rolesToAddTo(ctx, authResults, req.GetRoleIds())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	mg, err := s.createInRepo(ctx, authMeth, req.GetItem(), req.GetInitialMembers(), roles)
 	if err != nil {
 		return nil, err
 	}
+	rpc.changed(ctx, changeCreated, mg.GetPublicId(), authResults.UserId)
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
 		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
 	}
 
-	outputOpts := make([]handlers.Option, 0, 3)
-	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	outputOpts := make([]handlers.Option, 0, 5)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields), handlers.WithTags(req.GetItem().GetTags()), handlers.WithUri(fmt.Sprintf("managed-groups/%s", mg.GetPublicId())))
 	if outputFields.Has(globals.ScopeField) {
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
@@ -248,591 +956,4418 @@ func (s Service) CreateManagedGroup(ctx context.Context, req *pbs.CreateManagedG
 		return nil, err
 	}
 
-	return &pbs.CreateManagedGroupResponse{Item: item, Uri: fmt.Sprintf("managed-groups/%s", item.GetId())}, nil
+	return &pbs.CreateManagedGroupResponse{Item: item, Uri: fmt.Sprintf("managed-groups/%s", item.GetId()), Warnings: warnings}, nil
 }
 
 // UpdateManagedGroup implements the interface pbs.ManagedGroupServiceServer.
-func (s Service) UpdateManagedGroup(ctx context.Context, req *pbs.UpdateManagedGroupRequest) (*pbs.UpdateManagedGroupResponse, error) {
+func (s Service) UpdateManagedGroup(ctx context.Context, req *pbs.UpdateManagedGroupRequest) (_ *pbs.UpdateManagedGroupResponse, retErr error) {
 	const op = "managed_groups.(Service).UpdateManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
 
 	if err := validateUpdateRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
-	authMeth, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	authMeth, grp, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
-		return nil, authResults.Error
+		return nil, s.resourceAuthError(authResults.Error)
 	}
-	mg, err := s.updateInRepo(ctx, authResults.Scope.GetId(), authMeth.GetPublicId(), req)
-	if err != nil {
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := validateFilterUpdate(ctx, authMeth, grp, req, s.rejectMatchAll); err != nil {
 		return nil, err
 	}
-
-	outputFields, ok := requests.OutputFields(ctx)
-	if !ok {
-		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
-	}
-
-	outputOpts := make([]handlers.Option, 0, 3)
-	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
-	if outputFields.Has(globals.ScopeField) {
-		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	if err := validateFrozenFilterUpdate(grp, req); err != nil {
+		return nil, err
 	}
-	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())]).Strings()))
+	// Tags are kept apart from the managed group's own fields, which are
+	// updated with the rest of the mask.
+	setTags := handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.TagsField)
+	fieldsReq := req
+	if setTags {
+		fieldsReq = proto.Clone(req).(*pbs.UpdateManagedGroupRequest)
+		fieldsReq.UpdateMask.Paths = withoutPath(req.GetUpdateMask().GetPaths(), globals.TagsField)
 	}
-
-	item, err := toProto(ctx, mg, outputOpts...)
+	tagsOnly := setTags && len(fieldsReq.GetUpdateMask().GetPaths()) == 0
+	tags, err := s.tagsFromRepo(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
-
-	return &pbs.UpdateManagedGroupResponse{Item: item}, nil
-}
-
-// DeleteManagedGroup implements the interface pbs.ManagedGroupServiceServer.
-func (s Service) DeleteManagedGroup(ctx context.Context, req *pbs.DeleteManagedGroupRequest) (*pbs.DeleteManagedGroupResponse, error) {
+	newTags := tags
+	if setTags {
+		newTags = req.GetItem().GetTags()
+	}
+	var mg auth.ManagedGroup
+	if req.GetPreview() {
+		// Nothing is written, so a preview is limited like a read.
+		if err := s.readLimiter.allow(authResults.UserId); err != nil {
+			return nil, err
+		}
+		if mg, err = previewUpdate(ctx, grp, req); err != nil {
+			return nil, err
+		}
+		if err := s.verifyPreviewedName(ctx, grp, mg); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+			return nil, err
+		}
+		if tagsOnly {
+			mg, err = s.updateTagsOnlyInRepo(ctx, authResults.Scope.GetId(), req.GetId(), authResults.UserId, req.GetItem().GetVersion(), newTags)
+		} else {
+			mg, err = s.updateInRepo(ctx, authResults.Scope.GetId(), authMeth, grp, authResults.UserId, fieldsReq, setTags)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rpc.changed(ctx, changeUpdated, mg.GetPublicId(), authResults.UserId)
+		if req.GetRefresh() && isFrozen(grp) && !isFrozen(mg) {
+			if mg, err = s.refreshUnfrozen(ctx, authMeth, mg.GetPublicId()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 5)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields), handlers.WithTags(newTags), handlers.WithUri(fmt.Sprintf("managed-groups/%s", mg.GetPublicId())))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, mg, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if !req.GetPreview() && !req.GetChangedFieldsOnly() {
+		return &pbs.UpdateManagedGroupResponse{Item: item}, nil
+	}
+
+	// The later option replaces the new tags with the current ones.
+	before, err := toProto(ctx, grp, append(outputOpts, handlers.WithTags(tags))...)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := fieldChanges(before, item, outputFields, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if req.GetChangedFieldsOnly() {
+		if item, err = changedFieldsProto(ctx, mg, changes, outputFields, outputOpts); err != nil {
+			return nil, err
+		}
+	}
+	resp := &pbs.UpdateManagedGroupResponse{Item: item}
+	if req.GetPreview() {
+		resp.Changes = changes
+	}
+	return resp, nil
+}
+
+// PreviewUpdateManagedGroup implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) PreviewUpdateManagedGroup(ctx context.Context, req *pbs.PreviewUpdateManagedGroupRequest) (*pbs.PreviewUpdateManagedGroupResponse, error) {
+	// This is an update which is always previewed, so it never writes.
+	resp, err := s.UpdateManagedGroup(ctx, &pbs.UpdateManagedGroupRequest{
+		Id:                      req.GetId(),
+		Item:                    req.GetItem(),
+		UpdateMask:              req.GetUpdateMask(),
+		Preview:                 true,
+		IgnoreUnknownAttributes: req.GetIgnoreUnknownAttributes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.PreviewUpdateManagedGroupResponse{Item: resp.GetItem(), Changes: resp.GetChanges()}, nil
+}
+
+// changedFieldsProto returns the managed group as it is after an update with
+// only its id, its version and the fields the update changed set. opts are the
+// options the whole managed group is returned with.
+func changedFieldsProto(ctx context.Context, mg auth.ManagedGroup, changes []*pbs.ManagedGroupFieldChange, outputFields *perms.OutputFields, opts []handlers.Option) (*pb.ManagedGroup, error) {
+	fields := []string{globals.IdField, globals.VersionField}
+	var attrPaths []string
+	for _, c := range changes {
+		if proto.Equal(c.GetBefore(), c.GetAfter()) {
+			continue
+		}
+		fields = append(fields, c.GetField())
+		if strings.HasPrefix(c.GetField(), globals.AttributesField+".") {
+			attrPaths = append(attrPaths, c.GetField())
+		}
+	}
+	// The changed fields are named by their mask paths, which for attributes
+	// name a single attribute.
+	restricted := restrictOutputFields(outputFields, fields)
+	if len(attrPaths) > 0 {
+		restricted = restrictOutputFields(outputFields, append(fields, globals.AttributesField))
+	}
+	// Without the type the attributes are returned as a struct, which is
+	// pruned down to the changed attributes.
+	item, err := toProto(ctx, mg, append(opts, handlers.WithOutputFields(restricted))...)
+	if err != nil {
+		return nil, err
+	}
+	if item.GetAttributes() == nil {
+		return item, nil
+	}
+	full := &structpb.Struct{Fields: map[string]*structpb.Value{
+		globals.AttributesField: structpb.NewStructValue(item.GetAttributes()),
+	}}
+	pruned := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	for _, p := range attrPaths {
+		v := fieldValue(full, p)
+		setFieldValue(pruned, strings.TrimPrefix(p, globals.AttributesField+"."), v)
+	}
+	item.Attrs = &pb.ManagedGroup_Attributes{Attributes: pruned}
+	return item, nil
+}
+
+// setFieldValue sets the value at the dotted path in st, adding the structs
+// on the way to it.
+func setFieldValue(st *structpb.Struct, path string, v *structpb.Value) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		next := st.GetFields()[name].GetStructValue()
+		if next == nil {
+			next = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+			st.Fields[name] = structpb.NewStructValue(next)
+		}
+		st = next
+	}
+	st.Fields[names[len(names)-1]] = v
+}
+
+// TouchManagedGroup implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) TouchManagedGroup(ctx context.Context, req *pbs.TouchManagedGroupRequest) (_ *pbs.TouchManagedGroupResponse, retErr error) {
+	const op = "managed_groups.(Service).TouchManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateTouchRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	mg, err := s.touchInRepo(ctx, authResults.Scope.GetId(), req.GetId(), authResults.UserId, req.GetVersion(), false, nil)
+	if err != nil {
+		return nil, err
+	}
+	rpc.changed(ctx, changeUpdated, mg.GetPublicId(), authResults.UserId)
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())]).Strings()))
+	}
+	item, err := toProto(ctx, mg, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.TouchManagedGroupResponse{Item: item}, nil
+}
+
+// DeleteManagedGroup implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) DeleteManagedGroup(ctx context.Context, req *pbs.DeleteManagedGroupRequest) (_ *pbs.DeleteManagedGroupResponse, retErr error) {
+	const op = "managed_groups.(Service).DeleteManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
 	if err := validateDeleteRequest(ctx, req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Delete)
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		if req.GetReturnDeleted() && errors.Is(authResults.Error, handlers.NotFoundError()) {
+			// The managed group is already gone, which the caller asked to
+			// be told about rather than get an error for. A caller who
+			// isn't authorized still gets an error, and one without a token
+			// is unauthenticated rather than not found.
+			return &pbs.DeleteManagedGroupResponse{}, nil
+		}
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	deleted, err := s.deleteFromRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetForce(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		rpc.changed(ctx, changeDeleted, req.GetId(), authResults.UserId)
+	}
+	if req.GetReturnDeleted() {
+		return &pbs.DeleteManagedGroupResponse{Deleted: deleted}, nil
+	}
+	return nil, nil
+}
+
+// UpsertManagedGroup implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) UpsertManagedGroup(ctx context.Context, req *pbs.UpsertManagedGroupRequest) (_ *pbs.UpsertManagedGroupResponse, retErr error) {
+	const op = "managed_groups.(Service).UpsertManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetItem().GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+
+	if err := validateUpsertRequest(ctx, req, s.rejectMatchAll); err != nil {
+		return nil, err
+	}
+
+	// Authorize against the existing managed group when there is one so that
+	// callers who can only update it can still use upsert, otherwise
+	// authorize creation in the auth method.
+	existing, err := s.lookupByNameFromRepo(ctx, req.GetItem().GetAuthMethodId(), req.GetItem().GetName().GetValue(), false)
+	if err != nil {
+		return nil, unauthenticatedError(ctx, err)
+	}
+	var authMeth auth.AuthMethod
+	var authResults requestauth.VerifyResults
+	if existing != nil {
+		authMeth, _, authResults = s.authResult(ctx, existing.GetAuthMethodId(), existing, action.Update)
+	} else {
+		authMeth, _, authResults = s.authResult(ctx, req.GetItem().GetAuthMethodId(), nil, action.Create)
+	}
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := validateClaimAliasReferences(ctx, authMeth, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	if err := validateClaimTypes(ctx, authMeth, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	if mg, ok := existing.(*oidc.ManagedGroup); ok && mg.GetFrozen() && mg.GetFilter() != req.GetItem().GetOidcManagedGroupAttributes().GetFilter() {
+		// Upsert has no force, so the filter of a frozen managed group can
+		// only be changed by an update.
+		return nil, handlers.ConflictErrorf("The managed group is frozen, so its filter can't be changed. Unfreeze it first, or update it with force set.")
+	}
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	// The managed group which was authorized is the one written: it is
+	// created, within the quota, if none was found, otherwise it is updated at
+	// the version it was looked up at.
+	var mg auth.ManagedGroup
+	created := existing == nil
+	if created {
+		mg, err = s.createInRepo(ctx, authMeth, req.GetItem(), nil, nil)
+	} else {
+		mg, err = s.updateInRepo(ctx, authResults.Scope.GetId(), authMeth, existing, authResults.UserId, upsertUpdateRequest(existing, req.GetItem()), false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		rpc.changed(ctx, changeCreated, mg.GetPublicId(), authResults.UserId)
+	} else {
+		rpc.changed(ctx, changeUpdated, mg.GetPublicId(), authResults.UserId)
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, mg, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpsertManagedGroupResponse{Item: item, Created: created}, nil
+}
+
+// PreviewManagedGroupMatches implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) PreviewManagedGroupMatches(ctx context.Context, req *pbs.PreviewManagedGroupMatchesRequest) (_ *pbs.PreviewManagedGroupMatchesResponse, retErr error) {
+	const op = "managed_groups.(Service).PreviewManagedGroupMatches"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+
+	if err := validatePreviewMatchesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authMeth, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	resolver, ok := auth.MembershipResolverFor(subtypes.SubtypeFromId(domain, req.GetAuthMethodId()))
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no membership resolver for the auth method's subtype")
+	}
+
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	mgs, err := repo.ListManagedGroups(ctx, req.GetAuthMethodId(), oidc.WithLimit(maxPreviewManagedGroups+1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	resp := &pbs.PreviewManagedGroupMatchesResponse{}
+	if len(mgs) > maxPreviewManagedGroups {
+		mgs = mgs[:maxPreviewManagedGroups]
+		resp.Truncated = true
+	}
+
+	candidates := make([]auth.ManagedGroup, 0, len(mgs))
+	for _, mg := range mgs {
+		candidates = append(candidates, mg)
+	}
+	matched, err := resolver.ResolveMemberships(ctx, authMeth, candidates, req.GetClaims().AsMap())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	res := perms.Resource{
+		ScopeId: authResults.Scope.Id,
+		Type:    resource.ManagedGroup,
+		Pin:     req.GetAuthMethodId(),
+	}
+	for _, mg := range matched {
+		res.Id = mg.GetPublicId()
+		if len(authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[oidc.Subtype], requestauth.WithResource(&res))) == 0 {
+			continue
+		}
+		resp.ManagedGroupIds = append(resp.ManagedGroupIds, mg.GetPublicId())
+	}
+	return resp, nil
+}
+
+// GetManagedGroupFilterCapabilities implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) GetManagedGroupFilterCapabilities(ctx context.Context, req *pbs.GetManagedGroupFilterCapabilitiesRequest) (_ *pbs.GetManagedGroupFilterCapabilitiesResponse, retErr error) {
+	const op = "managed_groups.(Service).GetManagedGroupFilterCapabilities"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+
+	if err := validateFilterCapabilitiesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authMeth, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	_, err := s.deleteFromRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	resp := &pbs.GetManagedGroupFilterCapabilitiesResponse{
+		AuthMethodType: subtypes.SubtypeFromId(domain, req.GetAuthMethodId()).String(),
+	}
+	am, ok := authMeth.(*oidc.AuthMethod)
+	if !ok {
+		// LDAP managed groups are matched by group names, not a filter.
+		return resp, nil
+	}
+	aliases, err := oidc.ParseClaimAliases(ctx, am.GetClaimAliases()...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	resp.FiltersSupported = true
+	resp.Operators = oidc.ManagedGroupFilterOperators()
+	resp.SelectorPrefixes = oidc.ManagedGroupFilterSelectorPrefixes()
+	for alias, selector := range aliases {
+		resp.ClaimAliases = append(resp.ClaimAliases, &pbs.ManagedGroupFilterClaimAlias{Alias: alias, Selector: selector})
+	}
+	sort.Slice(resp.ClaimAliases, func(i, j int) bool {
+		return resp.ClaimAliases[i].GetAlias() < resp.ClaimAliases[j].GetAlias()
+	})
+	return resp, nil
+}
+
+// ExportManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ExportManagedGroups(ctx context.Context, req *pbs.ExportManagedGroupsRequest) (_ *pbs.ExportManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).ExportManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateExportRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	// listFromRepo doesn't limit the number of results, so the document holds
+	// every managed group in the auth method.
+	mgs, err := s.listFromRepo(ctx, req.GetAuthMethodId(), listOptions{})
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+
+	subtype := subtypes.SubtypeFromId(domain, req.GetAuthMethodId())
+	doc := &pbs.ManagedGroupsDocument{
+		Version: exportDocumentVersion,
+		Type:    subtype.String(),
+	}
+	res := perms.Resource{
+		ScopeId: authResults.Scope.Id,
+		Type:    resource.ManagedGroup,
+		Pin:     req.GetAuthMethodId(),
+	}
+	for _, mg := range mgs {
+		res.Id = mg.GetPublicId()
+		if !authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtype], requestauth.WithResource(&res)).HasAction(action.Read) {
+			continue
+		}
+		def, err := toDefinition(ctx, mg, authResults.FetchOutputFields(res, action.Read).SelfOrDefaults(authResults.UserId))
+		if err != nil {
+			return nil, err
+		}
+		doc.ManagedGroups = append(doc.ManagedGroups, def)
+	}
+	sort.SliceStable(doc.ManagedGroups, func(i, j int) bool {
+		return doc.ManagedGroups[i].GetName() < doc.ManagedGroups[j].GetName()
+	})
+	return &pbs.ExportManagedGroupsResponse{Document: doc}, nil
+}
+
+// ImportManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ImportManagedGroups(ctx context.Context, req *pbs.ImportManagedGroupsRequest) (_ *pbs.ImportManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).ImportManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateImportRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authMeth, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+
+	resp := &pbs.ImportManagedGroupsResponse{}
+	seen := make(map[string]bool, len(req.GetDocument().GetManagedGroups()))
+	for _, def := range req.GetDocument().GetManagedGroups() {
+		result := &pbs.ManagedGroupImportResult{Name: def.GetName()}
+		resp.Results = append(resp.Results, result)
+
+		item := fromDefinition(authMeth.GetPublicId(), def)
+		// Each managed group is validated as if it were created on its own.
+		if err := validateCreateRequest(ctx, &pbs.CreateManagedGroupRequest{Item: item, AllowMatchAll: req.GetAllowMatchAll()}, s.rejectMatchAll); err != nil {
+			result.Status, result.Error = importStatusFailed, fieldErrorsMessage(err)
+			continue
+		}
+		if err := validateClaimAliasReferences(ctx, authMeth, def.GetFilter()); err != nil {
+			result.Status, result.Error = importStatusFailed, fieldErrorsMessage(err)
+			continue
+		}
+		if err := validateClaimTypes(ctx, authMeth, def.GetFilter()); err != nil {
+			result.Status, result.Error = importStatusFailed, fieldErrorsMessage(err)
+			continue
+		}
+		if def.GetName() != "" {
+			if seen[def.GetName()] {
+				result.Status, result.Error = importStatusFailed, "The name is used by an earlier managed group in the document."
+				continue
+			}
+			seen[def.GetName()] = true
+
+			existing, err := s.lookupByNameFromRepo(ctx, authMeth.GetPublicId(), def.GetName(), false)
+			if err != nil {
+				result.Status, result.Error = importStatusFailed, handlers.ToApiError(err).GetMessage()
+				continue
+			}
+			if existing != nil {
+				result.Id = existing.GetPublicId()
+				if req.GetSkipExisting() {
+					result.Status = importStatusSkipped
+				} else {
+					result.Status, result.Error = importStatusFailed, "The name is already in use in the auth method."
+				}
+				continue
+			}
+		}
+		if req.GetDryRun() {
+			result.Status = importStatusWouldCreate
+			continue
+		}
+		mg, err := s.createInRepo(ctx, authMeth, item, nil, nil)
+		if err != nil {
+			rpc.itemFailed(ctx, err, "unable to import managed group", "name", def.GetName())
+			result.Status, result.Error = importStatusFailed, handlers.ToApiError(err).GetMessage()
+			continue
+		}
+		rpc.changed(ctx, changeCreated, mg.GetPublicId(), authResults.UserId)
+		result.Status, result.Id = importStatusCreated, mg.GetPublicId()
+	}
+	return resp, nil
+}
+
+// RefreshAuthMethodManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) RefreshAuthMethodManagedGroups(ctx context.Context, req *pbs.RefreshAuthMethodManagedGroupsRequest) (_ *pbs.RefreshAuthMethodManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).RefreshAuthMethodManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateRefreshRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	// Listing finds the managed groups, each of which is refreshed only if
+	// the caller can update it.
+	authMeth, _, authResults := s.authResult(ctx, req.GetAuthMethodId(), nil, action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	am, ok := authMeth.(*oidc.AuthMethod)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "auth method is not an oidc auth method")
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	mgs, err := repo.ListManagedGroups(ctx, am.GetPublicId(), oidc.WithLimit(-1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	candidates := make([]auth.ManagedGroup, 0, len(mgs))
+	for _, mg := range mgs {
+		candidates = append(candidates, mg)
+	}
+	res := perms.Resource{
+		ScopeId: authResults.Scope.GetId(),
+		Type:    resource.ManagedGroup,
+		Pin:     am.GetPublicId(),
+	}
+	actions := listActions(ctx, authResults, res, candidates)
+	resp := &pbs.RefreshAuthMethodManagedGroupsResponse{}
+	ids := make([]string, 0, len(mgs))
+	for _, mg := range mgs {
+		if !actions[mg.GetPublicId()].HasAction(action.Update) {
+			resp.UnauthorizedCount++
+			continue
+		}
+		ids = append(ids, mg.GetPublicId())
+	}
+	if len(ids) == 0 && resp.UnauthorizedCount > 0 {
+		return nil, handlers.ForbiddenError()
+	}
+
+	refreshed, err := repo.RefreshManagedGroupMemberships(ctx, am, oidc.WithManagedGroupIds(ids))
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to refresh managed group memberships"))
+	}
+	for _, r := range refreshed {
+		rpc.changed(ctx, changeUpdated, r.ManagedGroupId, authResults.UserId)
+		resp.Added += uint32(r.Added)
+		resp.Removed += uint32(r.Removed)
+		resp.Refreshed = append(resp.Refreshed, &pbs.ManagedGroupRefresh{
+			Id:      r.ManagedGroupId,
+			Added:   uint32(r.Added),
+			Removed: uint32(r.Removed),
+		})
+	}
+	return resp, nil
+}
+
+// refreshUnfrozen recomputes the memberships of the OIDC managed group with
+// the id after it was unfrozen, which the caller was authorized to do by
+// updating it. It returns the managed group as it is after the refresh, which
+// increments its version if its memberships changed.
+func (s Service) refreshUnfrozen(ctx context.Context, authMeth auth.AuthMethod, id string) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).refreshUnfrozen"
+	am, ok := authMeth.(*oidc.AuthMethod)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "auth method is not an oidc auth method")
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	if _, err := repo.RefreshManagedGroupMemberships(ctx, am, oidc.WithManagedGroupIds([]string{id})); err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to refresh managed group memberships"))
+	}
+	mg, err := repo.LookupManagedGroup(ctx, id)
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	if mg == nil {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist.", id)
+	}
+	return mg, nil
+}
+
+// GetManagedGroupGrants implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) GetManagedGroupGrants(ctx context.Context, req *pbs.GetManagedGroupGrantsRequest) (_ *pbs.GetManagedGroupGrantsResponse, retErr error) {
+	const op = "managed_groups.(Service).GetManagedGroupGrants"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateGetGrantsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, mg, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	grants, err := repo.GrantsForManagedGroup(ctx, mg.GetPublicId())
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to look up managed group grants"))
+	}
+	resp := &pbs.GetManagedGroupGrantsResponse{}
+	for _, g := range grants {
+		resp.Grants = append(resp.Grants, &pbs.ManagedGroupGrant{
+			Grant:        g.Grant,
+			RoleId:       g.RoleId,
+			GrantScopeId: g.ScopeId,
+		})
+	}
+	return resp, nil
+}
+
+// GetManagedGroupHistory implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) GetManagedGroupHistory(ctx context.Context, req *pbs.GetManagedGroupHistoryRequest) (_ *pbs.GetManagedGroupHistoryResponse, retErr error) {
+	const op = "managed_groups.(Service).GetManagedGroupHistory"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateGetHistoryRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, mg, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	revs, err := repo.ListManagedGroupRevisions(ctx, mg.GetPublicId(), oidc.WithLimit(-1))
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to list managed group revisions"))
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	resp := &pbs.GetManagedGroupHistoryResponse{}
+	for _, rev := range revs {
+		item, err := toProto(ctx, rev.ManagedGroup(mg.GetAuthMethodId()), handlers.WithOutputFields(outputFields))
+		if err != nil {
+			return nil, err
+		}
+		resp.Revisions = append(resp.Revisions, &pbs.ManagedGroupRevision{
+			Item:         item,
+			ReplacedTime: rev.GetReplacedTime().GetTimestamp(),
+			ReplacedById: rev.GetReplacedBy(),
+		})
+	}
+	return resp, nil
+}
+
+// DiffManagedGroupVersions implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) DiffManagedGroupVersions(ctx context.Context, req *pbs.DiffManagedGroupVersionsRequest) (_ *pbs.DiffManagedGroupVersionsResponse, retErr error) {
+	const op = "managed_groups.(Service).DiffManagedGroupVersions"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateDiffVersionsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, mg, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	versions := map[uint32]auth.ManagedGroup{mg.GetVersion(): mg}
+	if req.GetFromVersion() != mg.GetVersion() || req.GetToVersion() != mg.GetVersion() {
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		revs, err := repo.ListManagedGroupRevisions(ctx, mg.GetPublicId(), oidc.WithLimit(-1))
+		if err != nil {
+			return nil, repoError(ctx, op, err, errors.WithMsg("unable to list managed group revisions"))
+		}
+		for _, rev := range revs {
+			versions[rev.GetVersion()] = rev.ManagedGroup(mg.GetAuthMethodId())
+		}
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	resp := &pbs.DiffManagedGroupVersionsResponse{}
+	for _, v := range []struct {
+		version uint32
+		out     **pb.ManagedGroup
+	}{{req.GetFromVersion(), &resp.From}, {req.GetToVersion(), &resp.To}} {
+		vmg, ok := versions[v.version]
+		if !ok {
+			return nil, handlers.NotFoundErrorf("Managed Group %q has no stored version %d.", mg.GetPublicId(), v.version)
+		}
+		item, err := toProto(ctx, vmg, handlers.WithOutputFields(outputFields))
+		if err != nil {
+			return nil, err
+		}
+		*v.out = item
+	}
+	changes, err := fieldChanges(resp.From, resp.To, outputFields, diffFields)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range changes {
+		_, beforeUnset := c.GetBefore().GetKind().(*structpb.Value_NullValue)
+		_, afterUnset := c.GetAfter().GetKind().(*structpb.Value_NullValue)
+		switch {
+		case beforeUnset && afterUnset:
+		case beforeUnset:
+			resp.Added = append(resp.Added, c)
+		case afterUnset:
+			resp.Removed = append(resp.Removed, c)
+		case !proto.Equal(c.GetBefore(), c.GetAfter()):
+			resp.Changed = append(resp.Changed, c)
+		}
+	}
+	if outputFields.Has(attrFilterField) {
+		resp.FilterDiff = diffFilters(resp.From.GetOidcManagedGroupAttributes().GetFilter(), resp.To.GetOidcManagedGroupAttributes().GetFilter())
+	}
+	return resp, nil
+}
+
+// CreateManagedGroupTemplate implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) CreateManagedGroupTemplate(ctx context.Context, req *pbs.CreateManagedGroupTemplateRequest) (_ *pbs.CreateManagedGroupTemplateResponse, retErr error) {
+	const op = "managed_groups.(Service).CreateManagedGroupTemplate"
+	rpc := s.startRpcEvents(ctx, op, req.GetItem().GetScopeId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateCreateTemplateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := requestauth.Verify(ctx,
+		requestauth.WithType(resource.ManagedGroup),
+		requestauth.WithAction(action.Create),
+		requestauth.WithScopeId(req.GetItem().GetScopeId()))
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	item := req.GetItem()
+	tmpl, err := oidc.NewManagedGroupTemplate(ctx, item.GetScopeId(), item.GetName(), item.GetFilter(), oidc.WithDescription(item.GetDescription()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	out, err := repo.CreateManagedGroupTemplate(ctx, tmpl)
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return nil, invalidFieldError(globals.NameField, FieldErrorAlreadyExists, "A managed group template with this name already exists in the scope.")
+		}
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to create managed group template"))
+	}
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	return &pbs.CreateManagedGroupTemplateResponse{Item: templateToProto(out, outputFields)}, nil
+}
+
+// ListManagedGroupTemplates implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ListManagedGroupTemplates(ctx context.Context, req *pbs.ListManagedGroupTemplatesRequest) (_ *pbs.ListManagedGroupTemplatesResponse, retErr error) {
+	const op = "managed_groups.(Service).ListManagedGroupTemplates"
+	rpc := s.startRpcEvents(ctx, op, req.GetScopeId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateListTemplatesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := requestauth.Verify(ctx,
+		requestauth.WithType(resource.ManagedGroup),
+		requestauth.WithAction(action.List),
+		requestauth.WithScopeId(req.GetScopeId()))
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	tmpls, err := repo.ListManagedGroupTemplates(ctx, req.GetScopeId(), oidc.WithLimit(-1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		ScopeId: authResults.Scope.GetId(),
+		Type:    resource.ManagedGroup,
+	}, action.List).SelfOrDefaults(authResults.UserId)
+	resp := &pbs.ListManagedGroupTemplatesResponse{}
+	for _, t := range tmpls {
+		resp.Items = append(resp.Items, templateToProto(t, outputFields))
+	}
+	return resp, nil
+}
+
+// DeleteManagedGroupTemplate implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) DeleteManagedGroupTemplate(ctx context.Context, req *pbs.DeleteManagedGroupTemplateRequest) (_ *pbs.DeleteManagedGroupTemplateResponse, retErr error) {
+	const op = "managed_groups.(Service).DeleteManagedGroupTemplate"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateDeleteTemplateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	tmpl, err := s.lookupTemplateFromRepo(ctx, req.GetId())
+	if err == nil && tmpl == nil {
+		err = handlers.NotFoundError()
+	}
+	if err != nil {
+		return nil, unauthenticatedError(ctx, err)
+	}
+	authResults := requestauth.Verify(ctx,
+		requestauth.WithType(resource.ManagedGroup),
+		requestauth.WithAction(action.Delete),
+		requestauth.WithScopeId(tmpl.GetScopeId()))
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	rows, err := repo.DeleteManagedGroupTemplate(ctx, tmpl.GetScopeId(), req.GetId())
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to delete managed group template"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundError()
+	}
+	return nil, nil
+}
+
+// CreateManagedGroupFromTemplate implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) CreateManagedGroupFromTemplate(ctx context.Context, req *pbs.CreateManagedGroupFromTemplateRequest) (_ *pbs.CreateManagedGroupFromTemplateResponse, retErr error) {
+	const op = "managed_groups.(Service).CreateManagedGroupFromTemplate"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateCreateFromTemplateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authMeth, _, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if authMeth == nil {
+		return nil, handlers.NotFoundErrorf("Auth method %q not found.", req.GetAuthMethodId())
+	}
+	tmpl, err := s.lookupTemplateFromRepo(ctx, req.GetTemplateId())
+	if err != nil {
+		return nil, err
+	}
+	// Templates can only be used in the auth methods of their own scope.
+	if tmpl == nil || tmpl.GetScopeId() != authMeth.GetScopeId() {
+		return nil, handlers.NotFoundErrorf("Managed group template %q not found in the scope of auth method %q.", req.GetTemplateId(), req.GetAuthMethodId())
+	}
+
+	mg, err := tmpl.ManagedGroup(ctx, authMeth.GetPublicId())
+	if err != nil {
+		return nil, invalidFieldError(templateIdField, FieldErrorInvalidValue, fmt.Sprintf("The template doesn't define a valid managed group for the auth method: %s.", errors.Convert(err).Msg))
+	}
+	item := fromDefinition(authMeth.GetPublicId(), &pbs.ManagedGroupDefinition{
+		Name:        mg.GetName(),
+		Description: mg.GetDescription(),
+		Filter:      mg.GetFilter(),
+	})
+	if badFields := validateCreateItem(item); len(badFields) > 0 {
+		return nil, invalidFieldError(templateIdField, FieldErrorInvalidValue, fmt.Sprintf("The template doesn't define a valid managed group for the auth method: %s", badFieldsMessage(badFields.descriptions())))
+	}
+	if msg := matchAllFilterError(s.rejectMatchAll, req.GetAllowMatchAll(), item.GetOidcManagedGroupAttributes().GetFilter()); msg != "" {
+		return nil, invalidFieldError(templateIdField, FieldErrorInvalidValue, fmt.Sprintf("The template's filter %s", strings.ToLower(msg[:1])+msg[1:]))
+	}
+	if err := validateClaimAliasReferences(ctx, authMeth, item.GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	if err := validateClaimTypes(ctx, authMeth, item.GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+		return nil, err
+	}
+	warnings := filterWarnings(ctx, item)
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	created, err := s.createInRepo(ctx, authMeth, item, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	rpc.changed(ctx, changeCreated, created.GetPublicId(), authResults.UserId)
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields), handlers.WithUri(fmt.Sprintf("managed-groups/%s", created.GetPublicId())))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, created.GetPublicId(), IdActions[oidc.Subtype]).Strings()))
+	}
+	out, err := toProto(ctx, created, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.CreateManagedGroupFromTemplateResponse{Item: out, Uri: fmt.Sprintf("managed-groups/%s", out.GetId()), Warnings: warnings}, nil
+}
+
+// ReplaceInFilters implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ReplaceInFilters(ctx context.Context, req *pbs.ReplaceInFiltersRequest) (_ *pbs.ReplaceInFiltersResponse, retErr error) {
+	const op = "managed_groups.(Service).ReplaceInFilters"
+	rpc := s.startRpcEvents(ctx, op, req.GetAuthMethodId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateReplaceInFiltersRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	// Without an id this requires update on every managed group of the auth
+	// method, e.g. id=*;type=managed-group;actions=update.
+	authMeth, _, authResults := s.authResult(ctx, req.GetAuthMethodId(), nil, action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	mgs, err := repo.ListManagedGroups(ctx, req.GetAuthMethodId(), oidc.WithLimit(-1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	sort.Slice(mgs, func(i, j int) bool {
+		return mgs[i].GetPublicId() < mgs[j].GetPublicId()
+	})
+
+	resp := &pbs.ReplaceInFiltersResponse{}
+	res := perms.Resource{
+		ScopeId: authResults.Scope.GetId(),
+		Type:    resource.ManagedGroup,
+		Pin:     req.GetAuthMethodId(),
+	}
+	for _, mg := range mgs {
+		newFilter, changed, err := oidc.ReplaceManagedGroupFilterSelectorPrefix(ctx, mg.GetFilter(), req.GetOldPrefix(), req.GetNewPrefix())
+		if err == nil && !changed {
+			continue
+		}
+		res.Id = mg.GetPublicId()
+		withFilter := authResults.FetchOutputFields(res, action.Update).SelfOrDefaults(authResults.UserId).Has(attrFilterField)
+		result := &pbs.ManagedGroupFilterReplacement{Id: mg.GetPublicId()}
+		if withFilter {
+			result.OldFilter = mg.GetFilter()
+		}
+		resp.Replacements = append(resp.Replacements, result)
+		if err != nil {
+			result.Status, result.Error = replaceStatusFailed, handlers.ToApiError(err).GetMessage()
+			continue
+		}
+		if mg.GetFrozen() && !req.GetForce() {
+			result.Status, result.Error = replaceStatusFailed, "The managed group is frozen, so its filter can't be changed. Unfreeze it first, or set force to replace in its filter while leaving its members as they are."
+			continue
+		}
+		// The new filter is validated as updating the filter alone would.
+		item := &pb.ManagedGroup{
+			AuthMethodId: req.GetAuthMethodId(),
+			Version:      mg.GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: newFilter},
+			},
+		}
+		updReq := &pbs.UpdateManagedGroupRequest{
+			Id:            mg.GetPublicId(),
+			Item:          item,
+			UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{attrFilterField}},
+			AllowMatchAll: req.GetAllowMatchAll(),
+		}
+		upd := oidc.AllocManagedGroup()
+		upd.PublicId = mg.GetPublicId()
+		upd.Filter = newFilter
+		err = validateUpdateRequest(ctx, updReq)
+		if err == nil {
+			err = validateFilterUpdate(ctx, authMeth, mg, updReq, s.rejectMatchAll)
+		}
+		if err == nil && s.uniqueFilters {
+			err = checkUniqueFilter(ctx, repo, authMeth, upd, []string{oidc.FilterField})
+		}
+		if err != nil {
+			result.Status, result.Error = replaceStatusFailed, replacementError(err)
+			continue
+		}
+		result.Warnings = filterWarnings(ctx, item)
+		if withFilter {
+			result.NewFilter = newFilter
+		}
+		if !req.GetApply() {
+			result.Status = replaceStatusWouldReplace
+			continue
+		}
+
+		_, rowsUpdated, err := repo.UpdateManagedGroup(ctx, authResults.Scope.Id, upd, mg.GetVersion(), []string{oidc.FilterField}, oidc.WithActorId(authResults.UserId))
+		switch {
+		case err != nil:
+			rpc.itemFailed(ctx, err, "unable to replace managed group filter", "managed_group_id", mg.GetPublicId())
+			result.Status, result.Error = replaceStatusFailed, handlers.ToApiError(err).GetMessage()
+		case rowsUpdated == 0:
+			result.Status, result.Error = replaceStatusFailed, "The managed group was changed or deleted while replacing."
+		default:
+			rpc.changed(ctx, changeUpdated, mg.GetPublicId(), authResults.UserId)
+			result.Status = replaceStatusReplaced
+		}
+	}
+	return resp, nil
+}
+
+// ValidateStoredFilters implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) ValidateStoredFilters(ctx context.Context, req *pbs.ValidateStoredFiltersRequest) (_ *pbs.ValidateStoredFiltersResponse, retErr error) {
+	const op = "managed_groups.(Service).ValidateStoredFilters"
+	id := req.GetAuthMethodId()
+	if id == "" {
+		id = req.GetScopeId()
+	}
+	rpc := s.startRpcEvents(ctx, op, id)
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateValidateStoredFiltersRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	// Without an id this requires read on every managed group of the auth
+	// method or scope, e.g. id=*;type=managed-group;actions=read.
+	var authMeth auth.AuthMethod
+	var authResults requestauth.VerifyResults
+	if req.GetAuthMethodId() != "" {
+		authMeth, _, authResults = s.authResult(ctx, req.GetAuthMethodId(), nil, action.Read)
+	} else {
+		authResults = requestauth.Verify(ctx,
+			requestauth.WithType(resource.ManagedGroup),
+			requestauth.WithAction(action.Read),
+			requestauth.WithScopeId(req.GetScopeId()))
+	}
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	var ams []*oidc.AuthMethod
+	if authMeth != nil {
+		am, ok := authMeth.(*oidc.AuthMethod)
+		if !ok {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to validate the filters of an unrecognized auth method type.")
+		}
+		ams = []*oidc.AuthMethod{am}
+	} else {
+		ams, err = repo.ListAuthMethods(ctx, []string{req.GetScopeId()}, oidc.WithLimit(-1))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		sort.Slice(ams, func(i, j int) bool {
+			return ams[i].GetPublicId() < ams[j].GetPublicId()
+		})
+	}
+
+	resp := &pbs.ValidateStoredFiltersResponse{}
+	if after := req.GetAfterAuthMethodId(); after != "" {
+		ams = ams[sort.Search(len(ams), func(i int) bool {
+			return ams[i].GetPublicId() > after
+		}):]
+	}
+	// Bound the fan out before any auth method's managed groups are listed.
+	if s.maxScopeFanOut > 0 && len(ams) > s.maxScopeFanOut {
+		ams = ams[:s.maxScopeFanOut]
+		resp.Truncated = true
+		resp.NextAfterAuthMethodId = ams[len(ams)-1].GetPublicId()
+	}
+	for _, am := range ams {
+		aliases, err := oidc.ParseClaimAliases(ctx, am.GetClaimAliases()...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		mgs, err := repo.ListManagedGroups(ctx, am.GetPublicId(), oidc.WithLimit(-1))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		sort.Slice(mgs, func(i, j int) bool {
+			return mgs[i].GetPublicId() < mgs[j].GetPublicId()
+		})
+		for _, mg := range mgs {
+			resp.ValidatedCount++
+			err := oidc.CompileManagedGroupFilter(ctx, mg, oidc.WithClaimAliases(aliases))
+			if err != nil {
+				failure := &pbs.ManagedGroupFilterFailure{
+					Id:           mg.GetPublicId(),
+					AuthMethodId: am.GetPublicId(),
+					Error:        handlers.ToApiError(err).GetMessage(),
+				}
+				res := perms.Resource{
+					ScopeId: authResults.Scope.GetId(),
+					Type:    resource.ManagedGroup,
+					Pin:     am.GetPublicId(),
+					Id:      mg.GetPublicId(),
+				}
+				if authResults.FetchOutputFields(res, action.Read).SelfOrDefaults(authResults.UserId).Has(attrFilterField) {
+					failure.Filter = mg.GetFilter()
+				}
+				resp.Failures = append(resp.Failures, failure)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// AddManagedGroupToRoles implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) AddManagedGroupToRoles(ctx context.Context, req *pbs.AddManagedGroupToRolesRequest) (_ *pbs.AddManagedGroupToRolesResponse, retErr error) {
+	const op = "managed_groups.(Service).AddManagedGroupToRoles"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateAddToRolesRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, mg, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	repo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+
+	resp := &pbs.AddManagedGroupToRolesResponse{}
+	var roles []*iam.Role
+	var pending []*pbs.ManagedGroupRoleAddition
+	for _, roleId := range req.GetRoleIds() {
+		result := &pbs.ManagedGroupRoleAddition{RoleId: roleId}
+		resp.Results = append(resp.Results, result)
+
+		role, principals, _, err := repo.LookupRole(ctx, roleId)
+		if err != nil {
+			rpc.itemFailed(ctx, err, "unable to look up role", "role_id", roleId)
+			result.Status, result.Error = addToRoleStatusFailed, handlers.ToApiError(err).GetMessage()
+			continue
+		}
+		// A role which doesn't exist is reported the same way as one the
+		// caller can't add principals to, so its existence isn't disclosed.
+		if role == nil || !authResults.FetchActionSetForId(ctx, roleId, action.ActionSet{action.AddPrincipals}, requestauth.WithResource(&perms.Resource{
+			ScopeId: role.GetScopeId(),
+			Type:    resource.Role,
+		})).HasAction(action.AddPrincipals) {
+			result.Status, result.Error = addToRoleStatusFailed, "Role not found or the add-principals action is not granted on it."
+			continue
+		}
+		if isPrincipal(principals, mg.GetPublicId()) {
+			result.Status = addToRoleStatusAlreadyPrincipal
+			continue
+		}
+		roles = append(roles, role)
+		pending = append(pending, result)
+	}
+	if len(roles) == 0 {
+		return resp, nil
+	}
+
+	status, msg := addToRoleStatusAdded, ""
+	_, err = repo.AddManagedGroupToRoles(ctx, mg.GetPublicId(), roles)
+	if err != nil {
+		// The roles are updated in a single transaction, so none of them
+		// were updated.
+		rpc.itemFailed(ctx, err, "unable to add managed group to roles")
+		status, msg = addToRoleStatusFailed, handlers.ToApiError(err).GetMessage()
+	}
+	for _, result := range pending {
+		result.Status, result.Error = status, msg
+	}
+	return resp, nil
+}
+
+// SetManagedGroupsEnabled implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) SetManagedGroupsEnabled(ctx context.Context, req *pbs.SetManagedGroupsEnabledRequest) (_ *pbs.SetManagedGroupsEnabledResponse, retErr error) {
+	const op = "managed_groups.(Service).SetManagedGroupsEnabled"
+	rpc := s.startRpcEvents(ctx, op, "")
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateSetEnabledRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		ids = append(ids, item.GetId())
+	}
+	mgs, err := s.lookupManyFromRepo(ctx, ids)
+	if err != nil {
+		return nil, unauthenticatedError(ctx, err)
+	}
+
+	// The managed groups may be in several auth methods, so the caller is
+	// authorized once per auth method and then for each managed group.
+	byAuthMethod := make(map[string][]auth.ManagedGroup)
+	var amIds []string
+	for _, mg := range mgs {
+		amId := mg.GetAuthMethodId()
+		if _, ok := byAuthMethod[amId]; !ok {
+			amIds = append(amIds, amId)
+		}
+		byAuthMethod[amId] = append(byAuthMethod[amId], mg)
+	}
+	// updatable holds the managed groups the caller can update, and the scope
+	// of each.
+	updatable := make(map[string]*oidc.ManagedGroup, len(mgs))
+	scopeIds := make(map[string]string, len(mgs))
+	var userId string
+	allowed := false
+	for _, amId := range amIds {
+		_, _, authResults := s.authResult(ctx, amId, nil, action.Update)
+		if authResults.Error != nil {
+			switch {
+			case authResults.Error == handlers.ForbiddenError() && authResults.AuthenticationFinished:
+				// Grants on the ids of the managed groups are checked below,
+				// and allow updating them without allowing it in the whole
+				// auth method.
+			case errors.Is(authResults.Error, handlers.NotFoundError()):
+				// The auth method was deleted along with its managed groups
+				// after they were looked up.
+				continue
+			default:
+				return nil, authResults.Error
+			}
+		}
+		if !allowed {
+			if err := s.mutationLimiter.allow(authResults.UserId); err != nil {
+				return nil, err
+			}
+			allowed, userId = true, authResults.UserId
+		}
+		for _, mg := range byAuthMethod[amId] {
+			res := &perms.Resource{
+				ScopeId: authResults.Scope.GetId(),
+				Type:    resource.ManagedGroup,
+				Pin:     amId,
+				Id:      mg.GetPublicId(),
+			}
+			oidcMg, ok := mg.(*oidc.ManagedGroup)
+			if !ok || !authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, mg.GetPublicId())], requestauth.WithResource(res)).HasAction(action.Update) {
+				continue
+			}
+			updatable[mg.GetPublicId()] = oidcMg
+			scopeIds[mg.GetPublicId()] = authResults.Scope.GetId()
+		}
+	}
+	if !allowed && requestauth.Anonymous(ctx) {
+		return nil, handlers.UnauthenticatedError()
+	}
+
+	resp := &pbs.SetManagedGroupsEnabledResponse{}
+	var pending []oidc.ManagedGroupVersion
+	var pendingResults []*pbs.ManagedGroupEnabledResult
+	for _, item := range req.GetItems() {
+		result := &pbs.ManagedGroupEnabledResult{Id: item.GetId()}
+		resp.Results = append(resp.Results, result)
+		mg, ok := updatable[item.GetId()]
+		switch {
+		case !ok:
+			result.Status, result.Error = setEnabledStatusFailed, "Managed group not found or the update action is not granted on it."
+		case mg.GetVersion() != item.GetVersion():
+			result.Status, result.Error = setEnabledStatusFailed, fmt.Sprintf("Managed group is at version %d, not %d.", mg.GetVersion(), item.GetVersion())
+		case mg.GetDisabled() != req.GetEnabled():
+			result.Status, result.Version = setEnabledStatusUnchanged, mg.GetVersion()
+		default:
+			pending = append(pending, oidc.ManagedGroupVersion{ScopeId: scopeIds[item.GetId()], PublicId: item.GetId(), Version: item.GetVersion()})
+			pendingResults = append(pendingResults, result)
+		}
+	}
+	if len(pending) == 0 {
+		return resp, nil
+	}
+
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	updated, err := repo.SetManagedGroupsDisabled(ctx, !req.GetEnabled(), pending, oidc.WithActorId(userId))
+	if err != nil {
+		// The managed groups are updated in a single transaction, so none of
+		// them were updated.
+		rpc.itemFailed(ctx, err, "unable to set managed groups enabled")
+		msg := handlers.ToApiError(err).GetMessage()
+		for _, result := range pendingResults {
+			result.Status, result.Error = setEnabledStatusFailed, msg
+		}
+		return resp, nil
+	}
+	for i, mg := range updated {
+		pendingResults[i].Status, pendingResults[i].Version = setEnabledStatusUpdated, mg.GetVersion()
+		rpc.changed(ctx, changeUpdated, mg.GetPublicId(), userId)
+	}
+	return resp, nil
+}
+
+// AuthorizeManagedGroup implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) AuthorizeManagedGroup(ctx context.Context, req *pbs.AuthorizeManagedGroupRequest) (_ *pbs.AuthorizeManagedGroupResponse, retErr error) {
+	const op = "managed_groups.(Service).AuthorizeManagedGroup"
+	rpc := s.startRpcEvents(ctx, op, req.GetId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateAuthorizeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, _, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.NoOp)
+	if authResults.Error != nil {
+		return nil, s.resourceAuthError(authResults.Error)
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	authorized := authResults.FetchActionSetForId(ctx, req.GetId(), IdActions[subtypes.SubtypeFromId(domain, req.GetId())])
+	return &pbs.AuthorizeManagedGroupResponse{Authorized: authorized.HasAction(action.Map[req.GetAction()])}, nil
+}
+
+// CountManagedGroups implements the interface pbs.ManagedGroupServiceServer.
+func (s Service) CountManagedGroups(ctx context.Context, req *pbs.CountManagedGroupsRequest) (_ *pbs.CountManagedGroupsResponse, retErr error) {
+	const op = "managed_groups.(Service).CountManagedGroups"
+	rpc := s.startRpcEvents(ctx, op, req.GetScopeId())
+	defer func() { rpc.end(ctx, retErr) }()
+	if err := validateCountRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := requestauth.Verify(ctx,
+		requestauth.WithType(resource.ManagedGroup),
+		requestauth.WithAction(action.List),
+		requestauth.WithScopeId(req.GetScopeId()))
+	if authResults.Error != nil {
+		// Whether the caller can list the managed groups of each auth method
+		// is checked below, and grants pinned to an auth method allow listing
+		// its managed groups without allowing it in the whole scope.
+		if authResults.Error != handlers.ForbiddenError() || !authResults.AuthenticationFinished {
+			return nil, authResults.Error
+		}
+	}
+	rpc.scopeId = authResults.Scope.GetId()
+	if err := s.readLimiter.allow(authResults.UserId); err != nil {
+		return nil, err
+	}
+	scopeIds := []string{req.GetScopeId()}
+	if req.GetRecursive() {
+		repo, err := s.iamRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		scps, err := repo.ListScopesRecursively(ctx, req.GetScopeId())
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		scopeIds = scopeIds[:0]
+		for _, scp := range scps {
+			scopeIds = append(scopeIds, scp.GetPublicId())
+		}
+	}
+	ams, err := s.authMethodsInScopesFromRepo(ctx, scopeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbs.CountManagedGroupsResponse{}
+	if after := req.GetAfterAuthMethodId(); after != "" {
+		ams = ams[sort.Search(len(ams), func(i int) bool {
+			return ams[i].GetPublicId() > after
+		}):]
+	}
+	// Bound the fan out before any auth method's managed groups are counted.
+	if s.maxScopeFanOut > 0 && len(ams) > s.maxScopeFanOut {
+		ams = ams[:s.maxScopeFanOut]
+		resp.Truncated = true
+		resp.NextAfterAuthMethodId = ams[len(ams)-1].GetPublicId()
+	}
+	for _, am := range ams {
+		res := &perms.Resource{
+			ScopeId: am.GetScopeId(),
+			Type:    resource.ManagedGroup,
+			Pin:     am.GetPublicId(),
+		}
+		if len(authResults.FetchActionSetForType(ctx, resource.ManagedGroup, action.ActionSet{action.List}, requestauth.WithResource(res))) == 0 {
+			continue
+		}
+		count, err := s.countFromRepo(ctx, am.GetPublicId(), listOptions{})
+		if err != nil {
+			return nil, err
+		}
+		resp.Counts = append(resp.Counts, &pbs.AuthMethodManagedGroupCount{
+			AuthMethodId: am.GetPublicId(),
+			ScopeId:      am.GetScopeId(),
+			Count:        uint32(count),
+		})
+	}
+	return resp, nil
+}
+
+// rolesToAddTo looks up the roles with the ids a managed group being created
+// is to be added to. A role which doesn't exist is reported the same way as
+// one the caller can't add principals to, so its existence isn't disclosed.
+func (s Service) rolesToAddTo(ctx context.Context, authResults requestauth.VerifyResults, roleIds []string) ([]*iam.Role, error) {
+	const op = "managed_groups.(Service).rolesToAddTo"
+	if len(roleIds) == 0 {
+		return nil, nil
+	}
+	repo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	roles := make([]*iam.Role, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		role, _, _, err := repo.LookupRole(ctx, roleId)
+		if err != nil {
+			return nil, repoError(ctx, op, err, errors.WithMsg(fmt.Sprintf("unable to look up role %s", roleId)))
+		}
+		if role == nil || !authResults.FetchActionSetForId(ctx, roleId, action.ActionSet{action.AddPrincipals}, requestauth.WithResource(&perms.Resource{
+			ScopeId: role.GetScopeId(),
+			Type:    resource.Role,
+		})).HasAction(action.AddPrincipals) {
+			return nil, invalidFieldError(roleIdsField, FieldErrorInvalidValue, fmt.Sprintf("Role %q not found or the add-principals action is not granted on it.", roleId))
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// isPrincipal reports whether the principal with the provided id is one of the
+// role's principals.
+func isPrincipal(principals []*iam.PrincipalRole, id string) bool {
+	for _, p := range principals {
+		if p.GetPrincipalId() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// memberIdsFromRepo returns the ids of the accounts which are members of the
+// already fetched managed group.
+func (s Service) memberIdsFromRepo(ctx context.Context, mg auth.ManagedGroup) ([]string, error) {
+	const op = "managed_groups.(Service).memberIdsFromRepo"
+	var memberIds []string
+	switch mg.(type) {
+	case *oidc.ManagedGroup:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+	case *ldap.ManagedGroup:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+	default:
+		return nil, handlers.NotFoundErrorf("Unrecognized id.")
+	}
+	return memberIds, nil
+}
+
+// memberPageFromRepo returns up to limit memberships of the already fetched
+// managed group, ordered by the time they were created and then by account id,
+// the order ListManagedGroupMembers pages through them in. With after it
+// returns the memberships which follow the one the token was made from.
+func (s Service) memberPageFromRepo(ctx context.Context, mg auth.ManagedGroup, after *memberPageToken, limit int) ([]*pbs.ManagedGroupMembership, error) {
+	const op = "managed_groups.(Service).memberPageFromRepo"
+	var out []*pbs.ManagedGroupMembership
+	switch mg.(type) {
+	case *oidc.ManagedGroup:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		opts := []oidc.Option{oidc.WithOrderByCreateTime(true), oidc.WithLimit(limit)}
+		if after != nil {
+			opts = append(opts, oidc.WithAfterMember(after.CreatedTime, after.AccountId))
+		}
+		ms, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId(), opts...)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, m := range ms {
+			out = append(out, &pbs.ManagedGroupMembership{
+				AccountId:   m.GetMemberId(),
+				CreatedTime: m.GetCreateTime().GetTimestamp(),
+				Source:      m.GetSource(),
+			})
+		}
+	case *ldap.ManagedGroup:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		opts := []ldap.Option{ldap.WithOrderByCreateTime(ctx, true), ldap.WithLimit(ctx, limit)}
+		if after != nil {
+			opts = append(opts, ldap.WithAfterMember(ctx, after.CreatedTime, after.AccountId))
+		}
+		ms, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId(), opts...)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, m := range ms {
+			// LDAP memberships are always found from the groups of the
+			// account when it logs in.
+			out = append(out, &pbs.ManagedGroupMembership{
+				AccountId:   m.GetMemberId(),
+				CreatedTime: m.GetCreateTime().GetTimestamp(),
+				Source:      auth.MembershipSourceLogin,
+			})
+		}
+	default:
+		return nil, handlers.NotFoundErrorf("Unrecognized id.")
+	}
+	return out, nil
+}
+
+func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup, initialMembers []string, roles []*iam.Role) (*oidc.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createOidcInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	var opts []oidc.Option
+	if item.GetName() != nil {
+		opts = append(opts, oidc.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetOidcManagedGroupAttributes()
+	if attrs.GetDisabled() {
+		opts = append(opts, oidc.WithDisabled(true))
+	}
+	if attrs.GetMatchOptions().GetCaseInsensitive() {
+		opts = append(opts, oidc.WithMatchCaseInsensitive(true))
+	}
+	if item.GetKind() != "" {
+		opts = append(opts, oidc.WithKind(item.GetKind()))
+	}
+	if item.GetOwnerId() != nil {
+		opts = append(opts, oidc.WithOwnerId(item.GetOwnerId().GetValue()))
+	}
+	mg, err := oidc.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetFilter(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	if s.uniqueFilters {
+		if err := checkUniqueFilter(ctx, repo, am, mg, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	createOpts := []oidc.Option{oidc.WithInitialMembers(initialMembers...), oidc.WithRoles(roles...)}
+	if len(item.GetTags()) > 0 {
+		createOpts = append(createOpts, oidc.WithTags(item.GetTags()))
+	}
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg, createOpts...)
+	if err != nil {
+		switch {
+		case len(initialMembers) > 0 && errors.Match(errors.T(errors.RecordNotFound), err):
+			return nil, invalidFieldError(initialMembersField, FieldErrorInvalidValue, "Must only contain ids of accounts of the auth method.")
+		case mg.GetName() != "" && errors.Match(errors.T(errors.NotUnique), err):
+			// Another managed group with the name may have been created
+			// since the request was validated, so this is only found out
+			// from the unique constraint.
+			return nil, invalidFieldError(globals.NameField, FieldErrorAlreadyExists, "A managed group with this name already exists in the auth method.")
+		}
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup, roles []*iam.Role) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(ctx, item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(ctx, item.GetDescription().GetValue()))
+	}
+	if item.GetKind() != "" {
+		opts = append(opts, ldap.WithKind(ctx, item.GetKind()))
+	}
+	if item.GetOwnerId() != nil {
+		opts = append(opts, ldap.WithOwnerId(ctx, item.GetOwnerId().GetValue()))
+	}
+	attrs := item.GetLdapManagedGroupAttributes()
+	mg, err := ldap.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetGroupNames(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+
+	createOpts := []ldap.Option{ldap.WithRoles(ctx, roles...)}
+	if len(item.GetTags()) > 0 {
+		createOpts = append(createOpts, ldap.WithTags(ctx, item.GetTags()))
+	}
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg, createOpts...)
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
+// createInRepo creates the managed group item in the auth method am. The
+// accounts with the ids in initialMembers are added as its members, which is
+// only supported for OIDC managed groups. It is added as a principal to the
+// roles and its tags are set in the same transaction, so if that fails it
+// isn't created.
+func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup, initialMembers []string, roles []*iam.Role) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	release, err := s.reserveQuota(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	var out auth.ManagedGroup
+	switch subtypes.SubtypeFromId(domain, am.GetPublicId()) {
+	case oidc.Subtype:
+		am, err := s.createOidcInRepo(ctx, am, item, initialMembers, roles)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+		}
+		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item, roles)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ldap managed group but no error returned from repository.")
+		}
+		out = am
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unrecognized auth method subtype for %q", am.GetPublicId()))
+	}
+	return out, nil
+}
+
+// upsertUpdateRequest returns the request updating the existing managed group
+// to match the item of an upsert. Only the fields the item sets are updated,
+// so an omitted description is kept, and the update applies only to the
+// version of the managed group which was authorized.
+func upsertUpdateRequest(existing auth.ManagedGroup, item *pb.ManagedGroup) *pbs.UpdateManagedGroupRequest {
+	item = proto.Clone(item).(*pb.ManagedGroup)
+	item.Version = existing.GetVersion()
+	var mask []string
+	if item.GetDescription() != nil {
+		mask = append(mask, globals.DescriptionField)
+	}
+	switch existing.(type) {
+	case *oidc.ManagedGroup:
+		mask = append(mask, attrFilterField)
+	case *ldap.ManagedGroup:
+		mask = append(mask, attrGroupNamesField)
+	}
+	return &pbs.UpdateManagedGroupRequest{
+		Id:         existing.GetPublicId(),
+		Item:       item,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: mask},
+	}
+}
+
+func (s Service) updateOidcInRepo(ctx context.Context, scopeId string, am auth.AuthMethod, id, userId string, mask []string, item *pb.ManagedGroup, setTags bool) (*oidc.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateOidcInRepo"
+	mg, dbMask, err := oidcUpdate(ctx, id, mask, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	if s.uniqueFilters && (handlers.MaskContains(dbMask, oidc.FilterField) || handlers.MaskContains(dbMask, oidc.MatchCaseInsensitiveField)) {
+		if err := checkUniqueFilter(ctx, repo, am, mg, dbMask); err != nil {
+			return nil, err
+		}
+	}
+	updateOpts := []oidc.Option{oidc.WithActorId(userId)}
+	if setTags {
+		updateOpts = append(updateOpts, oidc.WithTags(item.GetTags()))
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, item.GetVersion(), dbMask, updateOpts...)
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+// checkUniqueFilter returns an invalid argument error on the filter if mg, as it
+// is about to be written, is matched the same way as another managed group of
+// the OIDC auth method am, see oidc.EquivalentManagedGroup. When mg is being
+// updated it only holds the fields in dbMask, and the other fields are taken
+// from the stored managed group. The check isn't atomic with the write, so
+// concurrent requests can still write equivalent filters.
+func checkUniqueFilter(ctx context.Context, repo *oidc.Repository, am auth.AuthMethod, mg *oidc.ManagedGroup, dbMask []string) error {
+	const op = "managed_groups.checkUniqueFilter"
+	oidcAm, ok := am.(*oidc.AuthMethod)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, "auth method is not an oidc auth method")
+	}
+	aliases, err := oidc.ParseClaimAliases(ctx, oidcAm.GetClaimAliases()...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	mgs, err := repo.ListManagedGroups(ctx, am.GetPublicId(), oidc.WithLimit(-1))
+	if err != nil {
+		return repoError(ctx, op, err)
+	}
+	if dbMask != nil {
+		var stored *oidc.ManagedGroup
+		for _, m := range mgs {
+			if m.GetPublicId() == mg.GetPublicId() {
+				stored = m.Clone()
+				break
+			}
+		}
+		if stored == nil {
+			// Left for the update to report.
+			return nil
+		}
+		for _, f := range dbMask {
+			switch {
+			case strings.EqualFold(f, oidc.FilterField):
+				stored.Filter = mg.Filter
+			case strings.EqualFold(f, oidc.MatchCaseInsensitiveField):
+				stored.MatchCaseInsensitive = mg.MatchCaseInsensitive
+			}
+		}
+		mg = stored
+	}
+	other, err := oidc.EquivalentManagedGroup(ctx, mg, mgs, oidc.WithClaimAliases(aliases))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if other != nil {
+		return invalidFieldError(attrFilterField, FieldErrorInvalidValue, fmt.Sprintf("Equivalent to the filter of managed group %q.", other.GetPublicId()))
+	}
+	return nil
+}
+
+// oidcUpdate returns the OIDC managed group holding the fields an update
+// request sets, and the mask of the storage fields it updates.
+func oidcUpdate(ctx context.Context, id string, mask []string, item *pb.ManagedGroup) (*oidc.ManagedGroup, []string, error) {
+	const op = "managed_groups.oidcUpdate"
+	if item == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	if item.GetOwnerId() != nil {
+		mg.OwnerId = item.GetOwnerId().GetValue()
+	}
+	mask = expandMatchOptionsPaths(mask)
+	// Attributes are only read when the mask updates them, so attributes which
+	// aren't being updated can't make the update fail.
+	if handlers.MaskContains(mask, attrFilterField) {
+		mg.Filter = item.GetOidcManagedGroupAttributes().GetFilter()
+	}
+	if handlers.MaskContains(mask, attrDisabledField) {
+		mg.Disabled = item.GetOidcManagedGroupAttributes().GetDisabled()
+	}
+	if handlers.MaskContains(mask, attrMatchCaseInsensitiveField) {
+		mg.MatchCaseInsensitive = item.GetOidcManagedGroupAttributes().GetMatchOptions().GetCaseInsensitive()
+	}
+	if handlers.MaskContains(mask, attrFrozenField) {
+		mg.Frozen = item.GetOidcManagedGroupAttributes().GetFrozen()
+	}
+
+	dbMask := oidcMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, nil, invalidFields{"update_mask": {code: FieldErrorInvalidValue, description: "No valid fields provided in the update mask."}}.errWithMessage("No valid fields included in the update mask.")
+	}
+	return mg, dbMask, nil
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup, setTags bool) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateLdapInRepo"
+	mg, dbMask, err := ldapUpdate(ctx, id, mask, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	var updateOpts []ldap.Option
+	if setTags {
+		updateOpts = append(updateOpts, ldap.WithTags(ctx, item.GetTags()))
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, item.GetVersion(), dbMask, updateOpts...)
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+// ldapUpdate returns the LDAP managed group holding the fields an update
+// request sets, and the mask of the storage fields it updates.
+func ldapUpdate(ctx context.Context, id string, mask []string, item *pb.ManagedGroup) (*ldap.ManagedGroup, []string, error) {
+	const op = "managed_groups.ldapUpdate"
+	if item == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := ldap.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	if item.GetOwnerId() != nil {
+		mg.OwnerId = item.GetOwnerId().GetValue()
+	}
+	if handlers.MaskContains(mask, attrGroupNamesField) {
+		encodedGroupNames, err := json.Marshal(item.GetLdapManagedGroupAttributes().GetGroupNames())
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode group names"))
+		}
+		mg.GroupNames = string(encodedGroupNames)
+	}
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, nil, invalidFields{"update_mask": {code: FieldErrorInvalidValue, description: "No valid fields provided in the update mask."}}.errWithMessage("No valid fields included in the update mask.")
+	}
+	return mg, dbMask, nil
+}
+
+// updateInRepo updates the managed group grp, as it was looked up to authorize
+// the request, in the auth method am of the scope scopeId. If setTags is set,
+// its tags are replaced with the tags of the request's item in the same
+// transaction.
+func (s Service) updateInRepo(ctx context.Context, scopeId string, am auth.AuthMethod, grp auth.ManagedGroup, userId string, req *pbs.UpdateManagedGroupRequest, setTags bool) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateInRepo"
+	if err := verifyUpdateScope(ctx, scopeId, am, grp, req.GetId()); err != nil {
+		return nil, err
+	}
+	var out auth.ManagedGroup
+	switch subtypes.SubtypeFromId(domain, req.GetId()) {
+	case oidc.Subtype:
+		mg, err := s.updateOidcInRepo(ctx, scopeId, am, req.GetId(), userId, req.GetUpdateMask().GetPaths(), req.GetItem(), setTags)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
+	case ldap.Subtype:
+		mg, err := s.updateLdapInRepo(ctx, scopeId, am.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem(), setTags)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
+	}
+	return out, nil
+}
+
+// verifyPreviewedName returns the error the update of the managed group grp to
+// previewed would fail with because another managed group of its auth method
+// already has the previewed name.
+func (s Service) verifyPreviewedName(ctx context.Context, grp, previewed auth.ManagedGroup) error {
+	const op = "managed_groups.(Service).verifyPreviewedName"
+	name := previewed.GetName()
+	if name == "" || name == grp.GetName() {
+		return nil
+	}
+	existing, err := s.lookupByNameFromRepo(ctx, grp.GetAuthMethodId(), name, false)
+	if err != nil {
+		return repoError(ctx, op, err)
+	}
+	if existing != nil && existing.GetPublicId() != grp.GetPublicId() {
+		return invalidFieldError(globals.NameField, FieldErrorAlreadyExists, "A managed group with this name already exists in the auth method.")
+	}
+	return nil
+}
+
+// verifyUpdateScope returns a permission denied error unless the managed group
+// grp, as it was looked up to authorize the update of the managed group id, is
+// that managed group, belongs to the auth method am and so is in the scope
+// scopeId the repository is asked to update it in. The repository trusts the
+// scope it's given, so this guards against an update being authorized in one
+// scope and written to a managed group of another.
+func verifyUpdateScope(ctx context.Context, scopeId string, am auth.AuthMethod, grp auth.ManagedGroup, id string) error {
+	const op = "managed_groups.verifyUpdateScope"
+	switch {
+	case am == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case grp == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing managed group")
+	case grp.GetPublicId() != id,
+		grp.GetAuthMethodId() != am.GetPublicId(),
+		am.GetScopeId() != scopeId:
+		return handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Managed group %q is not in the scope the update was authorized in.", id)
+	}
+	return nil
+}
+
+// previewUpdate returns the managed group grp as it would be after the update
+// request, without writing anything. As with an update, it fails unless grp is
+// at the request's version. The tags aren't held by grp, so an update of only
+// them only increments the version. The name of the result isn't checked to be
+// unique, which verifyPreviewedName does.
+func previewUpdate(ctx context.Context, grp auth.ManagedGroup, req *pbs.UpdateManagedGroupRequest) (auth.ManagedGroup, error) {
+	const op = "managed_groups.previewUpdate"
+	if grp == nil || grp.GetVersion() != req.GetItem().GetVersion() {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	mask := withoutPath(req.GetUpdateMask().GetPaths(), globals.TagsField)
+	tagsOnly := len(mask) == 0 && handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.TagsField)
+	switch orig := grp.(type) {
+	case *oidc.ManagedGroup:
+		mg, dbMask := oidc.AllocManagedGroup(), []string(nil)
+		if !tagsOnly {
+			var err error
+			if mg, dbMask, err = oidcUpdate(ctx, req.GetId(), mask, req.GetItem()); err != nil {
+				return nil, err
+			}
+		}
+		out := orig.Clone()
+		for _, f := range dbMask {
+			switch {
+			case strings.EqualFold(f, "Name"):
+				out.Name = mg.Name
+			case strings.EqualFold(f, "Description"):
+				out.Description = mg.Description
+			case strings.EqualFold(f, "Filter"):
+				if out.Filter != mg.Filter {
+					// As the database does, only a change of the filter
+					// increments the filter version.
+					out.FilterVersion++
+				}
+				out.Filter = mg.Filter
+				out.FilterSyntaxVersion = oidc.FilterSyntaxVersion
+				out.FilterHasWarnings = oidc.FilterHasWarnings(ctx, mg.Filter)
+				out.FilterComplexity = oidc.FilterComplexity(mg.Filter)
+			case strings.EqualFold(f, "Disabled"):
+				out.Disabled = mg.Disabled
+			case strings.EqualFold(f, "MatchCaseInsensitive"):
+				out.MatchCaseInsensitive = mg.MatchCaseInsensitive
+			case strings.EqualFold(f, "Frozen"):
+				out.Frozen = mg.Frozen
+			case strings.EqualFold(f, "OwnerId"):
+				out.OwnerId = mg.OwnerId
+			}
+		}
+		out.Version++
+		return out, nil
+	case *ldap.ManagedGroup:
+		mg, dbMask := ldap.AllocManagedGroup(), []string(nil)
+		if !tagsOnly {
+			var err error
+			if mg, dbMask, err = ldapUpdate(ctx, req.GetId(), mask, req.GetItem()); err != nil {
+				return nil, err
+			}
+		}
+		out := &ldap.ManagedGroup{ManagedGroup: proto.Clone(orig.ManagedGroup).(*ldapstore.ManagedGroup)}
+		for _, f := range dbMask {
+			switch {
+			case strings.EqualFold(f, "Name"):
+				out.Name = mg.Name
+			case strings.EqualFold(f, "Description"):
+				out.Description = mg.Description
+			case strings.EqualFold(f, "GroupNames"):
+				out.GroupNames = mg.GroupNames
+			case strings.EqualFold(f, "OwnerId"):
+				out.OwnerId = mg.OwnerId
+			}
+		}
+		out.Version++
+		return out, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unrecognized managed group subtype for %q", req.GetId()))
+	}
+}
+
+// fieldChanges returns the value of each masked field of the managed group
+// before and after an update, as they are returned to the caller. Fields the
+// caller isn't allowed to see are left out.
+func fieldChanges(before, after *pb.ManagedGroup, outputFields *perms.OutputFields, mask []string) ([]*pbs.ManagedGroupFieldChange, error) {
+	beforeFields, err := filterableStruct(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := filterableStruct(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*pbs.ManagedGroupFieldChange, 0, len(mask))
+	for _, path := range mask {
+		top, _, _ := strings.Cut(path, ".")
+		switch {
+		case path == attrFilterField:
+			// The filter is hidden from callers allowed the other
+			// attributes unless they are allowed it too.
+			if !outputFields.Has(attrFilterField) {
+				continue
+			}
+		case !outputFields.Has(top):
+			continue
+		}
+		changes = append(changes, &pbs.ManagedGroupFieldChange{
+			Field:  path,
+			Before: fieldValue(beforeFields, path),
+			After:  fieldValue(afterFields, path),
+		})
+	}
+	return changes, nil
+}
+
+// filterableStruct returns the managed group as a struct whose attributes are
+// held in the "attributes" field, as they are in the API.
+func filterableStruct(mg *pb.ManagedGroup) (*structpb.Struct, error) {
+	f, err := subtypes.Filterable(mg)
+	if err != nil {
+		return nil, err
+	}
+	if st, ok := f.(*structpb.Struct); ok {
+		return st, nil
+	}
+	// The managed group has no attributes so it wasn't converted.
+	return handlers.ProtoToStruct(f)
+}
+
+// fieldValue returns the value at the dotted path in st, or a null value if
+// there is none.
+func fieldValue(st *structpb.Struct, path string) *structpb.Value {
+	v := structpb.NewStructValue(st)
+	for _, name := range strings.Split(path, ".") {
+		next, ok := v.GetStructValue().GetFields()[name]
+		if !ok {
+			return structpb.NewNullValue()
+		}
+		v = next
+	}
+	return v
+}
+
+// touchInRepo increments the version of the managed group with the provided
+// id, which must be at version, without changing its fields. If setTags is
+// set, its tags are replaced with tags in the same transaction.
+func (s Service) touchInRepo(ctx context.Context, scopeId, id, userId string, version uint32, setTags bool, tags map[string]string) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).touchInRepo"
+	var out auth.ManagedGroup
+	var rows int
+	var err error
+	switch subtypes.SubtypeFromId(domain, id) {
+	case oidc.Subtype:
+		repo, iErr := s.oidcRepoFn()
+		if iErr != nil {
+			return nil, repoError(ctx, op, iErr)
+		}
+		var mg *oidc.ManagedGroup
+		opts := []oidc.Option{oidc.WithActorId(userId)}
+		if setTags {
+			opts = append(opts, oidc.WithTags(tags))
+		}
+		mg, rows, err = repo.TouchManagedGroup(ctx, scopeId, id, version, opts...)
+		out = mg
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return nil, repoError(ctx, op, iErr)
+		}
+		var mg *ldap.ManagedGroup
+		var opts []ldap.Option
+		if setTags {
+			opts = append(opts, ldap.WithTags(ctx, tags))
+		}
+		mg, rows, err = repo.TouchManagedGroup(ctx, scopeId, id, version, opts...)
+		out = mg
+	}
+	if err != nil {
+		return nil, repoError(ctx, op, err, errors.WithMsg("unable to touch managed group"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+// updateTagsOnlyInRepo replaces the tags of the managed group with the
+// provided id, which must be at version, with tags and increments its version
+// in the same transaction, and returns the managed group as it then is.
+func (s Service) updateTagsOnlyInRepo(ctx context.Context, scopeId, id, userId string, version uint32, tags map[string]string) (auth.ManagedGroup, error) {
+	touched, err := s.touchInRepo(ctx, scopeId, id, userId, version, true, tags)
+	if err != nil {
+		return nil, err
+	}
+	mg, err := s.lookupFromRepo(ctx, touched.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	if mg == nil {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist.", id)
+	}
+	return mg, nil
+}
+
+// splitMaskPaths returns the update mask paths with the paths which hold
+// several comma separated paths split into them, as the mask manager splits
+// them.
+func splitMaskPaths(paths []string) []string {
+	out := make([]string, 0, len(paths))
+	for _, p := range paths {
+		for _, v := range strings.Split(p, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				out = append(out, v)
+			}
+		}
+	}
+	return out
+}
+
+// withoutPath returns paths without path.
+func withoutPath(paths []string, path string) []string {
+	out := make([]string, 0, len(paths))
+	for _, p := range splitMaskPaths(paths) {
+		if p != path {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string, force bool, version uint32) (bool, error) {
+	const op = "managed_groups.(Service).deleteFromRepo"
+	var rows int
+	var err error
+	switch subtypes.SubtypeFromId(domain, id) {
+	case oidc.Subtype:
+		repo, iErr := s.oidcRepoFn()
+		if iErr != nil {
+			return false, repoError(ctx, op, iErr)
+		}
+		var opts []oidc.Option
+		if force {
+			opts = append(opts, oidc.WithForce())
+		}
+		if version > 0 {
+			opts = append(opts, oidc.WithVersion(version))
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id, opts...)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, repoError(ctx, op, iErr)
+		}
+		var opts []ldap.Option
+		if force {
+			opts = append(opts, ldap.WithForce(ctx))
+		}
+		if version > 0 {
+			opts = append(opts, ldap.WithVersion(ctx, version))
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id, opts...)
+	}
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, repoError(ctx, op, err)
+	}
+	return rows > 0, nil
+}
+
+// listOptions narrows the managed groups listFromRepo returns, and holds what
+// they're listed with. The zero value returns all of them.
+type listOptions struct {
+	// roleAssociation keeps only the managed groups which are a principal in
+	// some role the caller can read, as given by readableRoleIds, or in none.
+	roleAssociation string
+	readableRoleIds []string
+	// roleCounts holds the number of roles the caller can read each managed
+	// group is a principal in.
+	roleCounts map[string]int
+	// tagFilter keeps only the managed groups with all of its tags.
+	tagFilter map[string]string
+	// tags holds the tags of each managed group.
+	tags map[string]map[string]string
+	// noMembers keeps only the managed groups without members.
+	noMembers bool
+	// kind keeps only the managed groups of that kind.
+	kind string
+	// ownerId keeps only the managed groups it owns.
+	ownerId string
+}
+
+// oidcOptions returns the repository options restricting the OIDC managed
+// groups listed or counted to the ones matching o.
+func (o listOptions) oidcOptions() []oidc.Option {
+	opts := []oidc.Option{
+		oidc.WithKind(o.kind),
+		oidc.WithOwnerId(o.ownerId),
+		oidc.WithTagFilter(o.tagFilter),
+		oidc.WithNoMembers(o.noMembers),
+	}
+	switch o.roleAssociation {
+	case roleAssociationAny:
+		opts = append(opts, oidc.WithPrincipalInRoles(o.readableRoleIds))
+	case roleAssociationNone:
+		opts = append(opts, oidc.WithPrincipalInNoRoles(o.readableRoleIds))
+	}
+	return opts
+}
+
+// ldapOptions returns the repository options restricting the LDAP managed
+// groups listed or counted to the ones matching o.
+func (o listOptions) ldapOptions(ctx context.Context) []ldap.Option {
+	opts := []ldap.Option{
+		ldap.WithKind(ctx, o.kind),
+		ldap.WithOwnerId(ctx, o.ownerId),
+		ldap.WithTagFilter(ctx, o.tagFilter),
+		ldap.WithNoMembers(ctx, o.noMembers),
+	}
+	switch o.roleAssociation {
+	case roleAssociationAny:
+		opts = append(opts, ldap.WithPrincipalInRoles(ctx, o.readableRoleIds))
+	case roleAssociationNone:
+		opts = append(opts, ldap.WithPrincipalInNoRoles(ctx, o.readableRoleIds))
+	}
+	return opts
+}
+
+// listOptionsRequest holds what ListManagedGroups and StreamManagedGroups
+// requests share about which managed groups are listed.
+type listOptionsRequest interface {
+	GetAuthMethodId() string
+	GetRoleAssociation() string
+	GetIncludeRoleCount() bool
+	GetTags() []string
+	GetNoMembers() bool
+	GetKind() string
+	GetOwnerId() string
+}
+
+// listOptionsFromRepo returns the list options of req, along with the tags of
+// the listed auth method and the roles the caller can read which they need.
+// The role counts are also looked up when req includes them in its items, and
+// only count the roles the caller can read.
+func (s Service) listOptionsFromRepo(ctx context.Context, authResults requestauth.VerifyResults, req listOptionsRequest) (listOptions, error) {
+	opts := listOptions{
+		roleAssociation: req.GetRoleAssociation(),
+		noMembers:       req.GetNoMembers(),
+		kind:            req.GetKind(),
+		ownerId:         req.GetOwnerId(),
+	}
+	var err error
+	if opts.roleAssociation != "" || req.GetIncludeRoleCount() {
+		if opts.roleCounts, opts.readableRoleIds, err = s.roleCountsFromRepo(ctx, authResults, req.GetAuthMethodId()); err != nil {
+			return listOptions{}, err
+		}
+	}
+	if opts.tags, err = s.listTagsFromRepo(ctx, req.GetAuthMethodId()); err != nil {
+		return listOptions{}, err
+	}
+	// The request has been validated, so the tags parse.
+	opts.tagFilter, _ = parseTagFilter(req.GetTags())
+	return opts, nil
+}
+
+// listFromRepo returns the managed groups in the auth method which match opts,
+// ordered by public id, so the order doesn't depend on the subtype's
+// repository. The managed groups are matched against opts by the repository's
+// query.
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, opts listOptions) ([]auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).listFromRepo"
+
+	var outUl []auth.ManagedGroup
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		oidcRepo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		oidcl, err := oidcRepo.ListManagedGroups(ctx, authMethodId, append(opts.oidcOptions(), oidc.WithLimit(-1))...)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, a := range oidcl {
+			outUl = append(outUl, a)
+		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		oidcl, err := ldapRepo.ListManagedGroups(ctx, authMethodId, append(opts.ldapOptions(ctx), ldap.WithLimit(ctx, -1))...)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, a := range oidcl {
+			outUl = append(outUl, a)
+		}
+	}
+	sort.Slice(outUl, func(i, j int) bool {
+		return outUl[i].GetPublicId() < outUl[j].GetPublicId()
+	})
+	return outUl, nil
+}
+
+// memberAuthMethodIdFromRepo returns the id of the auth method of the account
+// with the provided id.
+func (s Service) memberAuthMethodIdFromRepo(ctx context.Context, accountId string) (string, error) {
+	const op = "managed_groups.(Service).memberAuthMethodIdFromRepo"
+	var acct auth.Account
+	switch subtypes.SubtypeFromId(domain, accountId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return "", repoError(ctx, op, err)
+		}
+		a, err := repo.LookupAccount(ctx, accountId)
+		if err != nil {
+			return "", repoError(ctx, op, err)
+		}
+		if a != nil {
+			acct = a
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return "", repoError(ctx, op, err)
+		}
+		a, err := repo.LookupAccount(ctx, accountId)
+		if err != nil {
+			return "", repoError(ctx, op, err)
+		}
+		if a != nil {
+			acct = a
+		}
+	default:
+		return "", errors.New(ctx, errors.InvalidPublicId, op, "unrecognized account subtype")
+	}
+	if acct == nil {
+		return "", handlers.NotFoundError()
+	}
+	return acct.GetAuthMethodId(), nil
+}
+
+// listByMemberFromRepo lists the managed groups the account with the provided
+// id is a member of, ordered by id.
+func (s Service) listByMemberFromRepo(ctx context.Context, accountId string) ([]auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).listByMemberFromRepo"
+	var outUl []auth.ManagedGroup
+	switch subtypes.SubtypeFromId(domain, accountId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mgs, err := repo.ListManagedGroupsByMember(ctx, accountId, oidc.WithLimit(-1))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, mg := range mgs {
+			outUl = append(outUl, mg)
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mgs, err := repo.ListManagedGroupsByMember(ctx, accountId, ldap.WithLimit(ctx, -1))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		for _, mg := range mgs {
+			outUl = append(outUl, mg)
+		}
+	}
+	return outUl, nil
+}
+
+// sendMembershipsByAuthMethod sends the members of each managed group of the
+// auth method with send, one managed group at a time ordered by id. The
+// memberships are read membershipReportPageSize at a time, so only the
+// members of the managed group being sent are held at once.
+func (s Service) sendMembershipsByAuthMethod(ctx context.Context, authMethodId string, send func(*pbs.GenerateManagedGroupMembershipReportResponse) error) error {
+	const op = "managed_groups.(Service).sendMembershipsByAuthMethod"
+	var current *pbs.GenerateManagedGroupMembershipReportResponse
+	add := func(managedGroupId, memberId string, createTime *timestamp.Timestamp, source string) error {
+		if current != nil && current.GetManagedGroupId() != managedGroupId {
+			if err := send(current); err != nil {
+				return err
+			}
+			current = nil
+		}
+		if current == nil {
+			current = &pbs.GenerateManagedGroupMembershipReportResponse{ManagedGroupId: managedGroupId}
+		}
+		current.Members = append(current.Members, &pbs.ManagedGroupMembership{
+			AccountId:   memberId,
+			CreatedTime: createTime.GetTimestamp(),
+			Source:      source,
+		})
+		return nil
+	}
+	var afterGroupId, afterMemberId string
+	for {
+		if err := ctx.Err(); err != nil {
+			return requestContextError(err)
+		}
+		var read int
+		switch subtypes.SubtypeFromId(domain, authMethodId) {
+		case oidc.Subtype:
+			repo, err := s.oidcRepoFn()
+			if err != nil {
+				return repoError(ctx, op, err)
+			}
+			opts := []oidc.Option{oidc.WithLimit(membershipReportPageSize)}
+			if afterGroupId != "" {
+				opts = append(opts, oidc.WithAfterMembership(afterGroupId, afterMemberId))
+			}
+			ms, err := repo.ListManagedGroupMembershipsByAuthMethod(ctx, authMethodId, opts...)
+			if err != nil {
+				return repoError(ctx, op, err)
+			}
+			for _, m := range ms {
+				if err := add(m.GetManagedGroupId(), m.GetMemberId(), m.GetCreateTime(), m.GetSource()); err != nil {
+					return err
+				}
+				afterGroupId, afterMemberId = m.GetManagedGroupId(), m.GetMemberId()
+			}
+			read = len(ms)
+		case ldap.Subtype:
+			repo, err := s.ldapRepoFn()
+			if err != nil {
+				return repoError(ctx, op, err)
+			}
+			opts := []ldap.Option{ldap.WithLimit(ctx, membershipReportPageSize)}
+			if afterGroupId != "" {
+				opts = append(opts, ldap.WithAfterMembership(ctx, afterGroupId, afterMemberId))
+			}
+			ms, err := repo.ListManagedGroupMembershipsByAuthMethod(ctx, authMethodId, opts...)
+			if err != nil {
+				return repoError(ctx, op, err)
+			}
+			for _, m := range ms {
+				// LDAP memberships are always found from the groups of the
+				// account when it logs in.
+				if err := add(m.GetManagedGroupId(), m.GetMemberId(), m.GetCreateTime(), auth.MembershipSourceLogin); err != nil {
+					return err
+				}
+				afterGroupId, afterMemberId = m.GetManagedGroupId(), m.GetMemberId()
+			}
+			read = len(ms)
+		}
+		if read < membershipReportPageSize {
+			break
+		}
+	}
+	if current != nil {
+		return send(current)
+	}
+	return nil
+}
+
+// reserveQuota checks that the auth method can hold another managed group. On
+// success the creation of managed groups in the auth method is serialized
+// until the returned func is called, which must be once the managed group is
+// created.
+func (s Service) reserveQuota(ctx context.Context, authMethodId string) (func(), error) {
+	const op = "managed_groups.(Service).reserveQuota"
+	if s.quota == nil || s.quota.unlimited() {
+		return func() {}, nil
+	}
+	release := s.quota.lock(authMethodId)
+	count, err := s.countFromRepo(ctx, authMethodId, listOptions{})
+	if err != nil {
+		release()
+		return nil, repoError(ctx, op, err)
+	}
+	if err := s.quota.check(authMethodId, count); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// countFromRepo returns the number of managed groups in the auth method which
+// match opts, without loading them.
+func (s Service) countFromRepo(ctx context.Context, authMethodId string, opts listOptions) (int, error) {
+	const op = "managed_groups.(Service).countFromRepo"
+	var count int
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return 0, repoError(ctx, op, err)
+		}
+		count, err = repo.CountManagedGroups(ctx, authMethodId, opts.oidcOptions()...)
+		if err != nil {
+			return 0, repoError(ctx, op, err)
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return 0, repoError(ctx, op, err)
+		}
+		count, err = repo.CountManagedGroups(ctx, authMethodId, opts.ldapOptions(ctx)...)
+		if err != nil {
+			return 0, repoError(ctx, op, err)
+		}
+	}
+	return count, nil
+}
+
+// memberCountsFromRepo returns the number of member accounts of each managed
+// group in the auth method, keyed by the managed group's id.
+func (s Service) memberCountsFromRepo(ctx context.Context, authMethodId string) (map[string]int, error) {
+	const op = "managed_groups.(Service).memberCountsFromRepo"
+	var counts map[string]int
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		counts, err = repo.CountManagedGroupMembers(ctx, authMethodId)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		counts, err = repo.CountManagedGroupMembers(ctx, authMethodId)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	}
+	return counts, nil
+}
+
+// roleCountsFromRepo returns the number of roles the caller can read which
+// each managed group in the auth method is a principal in, keyed by the
+// managed group's id, along with the ids of those roles. Managed groups which
+// aren't a principal in any such role are left out, so they're listed as in no
+// role. The role ids are never nil.
+func (s Service) roleCountsFromRepo(ctx context.Context, authResults requestauth.VerifyResults, authMethodId string) (map[string]int, []string, error) {
+	const op = "managed_groups.(Service).roleCountsFromRepo"
+	repo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, nil, repoError(ctx, op, err)
+	}
+	roles, err := repo.ListManagedGroupRoles(ctx, authMethodId)
+	if err != nil {
+		return nil, nil, repoError(ctx, op, err)
+	}
+	// A role is often a principal of several managed groups, so whether it
+	// can be read is only checked once.
+	readable := make(map[string]bool)
+	counts := make(map[string]int, len(roles))
+	for id, mgRoles := range roles {
+		for _, role := range mgRoles {
+			ok, checked := readable[role.GetPublicId()]
+			if !checked {
+				ok = authResults.FetchActionSetForId(ctx, role.GetPublicId(), action.ActionSet{action.Read}, requestauth.WithResource(&perms.Resource{
+					ScopeId: role.GetScopeId(),
+					Type:    resource.Role,
+				})).HasAction(action.Read)
+				readable[role.GetPublicId()] = ok
+			}
+			if ok {
+				counts[id]++
+			}
+		}
+	}
+	roleIds := make([]string, 0, len(readable))
+	for id, ok := range readable {
+		if ok {
+			roleIds = append(roleIds, id)
+		}
+	}
+	sort.Strings(roleIds)
+	return counts, roleIds, nil
+}
+
+// tagsFromRepo returns the tags of the managed group with the provided id.
+func (s Service) tagsFromRepo(ctx context.Context, id string) (map[string]string, error) {
+	const op = "managed_groups.(Service).tagsFromRepo"
+	var tags map[string]string
+	switch subtypes.SubtypeFromId(domain, id) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		tags, err = repo.LookupManagedGroupTags(ctx, id)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		tags, err = repo.LookupManagedGroupTags(ctx, id)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	}
+	return tags, nil
+}
+
+// listTagsFromRepo returns the tags of each managed group in the auth method,
+// keyed by the managed group's id. Managed groups without tags are left out.
+func (s Service) listTagsFromRepo(ctx context.Context, authMethodId string) (map[string]map[string]string, error) {
+	const op = "managed_groups.(Service).listTagsFromRepo"
+	var tags map[string]map[string]string
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		tags, err = repo.ListManagedGroupTags(ctx, authMethodId)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		tags, err = repo.ListManagedGroupTags(ctx, authMethodId)
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+	}
+	return tags, nil
+}
+
+// lookupByNameFromRepo returns the managed group with the provided name in the
+// auth method, or nil if there is none. Names are matched exactly, as their
+// uniqueness is case-sensitive, unless ignoreCase is set.
+func (s Service) lookupByNameFromRepo(ctx context.Context, authMethodId, name string, ignoreCase bool) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).lookupByNameFromRepo"
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mg, err := repo.LookupManagedGroupByName(ctx, authMethodId, name, oidc.WithIgnoreNameCase(ignoreCase))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if mg == nil {
+			return nil, nil
+		}
+		return mg, nil
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mg, err := repo.LookupManagedGroupByName(ctx, authMethodId, name, ldap.WithIgnoreNameCase(ctx, ignoreCase))
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		if mg == nil {
+			return nil, nil
+		}
+		return mg, nil
+	}
+	return nil, errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
+}
+
+// lookupByIdPrefixFromRepo returns the OIDC managed group in the auth method
+// whose id starts with prefix, or nil if there is none. An invalid argument
+// error on the id field is returned if more than one managed group matches.
+func (s Service) lookupByIdPrefixFromRepo(ctx context.Context, authMethodId, prefix string) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).lookupByIdPrefixFromRepo"
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	mg, err := repo.LookupManagedGroupByIdPrefix(ctx, authMethodId, prefix)
+	switch {
+	case errors.Match(errors.T(errors.NotUnique), err):
+		return nil, invalidFieldError(globals.IdField, FieldErrorAmbiguous, "Matches more than one ManagedGroup in the auth method; provide more of the id.")
+	case err != nil:
+		return nil, repoError(ctx, op, err)
+	case mg == nil:
+		return nil, nil
+	}
+	return mg, nil
+}
+
+// lookupNotFoundError returns the error reported when a managed group looked
+// up by something other than its id doesn't exist, which hides whether it
+// exists from callers who may not learn that.
+func (s Service) lookupNotFoundError(ctx context.Context, format string, a ...any) error {
+	if requestauth.Anonymous(ctx) {
+		return handlers.UnauthenticatedError()
+	}
+	if s.hideUnauthorized {
+		return handlers.NotFoundError()
+	}
+	return handlers.NotFoundErrorf(format, a...)
+}
+
+// parentAndAuthResult authorizes the action on the resource with the provided
+// id, which is the auth method for collection actions and the managed group
+// otherwise. The auth method, and the managed group for non collection
+// actions, fetched while authorizing are returned so callers don't have to
+// look them up again.
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, auth.ManagedGroup, requestauth.VerifyResults) {
+	switch a {
+	case action.List, action.Create:
+		return s.authResult(ctx, id, nil, a)
+	}
+
+	grp, err := s.lookupFromRepo(ctx, id)
+	if err == nil && grp == nil {
+		err = handlers.NotFoundError()
+	}
+	if err != nil {
+		return nil, nil, requestauth.VerifyResults{Error: unauthenticatedError(ctx, err)}
+	}
+	return s.authResult(ctx, grp.GetAuthMethodId(), grp, a)
+}
+
+// lookupFromRepo returns the managed group with the provided id, or nil if
+// there is none.
+func (s Service) lookupFromRepo(ctx context.Context, id string) (auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).lookupFromRepo"
+	switch subtypes.SubtypeFromId(domain, id) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if mg == nil {
+			return nil, nil
+		}
+		return mg, nil
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if mg == nil {
+			return nil, nil
+		}
+		return mg, nil
+	}
+	return nil, errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
+}
+
+// lookupManyFromRepo returns the managed groups with the provided ids, which
+// may be of any subtype. Ids of managed groups which don't exist are skipped.
+func (s Service) lookupManyFromRepo(ctx context.Context, ids []string) ([]auth.ManagedGroup, error) {
+	const op = "managed_groups.(Service).lookupManyFromRepo"
+	var oidcIds, ldapIds []string
+	for _, id := range ids {
+		switch subtypes.SubtypeFromId(domain, id) {
+		case oidc.Subtype:
+			oidcIds = append(oidcIds, id)
+		case ldap.Subtype:
+			ldapIds = append(ldapIds, id)
+		default:
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
+		}
+	}
+	var mgs []auth.ManagedGroup
+	if len(oidcIds) > 0 {
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		ogs, err := repo.LookupManagedGroups(ctx, oidcIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, mg := range ogs {
+			mgs = append(mgs, mg)
+		}
+	}
+	if len(ldapIds) > 0 {
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		lgs, err := repo.LookupManagedGroups(ctx, ldapIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, mg := range lgs {
+			mgs = append(mgs, mg)
+		}
+	}
+	return mgs, nil
+}
+
+// lookupTemplateFromRepo returns the managed group template with the provided
+// id, or nil if there is none.
+func (s Service) lookupTemplateFromRepo(ctx context.Context, id string) (*oidc.ManagedGroupTemplate, error) {
+	const op = "managed_groups.(Service).lookupTemplateFromRepo"
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	tmpl, err := repo.LookupManagedGroupTemplate(ctx, id)
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	return tmpl, nil
+}
+
+// validSortField reports whether ListManagedGroups can order managed groups by
+// the field.
+func validSortField(field string) bool {
+	switch field {
+	case sortById, sortByName, sortByCreatedTime, sortByUpdatedTime, sortByMemberCount:
+		return true
+	}
+	return false
+}
+
+// resourceAuthError returns the error to report when authorizing an action on
+// a single managed group failed. When unauthorized managed groups are hidden,
+// being denied is reported the same way as the managed group not existing.
+func (s Service) resourceAuthError(err error) error {
+	if s.hideUnauthorized && errors.Is(err, handlers.ForbiddenError()) {
+		return handlers.NotFoundError()
+	}
+	return err
+}
+
+// unauthenticatedError returns the error to report in place of err, which
+// happened before the request could be authorized, e.g. while looking up the
+// resource to authorize it against. Requests without a token are rejected as
+// unauthenticated whatever went wrong, so they can't learn whether a resource
+// exists or how the controller failed to find it.
+func unauthenticatedError(ctx context.Context, err error) error {
+	if err != nil && requestauth.Anonymous(ctx) {
+		return handlers.UnauthenticatedError()
+	}
+	return err
+}
+
+// authResult authorizes the action in the auth method with the provided id.
+// For non collection actions grp is the already fetched managed group the
+// action is performed on, and it is returned along with the auth method.
+func (s Service) authResult(ctx context.Context, parentId string, grp auth.ManagedGroup, a action.Type) (auth.AuthMethod, auth.ManagedGroup, requestauth.VerifyResults) {
+	authMeth, err := s.authMethodFromRepo(ctx, parentId)
+	if err == nil && authMeth == nil {
+		err = missingAuthMethodError(parentId, grp)
+	}
+	if err != nil {
+		return nil, nil, requestauth.VerifyResults{Error: unauthenticatedError(ctx, err)}
+	}
+	opts := []requestauth.Option{
+		requestauth.WithType(resource.ManagedGroup),
+		requestauth.WithAction(a),
+		requestauth.WithScopeId(authMeth.GetScopeId()),
+		requestauth.WithPin(parentId),
+	}
+	if grp != nil {
+		opts = append(opts, requestauth.WithId(grp.GetPublicId()))
+	}
+	return authMeth, grp, requestauth.Verify(ctx, opts...)
+}
+
+// missingAuthMethodError returns the error for the auth method with the
+// provided id not being found. When grp, its managed group, was read first the
+// auth method existed then: deleting an auth method deletes its managed
+// groups, so it was deleted since. That is reported rather than the plain not
+// found returned for an id which doesn't exist.
+func missingAuthMethodError(authMethodId string, grp auth.ManagedGroup) error {
+	if grp == nil {
+		return handlers.NotFoundError()
+	}
+	return handlers.NotFoundErrorf("Auth method %q of managed group %q has been deleted.", authMethodId, grp.GetPublicId())
+}
+
+// authMethodsInScopesFromRepo returns the OIDC and LDAP auth methods in the
+// scopes with the provided ids, ordered by id.
+func (s Service) authMethodsInScopesFromRepo(ctx context.Context, scopeIds []string) ([]auth.AuthMethod, error) {
+	const op = "managed_groups.(Service).authMethodsInScopesFromRepo"
+	oidcRepo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	oidcl, err := oidcRepo.ListAuthMethods(ctx, scopeIds, oidc.WithLimit(-1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	ldapl, err := ldapRepo.ListAuthMethods(ctx, scopeIds, ldap.WithLimit(ctx, -1))
+	if err != nil {
+		return nil, repoError(ctx, op, err)
+	}
+	ams := make([]auth.AuthMethod, 0, len(oidcl)+len(ldapl))
+	for _, am := range oidcl {
+		ams = append(ams, am)
+	}
+	for _, am := range ldapl {
+		ams = append(ams, am)
+	}
+	sort.Slice(ams, func(i, j int) bool {
+		return ams[i].GetPublicId() < ams[j].GetPublicId()
+	})
+	return ams, nil
+}
+
+// authMethodFromRepo returns the auth method with the provided id, or nil if
+// there is none.
+func (s Service) authMethodFromRepo(ctx context.Context, id string) (auth.AuthMethod, error) {
+	const op = "managed_groups.(Service).authMethodFromRepo"
+	switch subtypes.SubtypeFromId(domain, id) {
+	case oidc.Subtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, nil
+		}
+		return am, nil
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, repoError(ctx, op, err)
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, nil
+		}
+		return am, nil
+	}
+	return nil, errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
+}
+
+// toProto converts in to its API representation. Subtype attributes are set as
+// typed messages rather than a structpb.Struct, so their fields are always
+// serialized in proto field order, and LDAP group names keep their stored
+// order. When attributes are converted to the generic form for a response
+// their JSON keys are emitted sorted, so identical managed groups always
+// produce identical attribute output.
+//
+// Whenever the attributes output field, or a field nested in it such as
+// attributes.filter, is allowed the attributes are set, even if none of their
+// fields are, so a response always has an attributes
+// object, possibly empty, rather than omitting it. Without the type output
+// field they are set as the generic struct, since the response can't be
+// converted by type.
+func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option) (*pb.ManagedGroup, error) {
+	const op = "managed_groups.toProto"
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building managed group proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	// has reports whether the output field is allowed and records it as
+	// populated if it is.
+	var populated []string
+	has := func(field string) bool {
+		if !outputFields.Has(field) {
+			return false
+		}
+		populated = append(populated, field)
+		return true
+	}
+
+	out := pb.ManagedGroup{}
+	if has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if has(globals.AuthMethodIdField) {
+		out.AuthMethodId = in.GetAuthMethodId()
+	}
+	if has(globals.AuthMethodTypeField) {
+		// The auth method type is encoded in its id so no lookup is needed.
+		out.AuthMethodType = subtypes.SubtypeFromId(domain, in.GetAuthMethodId()).String()
+	}
+	if has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if has(globals.NameField) && in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	// Timestamps hold no offset, so they are rendered in UTC whatever the
+	// time zone they were read in.
+	if has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	if has(globals.MemberIdsField) {
+		out.MemberIds = opts.WithMemberIds
+	}
+	if opts.WithAuthMethod != nil && has(globals.AuthMethodField) {
+		out.AuthMethod = opts.WithAuthMethod
+	}
+	if opts.WithRoleCount != nil && has(globals.RoleCountField) {
+		out.RoleCount = wrapperspb.UInt32(*opts.WithRoleCount)
+	}
+	if len(opts.WithTags) > 0 && has(globals.TagsField) {
+		out.Tags = opts.WithTags
+	}
+	if has(globals.KindField) {
+		out.Kind = in.GetKind()
+	}
+	if has(globals.OwnerIdField) && in.GetOwnerId() != "" {
+		out.OwnerId = &wrapperspb.StringValue{Value: in.GetOwnerId()}
+	}
+	if opts.WithUri != "" && has(globals.UriField) {
+		out.Uri = opts.WithUri
+	}
+	// typedAttrs are the subtype attributes, if requested.
+	var typedAttrs proto.Message
+	switch i := in.(type) {
+	case *oidc.ManagedGroup:
+		if has(globals.TypeField) {
+			out.Type = oidc.Subtype.String()
+		}
+		if has(globals.FieldUpdatedTimesField) {
+			out.FieldUpdatedTimes = &pb.FieldUpdatedTimes{
+				Name:        i.GetNameUpdateTime().GetTimestamp(),
+				Description: i.GetDescriptionUpdateTime().GetTimestamp(),
+				Filter:      i.GetFilterUpdateTime().GetTimestamp(),
+			}
+		}
+		// The filter is an output field of its own, allowed only by the
+		// attributes.filter output field, so grants can show the attributes
+		// of a managed group without its matching rule or the matching rule
+		// without the other attributes.
+		withAttrs := outputFields.Has(globals.AttributesField)
+		if !withAttrs && !outputFields.HasNested(globals.AttributesField) {
+			break
+		}
+		populated = append(populated, globals.AttributesField)
+		attrs := &pb.OidcManagedGroupAttributes{}
+		if outputFields.Has(attrFilterField) {
+			attrs.Filter = i.GetFilter()
+		}
+		if withAttrs {
+			attrs.Disabled = i.GetDisabled()
+			attrs.FilterVersion = i.GetFilterVersion()
+			attrs.Frozen = i.GetFrozen()
+			attrs.FilterSyntaxVersion = i.GetFilterSyntaxVersion()
+			attrs.FilterHasWarnings = i.GetFilterHasWarnings()
+			attrs.FilterComplexity = i.GetFilterComplexity()
+			if i.GetMatchCaseInsensitive() {
+				attrs.MatchOptions = &pb.OidcManagedGroupMatchOptions{CaseInsensitive: true}
+			}
+		}
+		typedAttrs = attrs
+		out.Attrs = &pb.ManagedGroup_OidcManagedGroupAttributes{
+			OidcManagedGroupAttributes: attrs,
+		}
+	case *ldap.ManagedGroup:
+		if has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !has(globals.AttributesField) {
+			break
+		}
+
+		var grpNames []string
+		if err := json.Unmarshal([]byte(i.GetGroupNames()), &grpNames); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal group names")
+		}
+
+		attrs := &pb.LdapManagedGroupAttributes{
+			GroupNames: grpNames,
+		}
+		typedAttrs = attrs
+		out.Attrs = &pb.ManagedGroup_LdapManagedGroupAttributes{
+			LdapManagedGroupAttributes: attrs,
+		}
+	default:
+		// Emitting the common fields without the type and attributes would
+		// hide that a new subtype isn't handled here.
+		return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown managed group type %T", in))
+	}
+	switch {
+	case typedAttrs == nil:
+	case opts.WithAttributesJson:
+		js, err := attributesJson(typedAttrs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out.Attrs = nil
+		out.AttributesJson = js
+	case out.Type == "":
+		// The response interceptor converts typed attributes to the generic
+		// struct by the item's type, so without the type they are converted
+		// here instead of being left out of the response.
+		st, err := handlers.ProtoToStruct(typedAttrs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out.Attrs = &pb.ManagedGroup_Attributes{Attributes: st}
+	}
+	if opts.WithPopulatedFields {
+		out.PopulatedFields = populated
+	}
+	return &out, nil
+}
+
+// attributesJson returns the typed attributes encoded the way they are in the
+// attributes of a response, as a JSON object with its keys sorted.
+func attributesJson(attrs proto.Message) (string, error) {
+	st, err := handlers.ProtoToStruct(attrs)
+	if err != nil {
+		return "", err
+	}
+	js, err := json.Marshal(st.AsMap())
+	if err != nil {
+		return "", err
+	}
+	return string(js), nil
+}
+
+// toAuthMethodInfo returns the summary of the auth method included in a
+// managed group. Only the fields which are safe to show to anyone allowed to
+// read the managed group are copied; the auth method's configuration isn't.
+func toAuthMethodInfo(am auth.AuthMethod) *pbauthmethods.AuthMethodInfo {
+	return &pbauthmethods.AuthMethodInfo{
+		Id:   am.GetPublicId(),
+		Name: am.GetName(),
+		Type: subtypes.SubtypeFromId(domain, am.GetPublicId()).String(),
+	}
+}
+
+// restrictOutputFields returns the authorized output fields which were also
+// requested. Requested fields the caller isn't authorized to see are dropped
+// rather than reported. When no fields are requested, or "*" is, every
+// authorized field is returned.
+func restrictOutputFields(authorized *perms.OutputFields, requested []string) *perms.OutputFields {
+	if len(requested) == 0 {
+		return authorized
+	}
+	fields := make([]string, 0, len(requested))
+	for _, f := range requested {
+		if f == "*" {
+			return authorized
+		}
+		if authorized.Has(f) {
+			fields = append(fields, f)
+		}
+		if f == globals.AttributesField && authorized.Has(attrFilterField) {
+			// Requesting the attributes requests the raw filter too, if
+			// the caller is authorized to see it.
+			fields = append(fields, attrFilterField)
+		}
+	}
+	return new(perms.OutputFields).AddFields(fields)
+}
+
+// idOnlyProto returns the item listed for the managed group when only ids are
+// requested.
+func idOnlyProto(in auth.ManagedGroup, outputFields *perms.OutputFields) *pb.ManagedGroup {
+	out := &pb.ManagedGroup{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	return out
+}
+
+// toDefinition returns the portable form of the managed group which is used
+// in a ManagedGroupsDocument. The filter is left out unless outputFields has
+// it.
+func toDefinition(ctx context.Context, in auth.ManagedGroup, outputFields *perms.OutputFields) (*pbs.ManagedGroupDefinition, error) {
+	const op = "managed_groups.toDefinition"
+	out := &pbs.ManagedGroupDefinition{
+		Name:        in.GetName(),
+		Description: in.GetDescription(),
+	}
+	switch i := in.(type) {
+	case *oidc.ManagedGroup:
+		if outputFields.Has(attrFilterField) {
+			out.Filter = i.GetFilter()
+		}
+	case *ldap.ManagedGroup:
+		if err := json.Unmarshal([]byte(i.GetGroupNames()), &out.GroupNames); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal group names")
+		}
+	default:
+		return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown managed group type %T", in))
+	}
+	return out, nil
+}
+
+// fromDefinition returns the managed group to create in the auth method for a
+// ManagedGroupDefinition of an imported document.
+func fromDefinition(authMethodId string, def *pbs.ManagedGroupDefinition) *pb.ManagedGroup {
+	item := &pb.ManagedGroup{AuthMethodId: authMethodId}
+	if def.GetName() != "" {
+		item.Name = wrapperspb.String(def.GetName())
+	}
+	if def.GetDescription() != "" {
+		item.Description = wrapperspb.String(def.GetDescription())
+	}
+	switch subtypes.SubtypeFromId(domain, authMethodId) {
+	case oidc.Subtype:
+		item.Attrs = &pb.ManagedGroup_OidcManagedGroupAttributes{
+			OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: def.GetFilter()},
+		}
+	case ldap.Subtype:
+		item.Attrs = &pb.ManagedGroup_LdapManagedGroupAttributes{
+			LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{GroupNames: def.GetGroupNames()},
+		}
+	}
+	return item
+}
+
+// templateToProto returns the API representation of a managed group template.
+// As for managed groups, the filter is left out unless outputFields has the
+// attributes.filter field.
+func templateToProto(t *oidc.ManagedGroupTemplate, outputFields *perms.OutputFields) *pbs.ManagedGroupTemplate {
+	out := &pbs.ManagedGroupTemplate{
+		Id:          t.GetPublicId(),
+		ScopeId:     t.GetScopeId(),
+		Name:        t.GetName(),
+		Description: t.GetDescription(),
+		Version:     t.GetVersion(),
+		CreatedTime: t.GetCreateTime().GetTimestamp(),
+		UpdatedTime: t.GetUpdateTime().GetTimestamp(),
+	}
+	if outputFields.Has(attrFilterField) {
+		out.Filter = t.GetFilter()
+	}
+	return out
+}
+
+// fieldErrorsMessage returns the message of err for the result of a single
+// item of a bulk operation. The invalid fields of an invalid argument error
+// are flattened into it as by badFieldsMessage.
+func fieldErrorsMessage(err error) string {
+	apiErr := handlers.ToApiError(err)
+	fields := apiErr.GetDetails().GetRequestFields()
+	if len(fields) == 0 {
+		return apiErr.GetMessage()
+	}
+	badFields := make(map[string]string, len(fields))
+	for _, f := range fields {
+		badFields[f.GetName()] = f.GetDescription()
+	}
+	return badFieldsMessage(badFields)
+}
+
+// badFieldsMessage flattens the bad fields returned by a validator into a
+// single message, ordered by field name.
+func badFieldsMessage(badFields map[string]string) string {
+	fields := make([]string, 0, len(badFields))
+	for f := range badFields {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	msgs := make([]string, 0, len(fields))
+	for _, f := range fields {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", f, badFields[f]))
+	}
+	return strings.Join(msgs, " ")
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(ctx context.Context, req *pbs.GetManagedGroupRequest) error {
+	const op = "managed_groups.validateGetRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	if req.GetId() != "" && req.GetAuthMethodId() != "" {
+		// The id is looked up as a prefix of the id of an OIDC managed group
+		// in the auth method.
+		badFields := invalidFields{}
+		if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+			badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods support looking up an id prefix.")
+		}
+		if req.GetId() == globals.OidcManagedGroupPrefix+"_" || !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix) {
+			badFields.add(globals.IdField, FieldErrorInvalidId, fmt.Sprintf("Invalid formatted identifier. Must be %s_ followed by at least the start of the id.", globals.OidcManagedGroupPrefix))
+		}
+		if req.GetName() != "" {
+			badFields.add(globals.NameField, FieldErrorConflictingFields, "Cannot be combined with id.")
+		}
+		if len(badFields) > 0 {
+			return badFields.err()
+		}
+		return nil
+	}
+	if req.GetId() == "" && (req.GetAuthMethodId() != "" || req.GetName() != "") {
+		badFields := invalidFields{}
+		if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+			badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+		}
+		if req.GetName() == "" {
+			badFields.add(globals.NameField, FieldErrorRequired, "This field is required when looking up by auth method.")
+		}
+		if len(badFields) > 0 {
+			return badFields.err()
+		}
+		return nil
+	}
+	badFields := invalidFields{}
+	err := handlers.ValidateGetRequest(func() map[string]string {
+		if req.GetName() != "" {
+			badFields.add(globals.NameField, FieldErrorConflictingFields, "Cannot be combined with id.")
+		}
+		return badFields.descriptions()
+	}, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return withFieldErrorCodes(err, badFields, getRequestFieldCodes)
+}
+
+func validateBatchGetRequest(ctx context.Context, req *pbs.BatchGetManagedGroupsRequest) error {
+	const op = "managed_groups.validateBatchGetRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	switch {
+	case len(req.GetIds()) == 0:
+		badFields.add(globals.IdsField, FieldErrorRequired, "This field is required.")
+	case len(req.GetIds()) > maxBatchGetIds:
+		badFields.add(globals.IdsField, FieldErrorInvalidValue, fmt.Sprintf("Must contain at most %d managed group ids.", maxBatchGetIds))
+	default:
+		seen := make(map[string]bool, len(req.GetIds()))
+		for _, id := range req.GetIds() {
+			if !handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix) {
+				badFields.add(globals.IdsField, FieldErrorInvalidId, fmt.Sprintf("Invalid formatted identifier %q.", id))
+				break
+			}
+			if seen[id] {
+				badFields.add(globals.IdsField, FieldErrorInvalidValue, fmt.Sprintf("Managed group id %q is provided more than once.", id))
+				break
+			}
+			seen[id] = true
+		}
+	}
+	return badFields.err()
+}
+
+// validateCreateRequest checks a request to create a managed group. When
+// rejectMatchAll is set the filter of an OIDC managed group must not appear to
+// match every authenticated user, unless the request allows it.
+func validateCreateRequest(ctx context.Context, req *pbs.CreateManagedGroupRequest, rejectMatchAll bool) error {
+	const op = "managed_groups.validateCreateRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	var badFields invalidFields
+	err := handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields = validateCreateItem(req.GetItem())
+		if _, invalid := badFields[attrFilterField]; !invalid && subtypes.SubtypeFromId(domain, req.GetItem().GetAuthMethodId()) == oidc.Subtype {
+			if msg := matchAllFilterError(rejectMatchAll, req.GetAllowMatchAll(), req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); msg != "" {
+				badFields.add(attrFilterField, FieldErrorInvalidValue, msg)
+			}
+		}
+		if msg := tagsError(req.GetItem().GetTags()); msg != "" {
+			badFields.add(globals.TagsField, FieldErrorInvalidValue, msg)
+		}
+		switch initialMembers := req.GetInitialMembers(); {
+		case len(initialMembers) == 0:
+		case subtypes.SubtypeFromId(domain, req.GetItem().GetAuthMethodId()) != oidc.Subtype:
+			badFields.add(initialMembersField, FieldErrorInvalidValue, "Only supported for OIDC managed groups.")
+		case len(initialMembers) > maxInitialMembers:
+			badFields.add(initialMembersField, FieldErrorInvalidValue, fmt.Sprintf("Must contain at most %d account ids.", maxInitialMembers))
+		default:
+			seen := make(map[string]bool, len(initialMembers))
+			for _, id := range initialMembers {
+				if !handlers.ValidId(handlers.Id(id), globals.OidcAccountPrefix) {
+					badFields.add(initialMembersField, FieldErrorInvalidId, fmt.Sprintf("Invalid formatted identifier %q.", id))
+					break
+				}
+				if seen[id] {
+					badFields.add(initialMembersField, FieldErrorInvalidValue, fmt.Sprintf("Account id %q is provided more than once.", id))
+					break
+				}
+				seen[id] = true
+			}
+		}
+		if code, msg := roleIdsError(req.GetRoleIds()); msg != "" {
+			badFields.add(roleIdsField, code, msg)
+		}
+		if id := req.GetScopeId(); id != "" && id != scope.Global.String() && !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
+			badFields.add(globals.ScopeIdField, FieldErrorInvalidValue, "This field must be 'global' or a valid org scope id.")
+		}
+		return badFields.descriptions()
+	})
+	return withFieldErrorCodes(err, badFields, createRequestFieldCodes)
+}
+
+// filterWarnings returns the lint warnings for the filter of an OIDC managed
+// group. It is only called once the item has been validated, and warnings
+// never cause a request to fail.
+func filterWarnings(ctx context.Context, item *pb.ManagedGroup) []string {
+	if subtypes.SubtypeFromId(domain, item.GetAuthMethodId()) != oidc.Subtype {
+		return nil
+	}
+	warnings, err := oidc.LintManagedGroupFilter(ctx, item.GetOidcManagedGroupAttributes().GetFilter())
+	if err != nil {
+		return nil
+	}
+	return warnings
+}
+
+// matchAllFilterError returns why the filter of an OIDC managed group can't be
+// written, or "" if it can. When reject is set a filter which appears to match
+// every authenticated user is only written if the request allows it.
+func matchAllFilterError(reject, allow bool, filter string) string {
+	if !reject || allow || !oidc.MatchesAllSubjects(filter) {
+		return ""
+	}
+	return "Appears to match every authenticated user. Set allow_match_all to write it anyway."
+}
+
+// createTypeError returns the field error code and why the type of the
+// managed group item being created is invalid, or "" if it's valid. A set type must be a managed group
+// subtype matching the subtype of the auth method. The type may only be left
+// unset when the auth method's id prefix names a subtype with managed groups,
+// so the type is derived from it unambiguously.
+func createTypeError(item *pb.ManagedGroup) (string, string) {
+	parent := subtypes.SubtypeFromId(domain, item.GetAuthMethodId())
+	derivable := parent == oidc.Subtype || parent == ldap.Subtype
+	switch typ := item.GetType(); {
+	case typ == "" && !derivable:
+		return FieldErrorRequired, "This field is required when it can't be derived from the auth method id."
+	case typ == "":
+		return "", ""
+	case typ != oidc.Subtype.String() && typ != ldap.Subtype.String():
+		return FieldErrorInvalidValue, fmt.Sprintf("Unknown type, must be %q or %q.", ldap.Subtype, oidc.Subtype)
+	case derivable && typ != parent.String():
+		return FieldErrorTypeMismatch, "Doesn't match the parent resource's type."
+	}
+	return "", ""
 }
 
-func (s Service) getFromRepo(ctx context.Context, id string) (auth.ManagedGroup, []string, error) {
-	var out auth.ManagedGroup
-	var memberIds []string
-	switch subtypes.SubtypeFromId(domain, id) {
-	case oidc.Subtype:
-		repo, err := s.oidcRepoFn()
-		if err != nil {
-			return nil, nil, err
+// validateCreateItem checks the fields of a managed group which is about to be
+// created that depend on the subtype of its auth method.
+func validateCreateItem(item *pb.ManagedGroup) invalidFields {
+	badFields := invalidFields{}
+	if item.GetAuthMethodId() == "" {
+		badFields.add(globals.AuthMethodIdField, FieldErrorRequired, "This field is required.")
+	}
+	if code, msg := createTypeError(item); msg != "" {
+		badFields.add(globals.TypeField, code, msg)
+	}
+	if msg := kindError(item.GetKind()); msg != "" {
+		badFields.add(globals.KindField, FieldErrorInvalidValue, msg)
+	}
+	if item.GetOwnerId() != nil {
+		if code, msg := ownerIdError(item.GetOwnerId().GetValue()); msg != "" {
+			badFields.add(globals.OwnerIdField, code, msg)
 		}
-		mg, err := repo.LookupManagedGroup(ctx, id)
-		if err != nil {
-			if errors.IsNotFoundError(err) {
-				return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+	}
+	switch subtypes.SubtypeFromId(domain, item.GetAuthMethodId()) {
+	case oidc.Subtype:
+		attrs := item.GetOidcManagedGroupAttributes()
+		if attrs == nil {
+			code, msg := missingAttrsError(item, "Attribute fields is required.")
+			badFields.add(globals.AttributesField, code, msg)
+		} else {
+			if attrs.GetFilterVersion() != 0 {
+				badFields.add(attrFilterVersionField, FieldErrorReadOnly, "This is a read only field.")
 			}
-			return nil, nil, err
-		}
-		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
-		if err != nil {
-			return nil, nil, err
-		}
-		if len(ids) > 0 {
-			memberIds = make([]string, len(ids))
-			for i, v := range ids {
-				memberIds[i] = v.MemberId
+			if attrs.GetFilterSyntaxVersion() != "" {
+				badFields.add(attrFilterSyntaxVersionField, FieldErrorReadOnly, "This is a read only field.")
 			}
-		}
-		out = mg
-	case ldap.Subtype:
-		repo, err := s.ldapRepoFn()
-		if err != nil {
-			return nil, nil, err
-		}
-		mg, err := repo.LookupManagedGroup(ctx, id)
-		if err != nil {
-			if errors.IsNotFoundError(err) {
-				return nil, nil, handlers.NotFoundErrorf("LDAP ManagedGroup %q doesn't exist.", id)
+			if attrs.GetFilterHasWarnings() {
+				badFields.add(attrFilterHasWarningsField, FieldErrorReadOnly, "This is a read only field.")
+			}
+			if attrs.GetFilterComplexity() != 0 {
+				badFields.add(attrFilterComplexityField, FieldErrorReadOnly, "This is a read only field.")
+			}
+			if attrs.GetFrozen() {
+				badFields.add(attrFrozenField, FieldErrorReadOnly, "Cannot specify this field in a create request.")
+			}
+			if attrs.Filter == "" {
+				badFields.add(attrFilterField, FieldErrorRequired, "This field is required.")
+			} else {
+				if _, err := oidc.ManagedGroupFilterEvaluator(oidc.CompilableFilter(attrs.Filter)); err != nil {
+					badFields.add(attrFilterField, FieldErrorInvalidFilter, fmt.Sprintf("Error evaluating submitted filter expression: %v.", err))
+				} else if msg := matchOptionsError(attrs.Filter, attrs.GetMatchOptions().GetCaseInsensitive()); msg != "" {
+					badFields.add(attrMatchCaseInsensitiveField, FieldErrorInvalidValue, msg)
+				}
 			}
-			return nil, nil, err
-		}
-		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
-		if err != nil {
-			return nil, nil, err
 		}
-		if len(ids) > 0 {
-			memberIds = make([]string, len(ids))
-			for i, v := range ids {
-				memberIds[i] = v.MemberId
+	case ldap.Subtype:
+		attrs := item.GetLdapManagedGroupAttributes()
+		if attrs == nil {
+			code, msg := missingAttrsError(item, "Attribute fields is required.")
+			badFields.add(globals.AttributesField, code, msg)
+		} else {
+			if len(attrs.GroupNames) == 0 {
+				badFields.add(attrGroupNamesField, FieldErrorRequired, "This field is required.")
 			}
 		}
-		out = mg
 	default:
-		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Unknown auth method type from ID.")
 	}
-	return out, memberIds, nil
+	return badFields
 }
 
-func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*oidc.ManagedGroup, error) {
-	const op = "managed_groups.(Service).createOidcInRepo"
-	if item == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
-	}
-	var opts []oidc.Option
-	if item.GetName() != nil {
-		opts = append(opts, oidc.WithName(item.GetName().GetValue()))
+// kindError returns why kind isn't a kind of managed group, or "" if it is one
+// or is unset.
+func kindError(kind string) string {
+	switch kind {
+	case "", auth.ManagedGroupKindLogin, auth.ManagedGroupKindSynced:
+		return ""
 	}
-	if item.GetDescription() != nil {
-		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
+	return fmt.Sprintf("Unsupported kind, must be %q or %q.", auth.ManagedGroupKindLogin, auth.ManagedGroupKindSynced)
+}
+
+// maxOwnerIdLength is the longest owner id a managed group can have.
+const maxOwnerIdLength = 256
+
+// ownerIdError returns the field error code and why ownerId can't be the
+// owner of a managed group, or "" if it can. The owner is either the id of a user or a free-form principal,
+// so a principal which looks like a user id must be a well formed one. Whether
+// the user exists isn't checked, since the owner is only informational.
+func ownerIdError(ownerId string) (string, string) {
+	switch {
+	case ownerId == "":
+		return FieldErrorInvalidValue, "Cannot be empty; leave it unset instead."
+	case strings.TrimSpace(ownerId) != ownerId:
+		return FieldErrorInvalidValue, "Cannot have leading or trailing whitespace."
+	case len(ownerId) > maxOwnerIdLength:
+		return FieldErrorInvalidValue, fmt.Sprintf("Cannot be longer than %d characters.", maxOwnerIdLength)
+	case !handlers.ValidNameDescription(ownerId):
+		return FieldErrorInvalidValue, "Cannot contain non-printable characters."
+	case strings.HasPrefix(ownerId, globals.UserPrefix+"_") && !handlers.ValidId(handlers.Id(ownerId), globals.UserPrefix):
+		return FieldErrorInvalidId, "Improperly formatted user id."
 	}
-	attrs := item.GetOidcManagedGroupAttributes()
-	mg, err := oidc.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetFilter(), opts...)
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	return "", ""
+}
+
+// tagsError returns why the tags of a managed group are invalid, or "" if
+// they're valid. Keys can't contain "=", which separates them from the value
+// when listing managed groups with a tag.
+func tagsError(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
 	}
-	repo, err := s.oidcRepoFn()
-	if err != nil {
-		return nil, err
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch {
+		case k == "":
+			return "Tag keys cannot be empty."
+		case utf8.RuneCountInString(k) > maxTagKeyLength:
+			return fmt.Sprintf("Tag key %q is longer than %d characters.", k, maxTagKeyLength)
+		case strings.Contains(k, "="):
+			return fmt.Sprintf("Tag key %q cannot contain \"=\".", k)
+		case utf8.RuneCountInString(tags[k]) > maxTagValueLength:
+			return fmt.Sprintf("Value of tag %q is longer than %d characters.", k, maxTagValueLength)
+		}
 	}
+	return ""
+}
 
-	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+// parseTagFilter returns the tags listed managed groups must have, given as
+// "key=value", or why they are invalid.
+func parseTagFilter(tags []string) (map[string]string, string) {
+	if len(tags) == 0 {
+		return nil, ""
 	}
-	if out == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	want := make(map[string]string, len(tags))
+	for _, t := range tags {
+		k, v, ok := strings.Cut(t, "=")
+		switch _, seen := want[k]; {
+		case !ok || k == "":
+			return nil, fmt.Sprintf("Tag %q must be given as \"key=value\".", t)
+		case seen:
+			return nil, fmt.Sprintf("Tag key %q is given more than once.", k)
+		}
+		want[k] = v
 	}
-	return out, nil
+	return want, ""
 }
 
-func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
-	const op = "managed_groups.(Service).createLdapInRepo"
-	if item == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+// validateClaimAliasReferences returns an invalid argument error if the OIDC
+// managed group filter references a claim alias which isn't defined on its
+// auth method. Aliases can only be checked once the auth method is known, so
+// this can't be part of validating the request.
+func validateClaimAliasReferences(ctx context.Context, am auth.AuthMethod, filter string) error {
+	const op = "managed_groups.validateClaimAliasReferences"
+	oidcAm, ok := am.(*oidc.AuthMethod)
+	if !ok || len(oidc.ClaimAliasReferences(filter)) == 0 {
+		return nil
 	}
-	var opts []ldap.Option
-	if item.GetName() != nil {
-		opts = append(opts, ldap.WithName(ctx, item.GetName().GetValue()))
+	aliases, err := oidc.ParseClaimAliases(ctx, oidcAm.GetClaimAliases()...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
 	}
-	if item.GetDescription() != nil {
-		opts = append(opts, ldap.WithDescription(ctx, item.GetDescription().GetValue()))
+	if _, err := oidc.ExpandClaimAliases(ctx, filter, aliases); err != nil {
+		return invalidFieldError(attrFilterField, FieldErrorInvalidFilter, fmt.Sprintf("Error evaluating submitted filter expression: %s.", errors.Convert(err).Msg))
 	}
-	attrs := item.GetLdapManagedGroupAttributes()
-	mg, err := ldap.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetGroupNames(), opts...)
+	return nil
+}
+
+// validateClaimTypes returns an invalid argument error if the OIDC managed
+// group filter compares a claim in a way that never matches a claim of the
+// type its auth method declares it to be. Claims without a declared type
+// aren't checked, and like aliases, types are only known once the auth method
+// is, so this can't be part of validating the request.
+func validateClaimTypes(ctx context.Context, am auth.AuthMethod, filter string) error {
+	const op = "managed_groups.validateClaimTypes"
+	oidcAm, ok := am.(*oidc.AuthMethod)
+	if !ok || len(oidcAm.GetClaimTypes()) == 0 || filter == "" {
+		return nil
+	}
+	types, err := oidc.ParseClaimTypes(ctx, oidcAm.GetClaimTypes()...)
 	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+		return errors.Wrap(ctx, err, op)
 	}
-	repo, err := s.ldapRepoFn()
+	aliases, err := oidc.ParseClaimAliases(ctx, oidcAm.GetClaimAliases()...)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(ctx, err, op)
 	}
-
-	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
+	mismatches, err := oidc.ClaimTypeMismatches(ctx, filter, types, oidc.WithClaimAliases(aliases))
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+		return invalidFieldError(attrFilterField, FieldErrorInvalidFilter, fmt.Sprintf("Error evaluating submitted filter expression: %s.", errors.Convert(err).Msg))
 	}
-	if out == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	if len(mismatches) > 0 {
+		return invalidFieldError(attrFilterField, FieldErrorInvalidFilter, strings.Join(mismatches, " "))
 	}
-	return out, nil
+	return nil
 }
 
-func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (auth.ManagedGroup, error) {
-	const op = "managed_groups.(Service).createInRepo"
-	if item == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+// matchOptionsError returns why the valid OIDC managed group filter can't be
+// matched with the provided match options, or "" if it can.
+func matchOptionsError(filter string, caseInsensitive bool) string {
+	if !caseInsensitive {
+		return ""
 	}
-	var out auth.ManagedGroup
-	switch subtypes.SubtypeFromId(domain, am.GetPublicId()) {
-	case oidc.Subtype:
-		am, err := s.createOidcInRepo(ctx, am, item)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		if am == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	if _, err := oidc.ManagedGroupFilterEvaluator(oidc.CaseInsensitiveFilter(oidc.CompilableFilter(filter))); err != nil {
+		return fmt.Sprintf("The filter can't be matched case-insensitively: %v.", err)
+	}
+	return ""
+}
+
+// validateFilterUpdate returns an invalid argument error if the update sets
+// the filter of an OIDC managed group to one which references claim aliases
+// its auth method doesn't define, compares claims with the wrong type, or
+// can't be matched with the managed group's match options. When
+// rejectMatchAll is set the filter must also not appear to match every
+// authenticated user, unless the request allows it.
+func validateFilterUpdate(ctx context.Context, am auth.AuthMethod, grp auth.ManagedGroup, req *pbs.UpdateManagedGroupRequest, rejectMatchAll bool) error {
+	if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrFilterField) {
+		if msg := matchAllFilterError(rejectMatchAll, req.GetAllowMatchAll(), req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); msg != "" {
+			return invalidFieldError(attrFilterField, FieldErrorInvalidValue, msg)
 		}
-		out = am
-	case ldap.Subtype:
-		am, err := s.createLdapInRepo(ctx, am, item)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+		if err := validateClaimAliasReferences(ctx, am, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+			return err
 		}
-		if am == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ldap managed group but no error returned from repository.")
+		if err := validateClaimTypes(ctx, am, req.GetItem().GetOidcManagedGroupAttributes().GetFilter()); err != nil {
+			return err
 		}
-		out = am
 	}
-	return out, nil
+	return validateUpdatedMatchOptions(grp, req)
 }
 
-func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup) (*oidc.ManagedGroup, error) {
-	const op = "managed_groups.(Service).updateOidcInRepo"
-	if item == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
-	}
-	mg := oidc.AllocManagedGroup()
-	mg.PublicId = id
-	if item.GetName() != nil {
-		mg.Name = item.GetName().GetValue()
+// replacementError returns why a filter replacement failed, naming the
+// invalid fields of the replaced filter when there are any.
+func replacementError(err error) string {
+	apiErr := handlers.ToApiError(err)
+	fields := make(map[string]string, len(apiErr.GetDetails().GetRequestFields()))
+	for _, f := range apiErr.GetDetails().GetRequestFields() {
+		fields[f.GetName()] = f.GetDescription()
 	}
-	if item.GetDescription() != nil {
-		mg.Description = item.GetDescription().GetValue()
+	if len(fields) == 0 {
+		return apiErr.GetMessage()
 	}
-	// Set this regardless; it'll only take effect if the masks contain the value
-	mg.Filter = item.GetOidcManagedGroupAttributes().GetFilter()
-
-	version := item.GetVersion()
+	return badFieldsMessage(fields)
+}
 
-	dbMask := oidcMaskManager.Translate(mask)
-	if len(dbMask) == 0 {
-		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+// validateUpdatedMatchOptions returns an invalid argument error if the OIDC
+// managed group can't be matched with its match options once updated. Either
+// the filter or the options may come from the stored managed group, so this
+// can't be part of validating the request.
+func validateUpdatedMatchOptions(grp auth.ManagedGroup, req *pbs.UpdateManagedGroupRequest) error {
+	mg, ok := grp.(*oidc.ManagedGroup)
+	if !ok {
+		return nil
 	}
-	repo, err := s.oidcRepoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	mask := expandMatchOptionsPaths(req.GetUpdateMask().GetPaths())
+	attrs := req.GetItem().GetOidcManagedGroupAttributes()
+	filter, caseInsensitive := mg.GetFilter(), mg.GetMatchCaseInsensitive()
+	if handlers.MaskContains(mask, attrFilterField) {
+		filter = attrs.GetFilter()
 	}
-	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	if handlers.MaskContains(mask, attrMatchCaseInsensitiveField) {
+		caseInsensitive = attrs.GetMatchOptions().GetCaseInsensitive()
 	}
-	if rowsUpdated == 0 {
-		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	if msg := matchOptionsError(filter, caseInsensitive); msg != "" {
+		return invalidFieldError(attrMatchCaseInsensitiveField, FieldErrorInvalidValue, msg)
 	}
-	return out, nil
+	return nil
 }
 
-func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
-	const op = "managed_groups.(Service).updateLdapInRepo"
-	if item == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+// validateFrozenFilterUpdate returns a failed precondition error if the update
+// changes the filter of an OIDC managed group which is frozen, or frozen by
+// the same update, unless the request sets force.
+func validateFrozenFilterUpdate(grp auth.ManagedGroup, req *pbs.UpdateManagedGroupRequest) error {
+	mg, ok := grp.(*oidc.ManagedGroup)
+	if !ok || req.GetForce() {
+		return nil
 	}
-	mg := ldap.AllocManagedGroup()
-	mg.PublicId = id
-	if item.GetName() != nil {
-		mg.Name = item.GetName().GetValue()
+	mask := req.GetUpdateMask().GetPaths()
+	if !handlers.MaskContains(mask, attrFilterField) {
+		return nil
 	}
-	if item.GetDescription() != nil {
-		mg.Description = item.GetDescription().GetValue()
+	frozen := mg.GetFrozen()
+	if handlers.MaskContains(mask, attrFrozenField) {
+		frozen = req.GetItem().GetOidcManagedGroupAttributes().GetFrozen()
 	}
-	// Set this regardless; it'll only take effect if the masks contain the value
-	encodedGroupNames, err := json.Marshal(item.GetLdapManagedGroupAttributes().GetGroupNames())
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode group names"))
+	if frozen {
+		return handlers.ConflictErrorf("The managed group is frozen, so its filter can't be changed. Unfreeze it first, or set force to change the filter while leaving its members as they are.")
 	}
-	mg.GroupNames = string(encodedGroupNames)
+	return nil
+}
 
-	version := item.GetVersion()
+// isFrozen reports whether grp is an OIDC managed group which is frozen.
+func isFrozen(grp auth.ManagedGroup) bool {
+	mg, ok := grp.(*oidc.ManagedGroup)
+	return ok && mg.GetFrozen()
+}
 
-	dbMask := ldapMaskManager.Translate(mask)
-	if len(dbMask) == 0 {
-		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+// expandMatchOptionsPaths returns the update mask paths with a path updating
+// all the match options replaced by the paths of each option.
+func expandMatchOptionsPaths(paths []string) []string {
+	if !handlers.MaskContains(paths, attrMatchOptionsField) {
+		return paths
 	}
-	repo, err := s.ldapRepoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	out := make([]string, 0, len(paths))
+	for _, p := range splitMaskPaths(paths) {
+		if p == attrMatchOptionsField {
+			p = attrMatchCaseInsensitiveField
+		}
+		out = append(out, p)
 	}
-	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	return out
+}
+
+// unknownMaskPathsError returns the error to report for the update mask paths
+// which name neither a field the mask manager translates nor one of the other
+// fields an update handles, or "" if there are none. Paths are matched the
+// way the mask manager matches them, so a path which only differs in case
+// from a field is unknown, and named with the field it probably meant.
+func unknownMaskPathsError(m handlers.MaskManager, paths []string, otherFields ...string) string {
+	// Tags are set apart from the managed group's own fields, and the kind
+	// is rejected with its own error.
+	fields := []string{globals.TagsField, globals.KindField}
+	fields = append(fields, otherFields...)
+	for f := range m {
+		fields = append(fields, f)
 	}
-	if rowsUpdated == 0 {
-		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	var unknown []string
+	for _, path := range paths {
+		for _, p := range strings.Split(path, ",") {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			u := fmt.Sprintf("%q", p)
+			for _, f := range fields {
+				if f == p {
+					u = ""
+					break
+				}
+				if strings.EqualFold(f, p) {
+					u = fmt.Sprintf("%q (did you mean %q?)", p, f)
+				}
+			}
+			if u != "" {
+				unknown = append(unknown, u)
+			}
+		}
 	}
-	return out, nil
+	if len(unknown) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Unrecognized field %s.", strings.Join(unknown, ", "))
 }
 
-func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateManagedGroupRequest) (auth.ManagedGroup, error) {
-	const op = "managed_groups.(Service).updateInRepo"
-	var out auth.ManagedGroup
-	switch subtypes.SubtypeFromId(domain, req.GetId()) {
-	case oidc.Subtype:
-		mg, err := s.updateOidcInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+// maskValueErrors returns the fields named in the update mask which the item
+// of an update request holds no value for. A name, description or tags left
+// unset in the item is how they are cleared, and an attribute is cleared by
+// leaving it unset in the supplied attributes, so only attribute fields named
+// without any attributes being supplied are reported.
+func maskValueErrors(paths []string, item *pb.ManagedGroup) invalidFields {
+	if item.GetAttrs() != nil {
+		return nil
+	}
+	badFields := invalidFields{}
+	for _, p := range splitMaskPaths(paths) {
+		if strings.HasPrefix(p, globals.AttributesField+".") {
+			badFields.add(p, FieldErrorRequired, "Present in mask but no value provided.")
 		}
-		if mg == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+	}
+	return badFields
+}
+
+// missingAttrsError returns the field error code and the message to report
+// when item doesn't hold the attributes of its subtype. Attributes of another subtype can only be
+// provided by clients which set the strongly-typed attributes directly rather
+// than the generic attributes struct, which is converted to the right subtype.
+func missingAttrsError(item *pb.ManagedGroup, missing string) (string, string) {
+	if item.GetAttrs() != nil {
+		return FieldErrorTypeMismatch, "Attributes don't match the auth method's type."
+	}
+	return FieldErrorRequired, missing
+}
+
+// validateUpsertRequest checks a request to upsert a managed group. When
+// rejectMatchAll is set the filter of an OIDC managed group must not appear to
+// match every authenticated user, unless the request allows it.
+func validateUpsertRequest(ctx context.Context, req *pbs.UpsertManagedGroupRequest, rejectMatchAll bool) error {
+	const op = "managed_groups.validateUpsertRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	item := req.GetItem()
+	var badFields invalidFields
+	err := handlers.ValidateCreateRequest(item, func() map[string]string {
+		badFields = validateCreateItem(item)
+		if _, invalid := badFields[attrFilterField]; !invalid && subtypes.SubtypeFromId(domain, item.GetAuthMethodId()) == oidc.Subtype {
+			if msg := matchAllFilterError(rejectMatchAll, req.GetAllowMatchAll(), item.GetOidcManagedGroupAttributes().GetFilter()); msg != "" {
+				badFields.add(attrFilterField, FieldErrorInvalidValue, msg)
+			}
 		}
-		out = mg
-	case ldap.Subtype:
-		mg, err := s.updateLdapInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+		if item.GetName() == nil {
+			badFields.add(globals.NameField, FieldErrorRequired, "This field is required.")
 		}
-		if mg == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		if item.GetVersion() != 0 {
+			badFields.add(globals.VersionField, FieldErrorReadOnly, "Cannot specify this field in an upsert request.")
 		}
-		out = mg
+		if len(item.GetTags()) > 0 {
+			badFields.add(globals.TagsField, FieldErrorReadOnly, "Cannot specify this field in an upsert request.")
+		}
+		if item.GetKind() != "" {
+			badFields.add(globals.KindField, FieldErrorReadOnly, "Cannot specify this field in an upsert request.")
+		}
+		if item.GetOwnerId() != nil {
+			badFields.add(globals.OwnerIdField, FieldErrorReadOnly, "Cannot specify this field in an upsert request.")
+		}
+		return badFields.descriptions()
+	})
+	return withFieldErrorCodes(err, badFields, createRequestFieldCodes)
+}
+
+func validateExportRequest(ctx context.Context, req *pbs.ExportManagedGroupsRequest) error {
+	const op = "managed_groups.validateExportRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return out, nil
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		return invalidFieldError(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return nil
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	const op = "managed_groups.(Service).deleteFromRepo"
-	var rows int
-	var err error
-	switch subtypes.SubtypeFromId(domain, id) {
-	case oidc.Subtype:
-		repo, iErr := s.oidcRepoFn()
-		if iErr != nil {
-			return false, iErr
-		}
-		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
-	case ldap.Subtype:
-		repo, iErr := s.ldapRepoFn()
-		if iErr != nil {
-			return false, iErr
-		}
-		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
+func validateFilterCapabilitiesRequest(ctx context.Context, req *pbs.GetManagedGroupFilterCapabilitiesRequest) error {
+	const op = "managed_groups.validateFilterCapabilitiesRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	if err != nil {
-		if errors.IsNotFoundError(err) {
-			return false, nil
-		}
-		return false, errors.Wrap(ctx, err, op)
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		return invalidFieldError(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return nil
+}
+
+func validateImportRequest(ctx context.Context, req *pbs.ImportManagedGroupsRequest) error {
+	const op = "managed_groups.validateImportRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	switch doc := req.GetDocument(); {
+	case doc == nil:
+		badFields.add("document", FieldErrorRequired, "This field is required.")
+	case doc.GetVersion() != exportDocumentVersion:
+		badFields.add("document.version", FieldErrorInvalidValue, fmt.Sprintf("Unsupported document version, only version %d is supported.", exportDocumentVersion))
+	case doc.GetType() != subtypes.SubtypeFromId(domain, req.GetAuthMethodId()).String():
+		badFields.add("document.type", FieldErrorTypeMismatch, "Doesn't match the type of the auth method.")
+	}
+	return badFields.err()
+}
+
+func validatePreviewMatchesRequest(ctx context.Context, req *pbs.PreviewManagedGroupMatchesRequest) error {
+	const op = "managed_groups.validatePreviewMatchesRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods are supported.")
+	}
+	if req.GetClaims() == nil {
+		badFields.add("claims", FieldErrorRequired, "This field is required.")
+	}
+	return badFields.err()
+}
+
+func validateRefreshRequest(ctx context.Context, req *pbs.RefreshAuthMethodManagedGroupsRequest) error {
+	const op = "managed_groups.validateRefreshRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+		return invalidFieldError(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods are supported.")
+	}
+	return nil
+}
+
+func validateMembershipReportRequest(ctx context.Context, req *pbs.GenerateManagedGroupMembershipReportRequest) error {
+	const op = "managed_groups.validateMembershipReportRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return rows > 0, nil
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		return invalidFieldError(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.ManagedGroup, error) {
-	const op = "managed_groups.(Service).listFromRepo"
+func validateGetGrantsRequest(ctx context.Context, req *pbs.GetManagedGroupGrantsRequest) error {
+	const op = "managed_groups.validateGetGrantsRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	err := handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return withFieldErrorCodes(err, nil, getRequestFieldCodes)
+}
 
-	var outUl []auth.ManagedGroup
-	switch subtypes.SubtypeFromId(domain, authMethodId) {
-	case oidc.Subtype:
-		oidcRepo, err := s.oidcRepoFn()
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		oidcl, err := oidcRepo.ListManagedGroups(ctx, authMethodId, oidc.WithLimit(-1))
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		for _, a := range oidcl {
-			outUl = append(outUl, a)
-		}
-	case ldap.Subtype:
-		ldapRepo, err := s.ldapRepoFn()
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		oidcl, err := ldapRepo.ListManagedGroups(ctx, authMethodId, ldap.WithLimit(ctx, -1))
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		for _, a := range oidcl {
-			outUl = append(outUl, a)
-		}
+func validateDiffVersionsRequest(ctx context.Context, req *pbs.DiffManagedGroupVersionsRequest) error {
+	const op = "managed_groups.validateDiffVersionsRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return outUl, nil
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix) {
+		badFields.add(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC managed groups have history.")
+	}
+	if req.GetFromVersion() == 0 {
+		badFields.add("from_version", FieldErrorRequired, "This field is required.")
+	}
+	if req.GetToVersion() == 0 {
+		badFields.add("to_version", FieldErrorRequired, "This field is required.")
+	}
+	return badFields.err()
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, requestauth.VerifyResults) {
-	const op = "managed_groups.(Service)."
-	res := requestauth.VerifyResults{}
-	oidcRepo, err := s.oidcRepoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
+func validateGetHistoryRequest(ctx context.Context, req *pbs.GetManagedGroupHistoryRequest) error {
+	const op = "managed_groups.validateGetHistoryRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	ldapRepo, err := s.ldapRepoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix) {
+		return invalidFieldError(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC managed groups have history.")
 	}
+	return nil
+}
 
-	var parentId string
-	opts := []requestauth.Option{requestauth.WithType(resource.ManagedGroup), requestauth.WithAction(a)}
-	switch a {
-	case action.List, action.Create:
-		parentId = id
-	default:
-		switch subtypes.SubtypeFromId(domain, id) {
-		case oidc.Subtype:
-			grp, err := oidcRepo.LookupManagedGroup(ctx, id)
-			if err != nil {
-				res.Error = err
-				return nil, res
-			}
-			if grp == nil {
-				res.Error = handlers.NotFoundError()
-				return nil, res
-			}
-			parentId = grp.GetAuthMethodId()
-		case ldap.Subtype:
-			grp, err := ldapRepo.LookupManagedGroup(ctx, id)
-			if err != nil {
-				res.Error = err
-				return nil, res
-			}
-			if grp == nil {
-				res.Error = handlers.NotFoundError()
-				return nil, res
-			}
-			parentId = grp.GetAuthMethodId()
-		default:
-			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
-			return nil, res
-		}
-		opts = append(opts, requestauth.WithId(id))
+func validateReplaceInFiltersRequest(ctx context.Context, req *pbs.ReplaceInFiltersRequest) error {
+	const op = "managed_groups.validateReplaceInFiltersRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods are supported.")
+	}
+	if !oidc.ValidSelectorPrefix(req.GetOldPrefix()) {
+		badFields.add("old_prefix", FieldErrorInvalidValue, "Must be a JSON pointer such as \"/token/groups\".")
+	}
+	if !oidc.ValidSelectorPrefix(req.GetNewPrefix()) {
+		badFields.add("new_prefix", FieldErrorInvalidValue, "Must be a JSON pointer such as \"/token/groups\".")
 	}
+	return badFields.err()
+}
 
-	var authMeth auth.AuthMethod
-	switch subtypes.SubtypeFromId(domain, parentId) {
-	case oidc.Subtype:
-		am, err := oidcRepo.LookupAuthMethod(ctx, parentId)
-		if err != nil {
-			res.Error = err
-			return nil, res
-		}
-		if am == nil {
-			res.Error = handlers.NotFoundError()
-			return nil, res
-		}
-		authMeth = am
-		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
-	case ldap.Subtype:
-		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
-		if err != nil {
-			res.Error = err
-			return nil, res
+func validateValidateStoredFiltersRequest(ctx context.Context, req *pbs.ValidateStoredFiltersRequest) error {
+	const op = "managed_groups.validateValidateStoredFiltersRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	switch {
+	case req.GetAuthMethodId() != "" && req.GetScopeId() != "":
+		badFields.add(globals.ScopeIdField, FieldErrorConflictingFields, "Cannot be combined with auth_method_id.")
+	case req.GetAuthMethodId() != "":
+		if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+			badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods are supported.")
 		}
-		if am == nil {
-			res.Error = handlers.NotFoundError()
-			return nil, res
+	case req.GetScopeId() != "":
+		if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && req.GetScopeId() != scope.Global.String() {
+			badFields.add(globals.ScopeIdField, FieldErrorInvalidValue, "This field must be 'global' or a valid org scope id.")
 		}
-		authMeth = am
-		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
 	default:
-		res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
-		return nil, res
+		badFields.add(globals.AuthMethodIdField, FieldErrorRequired, "An auth_method_id or a scope_id is required.")
 	}
-	opts = append(opts, requestauth.WithPin(parentId))
-	return authMeth, requestauth.Verify(ctx, opts...)
+	switch after := req.GetAfterAuthMethodId(); {
+	case after == "":
+	case req.GetScopeId() == "":
+		badFields.add(afterAuthMethodIdField, FieldErrorInvalidValue, "Can only be provided with a scope_id.")
+	case !handlers.ValidId(handlers.Id(after), globals.OidcAuthMethodPrefix):
+		badFields.add(afterAuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return badFields.err()
 }
 
-func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option) (*pb.ManagedGroup, error) {
-	opts := handlers.GetOpts(opt...)
-	if opts.WithOutputFields == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building managed group proto")
-	}
-	outputFields := *opts.WithOutputFields
+// validTemplateScopeId reports whether managed group templates can be defined
+// in the scope with the provided id. Auth methods are only in the global and
+// org scopes.
+func validTemplateScopeId(id string) bool {
+	return id == scope.Global.String() || handlers.ValidId(handlers.Id(id), scope.Org.Prefix())
+}
 
-	out := pb.ManagedGroup{}
-	if outputFields.Has(globals.IdField) {
-		out.Id = in.GetPublicId()
+func validateCreateTemplateRequest(ctx context.Context, req *pbs.CreateManagedGroupTemplateRequest) error {
+	const op = "managed_groups.validateCreateTemplateRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	if outputFields.Has(globals.AuthMethodIdField) {
-		out.AuthMethodId = in.GetAuthMethodId()
+	item := req.GetItem()
+	badFields := invalidFields{}
+	if !validTemplateScopeId(item.GetScopeId()) {
+		badFields.add(globals.ScopeIdField, FieldErrorInvalidValue, "This field must be 'global' or a valid org scope id.")
 	}
-	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
-		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	if item.GetId() != "" {
+		badFields.add(globals.IdField, FieldErrorReadOnly, "This is a read only field.")
 	}
-	if outputFields.Has(globals.NameField) && in.GetName() != "" {
-		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	if item.GetVersion() != 0 {
+		badFields.add(globals.VersionField, FieldErrorReadOnly, "This is a read only field.")
 	}
-	if outputFields.Has(globals.CreatedTimeField) {
-		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	if item.GetCreatedTime() != nil {
+		badFields.add(globals.CreatedTimeField, FieldErrorReadOnly, "This is a read only field.")
 	}
-	if outputFields.Has(globals.UpdatedTimeField) {
-		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	if item.GetUpdatedTime() != nil {
+		badFields.add(globals.UpdatedTimeField, FieldErrorReadOnly, "This is a read only field.")
 	}
-	if outputFields.Has(globals.VersionField) {
-		out.Version = in.GetVersion()
+	if item.GetName() == "" {
+		badFields.add(globals.NameField, FieldErrorRequired, "This field is required.")
 	}
-	if outputFields.Has(globals.ScopeField) {
-		out.Scope = opts.WithScope
+	if item.GetFilter() == "" {
+		badFields.add(filterField, FieldErrorRequired, "This field is required.")
+	} else {
+		// The filter is validated as it would be for an example auth method.
+		filter := oidc.ExpandManagedGroupTemplate(item.GetFilter(), globals.OidcAuthMethodPrefix+"_1234567890")
+		if _, err := oidc.ManagedGroupFilterEvaluator(oidc.CompilableFilter(filter)); err != nil {
+			badFields.add(filterField, FieldErrorInvalidFilter, fmt.Sprintf("Error evaluating submitted filter expression: %v.", err))
+		}
 	}
-	if outputFields.Has(globals.AuthorizedActionsField) {
-		out.AuthorizedActions = opts.WithAuthorizedActions
+	return badFields.err()
+}
+
+func validateListTemplatesRequest(ctx context.Context, req *pbs.ListManagedGroupTemplatesRequest) error {
+	const op = "managed_groups.validateListTemplatesRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	if outputFields.Has(globals.MemberIdsField) {
-		out.MemberIds = opts.WithMemberIds
+	if !validTemplateScopeId(req.GetScopeId()) {
+		return invalidFieldError(globals.ScopeIdField, FieldErrorInvalidValue, "This field must be 'global' or a valid org scope id.")
 	}
-	switch i := in.(type) {
-	case *oidc.ManagedGroup:
-		if outputFields.Has(globals.TypeField) {
-			out.Type = oidc.Subtype.String()
-		}
-		if !outputFields.Has(globals.AttributesField) {
-			break
-		}
-		attrs := &pb.OidcManagedGroupAttributes{
-			Filter: i.GetFilter(),
-		}
-		out.Attrs = &pb.ManagedGroup_OidcManagedGroupAttributes{
-			OidcManagedGroupAttributes: attrs,
-		}
-	case *ldap.ManagedGroup:
-		if outputFields.Has(globals.TypeField) {
-			out.Type = ldap.Subtype.String()
-		}
-		if !outputFields.Has(globals.AttributesField) {
-			break
-		}
+	return nil
+}
 
-		var grpNames []string
-		if err := json.Unmarshal([]byte(i.GetGroupNames()), &grpNames); err != nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal group names")
-		}
+func validateDeleteTemplateRequest(ctx context.Context, req *pbs.DeleteManagedGroupTemplateRequest) error {
+	const op = "managed_groups.validateDeleteTemplateRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupTemplatePrefix) {
+		return invalidFieldError(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return nil
+}
 
-		attrs := &pb.LdapManagedGroupAttributes{
-			GroupNames: grpNames,
+func validateCreateFromTemplateRequest(ctx context.Context, req *pbs.CreateManagedGroupFromTemplateRequest) error {
+	const op = "managed_groups.validateCreateFromTemplateRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetTemplateId()), globals.OidcManagedGroupTemplatePrefix) {
+		badFields.add(templateIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix) {
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier. Only OIDC auth methods are supported.")
+	}
+	return badFields.err()
+}
+
+// roleIdsError returns the field error code and why the ids of the roles to
+// add a managed group to are invalid, or "" if they're valid.
+func roleIdsError(roleIds []string) (string, string) {
+	if len(roleIds) > maxAddToRoles {
+		return FieldErrorInvalidValue, fmt.Sprintf("Must contain at most %d role ids.", maxAddToRoles)
+	}
+	seen := make(map[string]bool, len(roleIds))
+	for _, id := range roleIds {
+		if !handlers.ValidId(handlers.Id(id), globals.RolePrefix) {
+			return FieldErrorInvalidId, fmt.Sprintf("Invalid formatted role id %q.", id)
 		}
-		out.Attrs = &pb.ManagedGroup_LdapManagedGroupAttributes{
-			LdapManagedGroupAttributes: attrs,
+		if seen[id] {
+			return FieldErrorInvalidValue, fmt.Sprintf("Role id %q is provided more than once.", id)
 		}
+		seen[id] = true
 	}
-	return &out, nil
+	return "", ""
 }
 
-// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
-// requirements on the structure of the request.  They verify that:
-//   - The path passed in is correctly formatted
-//   - All required parameters are set
-//   - There are no conflicting parameters provided
-func validateGetRequest(ctx context.Context, req *pbs.GetManagedGroupRequest) error {
-	const op = "managed_groups.validateGetRequest"
+func validateAddToRolesRequest(ctx context.Context, req *pbs.AddManagedGroupToRolesRequest) error {
+	const op = "managed_groups.validateAddToRolesRequest"
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix) {
+		badFields.add(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	if len(req.GetRoleIds()) == 0 {
+		badFields.add(roleIdsField, FieldErrorInvalidValue, "Must contain at least one role id.")
+	} else if code, msg := roleIdsError(req.GetRoleIds()); msg != "" {
+		badFields.add(roleIdsField, code, msg)
+	}
+	return badFields.err()
 }
 
-func validateCreateRequest(ctx context.Context, req *pbs.CreateManagedGroupRequest) error {
-	const op = "managed_groups.validateCreateRequest"
+func validateSetEnabledRequest(ctx context.Context, req *pbs.SetManagedGroupsEnabledRequest) error {
+	const op = "managed_groups.validateSetEnabledRequest"
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
-		badFields := map[string]string{}
-		if req.GetItem().GetAuthMethodId() == "" {
-			badFields[globals.AuthMethodIdField] = "This field is required."
-		}
-		switch subtypes.SubtypeFromId(domain, req.GetItem().GetAuthMethodId()) {
-		case oidc.Subtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != oidc.Subtype.String() {
-				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
-			}
-			attrs := req.GetItem().GetOidcManagedGroupAttributes()
-			if attrs == nil {
-				badFields[globals.AttributesField] = "Attribute fields is required."
-			} else {
-				if attrs.Filter == "" {
-					badFields[attrFilterField] = "This field is required."
-				} else {
-					if _, err := bexpr.CreateEvaluator(attrs.Filter); err != nil {
-						badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
-					}
-				}
+	badFields := invalidFields{}
+	switch {
+	case len(req.GetItems()) == 0:
+		badFields.add(itemsField, FieldErrorRequired, "This field is required.")
+	case len(req.GetItems()) > maxSetEnabledItems:
+		badFields.add(itemsField, FieldErrorInvalidValue, fmt.Sprintf("Must contain at most %d managed groups.", maxSetEnabledItems))
+	default:
+		seen := make(map[string]bool, len(req.GetItems()))
+		for _, item := range req.GetItems() {
+			id := item.GetId()
+			if !handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) {
+				badFields.add(itemsField, FieldErrorInvalidId, fmt.Sprintf("Invalid formatted identifier %q. Only OIDC managed groups can be enabled or disabled.", id))
+				break
 			}
-		case ldap.Subtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
-				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			if seen[id] {
+				badFields.add(itemsField, FieldErrorInvalidValue, fmt.Sprintf("Managed group id %q is provided more than once.", id))
+				break
 			}
-			attrs := req.GetItem().GetLdapManagedGroupAttributes()
-			if attrs == nil {
-				badFields[globals.AttributesField] = "Attribute fields is required."
-			} else {
-				if len(attrs.GroupNames) == 0 {
-					badFields[attrGroupNamesField] = "This field is required."
-				}
+			seen[id] = true
+			if item.GetVersion() == 0 {
+				badFields.add(itemsField, FieldErrorRequired, fmt.Sprintf("The version of managed group %q is required.", id))
+				break
 			}
-		default:
-			badFields[globals.AuthMethodIdField] = "Unknown auth method type from ID."
 		}
-		return badFields
-	})
+	}
+	return badFields.err()
+}
+
+func validateCountRequest(ctx context.Context, req *pbs.CountManagedGroupsRequest) error {
+	const op = "managed_groups.validateCountRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	switch {
+	case req.GetScopeId() == "":
+		badFields.add(globals.ScopeIdField, FieldErrorRequired, "This field is required.")
+	case req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()):
+		badFields.add(globals.ScopeIdField, FieldErrorInvalidValue, "This field must be 'global' or a valid org scope id.")
+	}
+	if after := req.GetAfterAuthMethodId(); after != "" && !handlers.ValidId(handlers.Id(after), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		badFields.add(afterAuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	return badFields.err()
+}
+
+func validateAuthorizeRequest(ctx context.Context, req *pbs.AuthorizeManagedGroupRequest) error {
+	const op = "managed_groups.validateAuthorizeRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix) {
+		badFields.add(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	switch act, ok := action.Map[req.GetAction()]; {
+	case req.GetAction() == "":
+		badFields.add("action", FieldErrorRequired, "This field is required.")
+	case !ok || !IdActions[subtypes.SubtypeFromId(domain, req.GetId())].HasAction(act):
+		badFields.add("action", FieldErrorInvalidValue, fmt.Sprintf("Unknown managed group action %q.", req.GetAction()))
+	}
+	return badFields.err()
 }
 
 func validateUpdateRequest(ctx context.Context, req *pbs.UpdateManagedGroupRequest) error {
@@ -840,43 +5375,100 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateManagedGroupReque
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
-		badFields := map[string]string{}
+	badFields := invalidFields{}
+	err := handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.TagsField) {
+			if msg := tagsError(req.GetItem().GetTags()); msg != "" {
+				badFields.add(globals.TagsField, FieldErrorInvalidValue, msg)
+			}
+		}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.KindField) {
+			badFields.add(globals.KindField, FieldErrorInvalidValue, "Cannot modify the managed group kind.")
+		}
+		// An unset owner id clears it.
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.OwnerIdField) && req.GetItem().GetOwnerId() != nil {
+			if code, msg := ownerIdError(req.GetItem().GetOwnerId().GetValue()); msg != "" {
+				badFields.add(globals.OwnerIdField, code, msg)
+			}
+		}
+		for name, field := range maskValueErrors(req.GetUpdateMask().GetPaths(), req.GetItem()) {
+			badFields[name] = field
+		}
 		switch subtypes.SubtypeFromId(domain, req.GetId()) {
 		case oidc.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != oidc.Subtype.String() {
-				badFields[globals.TypeField] = "Cannot modify the resource type."
+				badFields.add(globals.TypeField, FieldErrorReadOnly, "Cannot modify the resource type.")
+			}
+			if msg := unknownMaskPathsError(oidcMaskManager, req.GetUpdateMask().GetPaths(), attrMatchOptionsField); msg != "" {
+				badFields.add("update_mask", FieldErrorInvalidValue, msg)
 			}
 			attrs := req.GetItem().GetOidcManagedGroupAttributes()
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrFilterField) {
 				switch {
+				case attrs == nil && req.GetItem().GetAttrs() == nil:
+					// Reported by maskValueErrors.
 				case attrs == nil:
-					badFields["attributes"] = "Attributes field not supplied request"
+					code, msg := missingAttrsError(req.GetItem(), "")
+					badFields.add(globals.AttributesField, code, msg)
 				default:
 					if attrs.Filter == "" {
-						badFields[attrFilterField] = "Field cannot be empty."
+						badFields.add(attrFilterField, FieldErrorRequired, "Field cannot be empty.")
 					} else {
-						if _, err := bexpr.CreateEvaluator(attrs.Filter); err != nil {
-							badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
+						if _, err := oidc.ManagedGroupFilterEvaluator(oidc.CompilableFilter(attrs.Filter)); err != nil {
+							badFields.add(attrFilterField, FieldErrorInvalidFilter, fmt.Sprintf("Error evaluating submitted filter expression: %v.", err))
 						}
 					}
 				}
 			}
+			if req.GetRefresh() && req.GetPreview() {
+				badFields.add("refresh", FieldErrorInvalidValue, "Cannot be set when previewing an update.")
+			}
 		case ldap.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
-				badFields[globals.TypeField] = "Cannot modify the resource type."
+				badFields.add(globals.TypeField, FieldErrorReadOnly, "Cannot modify the resource type.")
+			}
+			if msg := unknownMaskPathsError(ldapMaskManager, req.GetUpdateMask().GetPaths()); msg != "" {
+				badFields.add("update_mask", FieldErrorInvalidValue, msg)
+			}
+			if req.GetForce() {
+				badFields.add("force", FieldErrorInvalidValue, "Only supported for OIDC managed groups.")
+			}
+			if req.GetRefresh() {
+				badFields.add("refresh", FieldErrorInvalidValue, "Only supported for OIDC managed groups.")
 			}
 			attrs := req.GetItem().GetLdapManagedGroupAttributes()
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrGroupNamesField) {
-				if len(attrs.GroupNames) == 0 {
-					badFields[attrFilterField] = "Field cannot be empty."
+				switch {
+				case attrs == nil && req.GetItem().GetAttrs() == nil:
+					// Reported by maskValueErrors.
+				case attrs == nil:
+					code, msg := missingAttrsError(req.GetItem(), "")
+					badFields.add(globals.AttributesField, code, msg)
+				case len(attrs.GetGroupNames()) == 0:
+					badFields.add(attrGroupNamesField, FieldErrorRequired, "Field cannot be empty.")
 				}
 			}
 		default:
-			badFields[globals.IdField] = "Unrecognized resource type."
+			badFields.add(globals.IdField, FieldErrorInvalidId, "Unrecognized resource type.")
 		}
-		return badFields
+		return badFields.descriptions()
 	}, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return withFieldErrorCodes(err, badFields, updateRequestFieldCodes(req.GetItem()))
+}
+
+func validateTouchRequest(ctx context.Context, req *pbs.TouchManagedGroupRequest) error {
+	const op = "managed_groups.validateTouchRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix) {
+		badFields.add(globals.IdField, FieldErrorInvalidId, "Incorrectly formatted identifier.")
+	}
+	if req.GetVersion() == 0 {
+		badFields.add(globals.VersionField, FieldErrorRequired, "Existing resource version is required for a touch.")
+	}
+	return badFields.err()
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteManagedGroupRequest) error {
@@ -884,7 +5476,42 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteManagedGroupReque
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	err := handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return withFieldErrorCodes(err, nil, deleteRequestFieldCodes)
+}
+
+func validateListMembersRequest(ctx context.Context, req *pbs.ListManagedGroupMembersRequest) error {
+	const op = "managed_groups.validateListMembersRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix) {
+		badFields.add(globals.IdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	if req.GetPageToken() != "" {
+		if _, err := decodeMemberPageToken(req.GetId(), req.GetPageToken()); err != nil {
+			badFields.add(pageTokenField, FieldErrorInvalidValue, "Invalid page token; list from the first page again.")
+		}
+	}
+	return badFields.err()
+}
+
+func validateListByMemberRequest(ctx context.Context, req *pbs.ListManagedGroupsByMemberRequest) error {
+	const op = "managed_groups.validateListByMemberRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetAccountId()), globals.OidcAccountPrefix, globals.LdapAccountPrefix) {
+		badFields.add(globals.AccountIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	if req.GetPageToken() != "" {
+		if _, err := decodeMemberOfPageToken(req.GetAccountId(), req.GetPageToken()); err != nil {
+			badFields.add(pageTokenField, FieldErrorInvalidValue, "Invalid page token; list from the first page again.")
+		}
+	}
+	return badFields.err()
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListManagedGroupsRequest) error {
@@ -892,15 +5519,67 @@ func validateListRequest(ctx context.Context, req *pbs.ListManagedGroupsRequest)
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	badFields := map[string]string{}
+	badFields := invalidFields{}
 	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
-		badFields[globals.AuthMethodIdField] = "Invalid formatted identifier."
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
-		badFields[globals.FilterField] = fmt.Sprintf("This field could not be parsed. %v", err)
+		badFields.add(globals.FilterField, FieldErrorInvalidFilter, fmt.Sprintf("This field could not be parsed. %v", err))
 	}
-	if len(badFields) > 0 {
-		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	if req.GetSortBy() != "" && !validSortField(req.GetSortBy()) {
+		badFields.add("sort_by", FieldErrorInvalidValue, fmt.Sprintf("Unsupported sort field, must be one of %q, %q, %q, %q or %q.", sortById, sortByName, sortByCreatedTime, sortByUpdatedTime, sortByMemberCount))
 	}
-	return nil
+	if req.GetPageToken() != "" {
+		if _, err := decodeListPageToken(req.GetAuthMethodId(), req.GetPageToken()); err != nil {
+			badFields.add(pageTokenField, FieldErrorInvalidValue, "Invalid page token; list from the first page again.")
+		}
+	}
+	switch req.GetRoleAssociation() {
+	case "", roleAssociationAny, roleAssociationNone:
+	default:
+		badFields.add("role_association", FieldErrorInvalidValue, fmt.Sprintf("Unsupported role association, must be %q or %q.", roleAssociationAny, roleAssociationNone))
+	}
+	if _, msg := parseTagFilter(req.GetTags()); msg != "" {
+		badFields.add(globals.TagsField, FieldErrorInvalidValue, msg)
+	}
+	if msg := kindError(req.GetKind()); msg != "" {
+		badFields.add(globals.KindField, FieldErrorInvalidValue, msg)
+	}
+	if req.GetOwnerId() != "" {
+		if code, msg := ownerIdError(req.GetOwnerId()); msg != "" {
+			badFields.add(globals.OwnerIdField, code, msg)
+		}
+	}
+	return badFields.err()
+}
+
+func validateStreamRequest(ctx context.Context, req *pbs.StreamManagedGroupsRequest) error {
+	const op = "managed_groups.validateStreamRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	badFields := invalidFields{}
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+		badFields.add(globals.AuthMethodIdField, FieldErrorInvalidId, "Invalid formatted identifier.")
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields.add(globals.FilterField, FieldErrorInvalidFilter, fmt.Sprintf("This field could not be parsed. %v", err))
+	}
+	switch req.GetRoleAssociation() {
+	case "", roleAssociationAny, roleAssociationNone:
+	default:
+		badFields.add("role_association", FieldErrorInvalidValue, fmt.Sprintf("Unsupported role association, must be %q or %q.", roleAssociationAny, roleAssociationNone))
+	}
+	if _, msg := parseTagFilter(req.GetTags()); msg != "" {
+		badFields.add(globals.TagsField, FieldErrorInvalidValue, msg)
+	}
+	if msg := kindError(req.GetKind()); msg != "" {
+		badFields.add(globals.KindField, FieldErrorInvalidValue, msg)
+	}
+	if req.GetOwnerId() != "" {
+		if code, msg := ownerIdError(req.GetOwnerId()); msg != "" {
+			badFields.add(globals.OwnerIdField, code, msg)
+		}
+	}
+	return badFields.err()
 }
@@ -7,33 +7,48 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http/httptest"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	authmethodspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -53,6 +68,79 @@ var (
 	}
 )
 
+// serviceTestEnv is the database, an org scope with a project and an OIDC auth
+// method in the org which the service tests create managed groups in.
+type serviceTestEnv struct {
+	ctx             context.Context
+	conn            *db.DB
+	rw              *db.Db
+	wrap            wrapping.Wrapper
+	kmsCache        *kms.Kms
+	org             *iam.Scope
+	proj            *iam.Scope
+	databaseWrapper wrapping.Wrapper
+	am              *oidc.AuthMethod
+
+	oidcRepoFn    func() (*oidc.Repository, error)
+	ldapRepoFn    func() (*ldap.Repository, error)
+	iamRepoFn     func() (*iam.Repository, error)
+	tokenRepoFn   func() (*authtoken.Repository, error)
+	serversRepoFn func() (*server.Repository, error)
+}
+
+func newServiceTestEnv(t *testing.T) *serviceTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	return &serviceTestEnv{
+		ctx:             ctx,
+		conn:            conn,
+		rw:              rw,
+		wrap:            wrap,
+		kmsCache:        kmsCache,
+		org:             org,
+		proj:            proj,
+		databaseWrapper: databaseWrapper,
+		am:              am,
+		oidcRepoFn: func() (*oidc.Repository, error) {
+			return oidc.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		ldapRepoFn: func() (*ldap.Repository, error) {
+			return ldap.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		iamRepoFn: func() (*iam.Repository, error) {
+			return iam.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		tokenRepoFn: func() (*authtoken.Repository, error) {
+			return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+		},
+		serversRepoFn: func() (*server.Repository, error) {
+			return server.NewRepository(ctx, rw, rw, kmsCache)
+		},
+	}
+}
+
+// service returns a managed group service of the environment's repositories.
+func (e *serviceTestEnv) service(t *testing.T, opt ...managed_groups.Option) managed_groups.Service {
+	t.Helper()
+	s, err := managed_groups.NewService(e.ctx, e.oidcRepoFn, e.ldapRepoFn, e.iamRepoFn, opt...)
+	require.NoError(t, err)
+	return s
+}
+
 func TestNewService(t *testing.T) {
 	ctx := context.TODO()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -65,35 +153,118 @@ func TestNewService(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name            string
 		oidcRepo        common.OidcAuthRepoFactory
 		ldapRepo        common.LdapAuthRepoFactory
+		iamRepo         common.IamRepoFactory
+		opts            []managed_groups.Option
 		wantErr         bool
 		wantErrContains string
 	}{
 		{
 			name:            "nil-oidc-repo",
 			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
 			wantErr:         true,
 			wantErrContains: "missing oidc repository",
 		},
 		{
 			name:            "missing-ldap-repo",
 			oidcRepo:        oidcRepoFn,
+			iamRepo:         iamRepoFn,
 			wantErr:         true,
 			wantErrContains: "missing ldap repository",
 		},
+		{
+			name:            "missing-iam-repo",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			wantErr:         true,
+			wantErrContains: "missing iam repository",
+		},
+		{
+			name:            "negative-default-list-limit",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithDefaultListLimit(-1)},
+			wantErr:         true,
+			wantErrContains: "default list limit must not be negative",
+		},
+		{
+			name:            "unsupported-default-sort",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithDefaultSort("filter")},
+			wantErr:         true,
+			wantErrContains: `unsupported default sort "filter"`,
+		},
+		{
+			name:            "zero-max-per-auth-method",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithMaxManagedGroupsPerAuthMethod(0)},
+			wantErr:         true,
+			wantErrContains: "max managed groups per auth method must be positive or unlimited",
+		},
+		{
+			name:            "negative-max-list-processed",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithMaxListProcessed(-1)},
+			wantErr:         true,
+			wantErrContains: "max managed groups processed per list must not be negative",
+		},
+		{
+			name:            "negative-max-scope-fan-out",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithMaxScopeFanOut(-1)},
+			wantErr:         true,
+			wantErrContains: "max auth methods per scope request must not be negative",
+		},
+		{
+			name:            "negative-compress-list-over",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			iamRepo:         iamRepoFn,
+			opts:            []managed_groups.Option{managed_groups.WithCompressListOver(-1)},
+			wantErr:         true,
+			wantErrContains: "list compression threshold must not be negative",
+		},
 		{
 			name:     "success",
 			oidcRepo: oidcRepoFn,
 			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
+		},
+		{
+			name:     "success-with-list-defaults",
+			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
+			opts:     []managed_groups.Option{managed_groups.WithDefaultListLimit(10), managed_groups.WithDefaultSort("name")},
+		},
+		{
+			name:     "success-unlimited",
+			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
+			opts:     []managed_groups.Option{managed_groups.WithMaxManagedGroupsPerAuthMethod(managed_groups.UnlimitedManagedGroups)},
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := managed_groups.NewService(ctx, tc.oidcRepo, tc.ldapRepo)
+			_, err := managed_groups.NewService(ctx, tc.oidcRepo, tc.ldapRepo, tc.iamRepo, tc.opts...)
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tc.wantErrContains)
@@ -120,7 +291,7 @@ func TestGet(t *testing.T) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create new managed groups service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -151,18 +322,27 @@ func TestGet(t *testing.T) {
 	require.NoError(t, err)
 
 	oidcWireManagedGroup := pb.ManagedGroup{
-		Id:           omg.GetPublicId(),
-		AuthMethodId: omg.GetAuthMethodId(),
-		CreatedTime:  omg.GetCreateTime().GetTimestamp(),
-		UpdatedTime:  currMg.GetUpdateTime().GetTimestamp(),
-		Scope:        &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-		Version:      currMg.Version,
-		Type:         "oidc",
+		Id:             omg.GetPublicId(),
+		AuthMethodId:   omg.GetAuthMethodId(),
+		AuthMethodType: "oidc",
+		Kind:           "login",
+		CreatedTime:    omg.GetCreateTime().GetTimestamp(),
+		UpdatedTime:    currMg.GetUpdateTime().GetTimestamp(),
+		Scope:          &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+		Version:        currMg.Version,
+		Type:           "oidc",
 		Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
 			OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-				Filter: omg.GetFilter(),
+				Filter:        omg.GetFilter(),
+				FilterVersion: 1,
 			},
 		},
+		FieldUpdatedTimes: &pb.FieldUpdatedTimes{
+			Name:        omg.GetNameUpdateTime().GetTimestamp(),
+			Description: omg.GetDescriptionUpdateTime().GetTimestamp(),
+			Filter:      omg.GetFilterUpdateTime().GetTimestamp(),
+		},
+		Uri:               fmt.Sprintf("managed-groups/%s", omg.GetPublicId()),
 		AuthorizedActions: oidcAuthorizedActions,
 		MemberIds:         []string{oidcA.GetPublicId()},
 	}
@@ -171,22 +351,40 @@ func TestGet(t *testing.T) {
 	ldapAcct := ldap.TestAccount(t, conn, ldapAm, "test-login-name", ldap.WithMemberOfGroups(ctx, "admin"))
 	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin"})
 	ldapWireManagedGroup := pb.ManagedGroup{
-		Id:           ldapMg.GetPublicId(),
-		AuthMethodId: ldapAm.GetPublicId(),
-		CreatedTime:  ldapMg.GetCreateTime().GetTimestamp(),
-		UpdatedTime:  ldapMg.GetUpdateTime().GetTimestamp(),
-		Scope:        &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-		Version:      ldapMg.GetVersion(),
-		Type:         "ldap",
+		Id:             ldapMg.GetPublicId(),
+		AuthMethodId:   ldapAm.GetPublicId(),
+		AuthMethodType: "ldap",
+		Kind:           "login",
+		CreatedTime:    ldapMg.GetCreateTime().GetTimestamp(),
+		UpdatedTime:    ldapMg.GetUpdateTime().GetTimestamp(),
+		Scope:          &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+		Version:        ldapMg.GetVersion(),
+		Type:           "ldap",
 		Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
 			LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
 				GroupNames: []string{"admin"},
 			},
 		},
+		Uri:               fmt.Sprintf("managed-groups/%s", ldapMg.GetPublicId()),
 		AuthorizedActions: ldapAuthorizedActions,
 		MemberIds:         []string{ldapAcct.GetPublicId()},
 	}
 
+	oidcWithAuthMethod := proto.Clone(&oidcWireManagedGroup).(*pb.ManagedGroup)
+	oidcWithAuthMethod.AuthMethod = &authmethodspb.AuthMethodInfo{Id: oidcAm.GetPublicId(), Name: oidcAm.GetName(), Type: "oidc"}
+	ldapWithAuthMethod := proto.Clone(&ldapWireManagedGroup).(*pb.ManagedGroup)
+	ldapWithAuthMethod.AuthMethod = &authmethodspb.AuthMethodInfo{Id: ldapAm.GetPublicId(), Name: ldapAm.GetName(), Type: "ldap"}
+	// Without the type the attributes are returned as the generic struct.
+	sparseAttrs, err := handlers.ProtoToStruct(oidcWireManagedGroup.GetOidcManagedGroupAttributes())
+	require.NoError(t, err)
+	oidcSparse := &pb.ManagedGroup{
+		Id:    oidcWireManagedGroup.GetId(),
+		Attrs: &pb.ManagedGroup_Attributes{Attributes: sparseAttrs},
+	}
+	ldapAttributesJson := proto.Clone(&ldapWireManagedGroup).(*pb.ManagedGroup)
+	ldapAttributesJson.Attrs = nil
+	ldapAttributesJson.AttributesJson = `{"group_names":["admin"]}`
+
 	cases := []struct {
 		name        string
 		req         *pbs.GetManagedGroupRequest
@@ -199,6 +397,16 @@ func TestGet(t *testing.T) {
 			req:  &pbs.GetManagedGroupRequest{Id: oidcWireManagedGroup.GetId()},
 			res:  &pbs.GetManagedGroupResponse{Item: &oidcWireManagedGroup},
 		},
+		{
+			name: "Get an oidc managed group with its auth method",
+			req:  &pbs.GetManagedGroupRequest{Id: oidcWireManagedGroup.GetId(), WithAuthMethod: true},
+			res:  &pbs.GetManagedGroupResponse{Item: oidcWithAuthMethod},
+		},
+		{
+			name: "Get only the requested fields of an oidc managed group",
+			req:  &pbs.GetManagedGroupRequest{Id: oidcWireManagedGroup.GetId(), Fields: []string{globals.IdField, globals.AttributesField, "unknown"}},
+			res:  &pbs.GetManagedGroupResponse{Item: oidcSparse},
+		},
 		{
 			name:        "Get a non existing oidc managed group",
 			req:         &pbs.GetManagedGroupRequest{Id: globals.OidcManagedGroupPrefix + "_DoesntExis"},
@@ -211,6 +419,16 @@ func TestGet(t *testing.T) {
 			req:  &pbs.GetManagedGroupRequest{Id: ldapWireManagedGroup.GetId()},
 			res:  &pbs.GetManagedGroupResponse{Item: &ldapWireManagedGroup},
 		},
+		{
+			name: "Get an ldap managed group with its attributes as json",
+			req:  &pbs.GetManagedGroupRequest{Id: ldapWireManagedGroup.GetId(), AttributesAsJson: true},
+			res:  &pbs.GetManagedGroupResponse{Item: ldapAttributesJson},
+		},
+		{
+			name: "Get an ldap managed group with its auth method",
+			req:  &pbs.GetManagedGroupRequest{Id: ldapWireManagedGroup.GetId(), WithAuthMethod: true},
+			res:  &pbs.GetManagedGroupResponse{Item: ldapWithAuthMethod},
+		},
 		{
 			name:        "space in id",
 			req:         &pbs.GetManagedGroupRequest{Id: globals.AuthTokenPrefix + "_1 23456789"},
@@ -257,6 +475,147 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGet_byName(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, iamRepoFn, org, databaseWrapper, oidcAm := env.ctx, env.conn, env.iamRepoFn, env.org, env.databaseWrapper, env.am
+	s := env.service(t)
+
+	omg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter, oidc.WithName("oidc-group"))
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin"}, ldap.WithName(ctx, "ldap-group"))
+
+	cases := []struct {
+		name        string
+		req         *pbs.GetManagedGroupRequest
+		wantId      string
+		wantName    string
+		err         error
+		errContains string
+	}{
+		{
+			name:     "oidc",
+			req:      &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Name: "oidc-group"},
+			wantId:   omg.GetPublicId(),
+			wantName: "oidc-group",
+		},
+		{
+			name:     "ldap",
+			req:      &pbs.GetManagedGroupRequest{AuthMethodId: ldapAm.GetPublicId(), Name: "ldap-group"},
+			wantId:   ldapMg.GetPublicId(),
+			wantName: "ldap-group",
+		},
+		{
+			// The stored case is returned regardless of the case requested
+			name:     "oidc mixed case",
+			req:      &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Name: "OIDC-Group"},
+			wantId:   omg.GetPublicId(),
+			wantName: "oidc-group",
+		},
+		{
+			name:     "ldap mixed case",
+			req:      &pbs.GetManagedGroupRequest{AuthMethodId: ldapAm.GetPublicId(), Name: "LDAP-GROUP"},
+			wantId:   ldapMg.GetPublicId(),
+			wantName: "ldap-group",
+		},
+		{
+			name:        "name in other auth method",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: ldapAm.GetPublicId(), Name: "oidc-group"},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: `ManagedGroup "oidc-group" doesn't exist`,
+		},
+		{
+			name:        "non existing auth method",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_DoesntExis", Name: "oidc-group"},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: `ManagedGroup "oidc-group" doesn't exist`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetManagedGroup(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				assert.Contains(gErr.Error(), tc.errContains)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.wantId, got.GetItem().GetId())
+			assert.Equal(tc.wantName, got.GetItem().GetName().GetValue())
+		})
+	}
+}
+
+func TestGet_byIdPrefix(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, rw, iamRepoFn, org, oidcAm := env.ctx, env.rw, env.iamRepoFn, env.org, env.am
+	s := env.service(t)
+
+	// The ids are set so which of them share a prefix is known.
+	newMg := func(id string) *oidc.ManagedGroup {
+		mg, err := oidc.NewManagedGroup(ctx, oidcAm.GetPublicId(), oidc.TestFakeManagedGroupFilter)
+		require.NoError(t, err)
+		mg.PublicId = id
+		require.NoError(t, rw.Create(ctx, mg))
+		return mg
+	}
+	mg := newMg(globals.OidcManagedGroupPrefix + "_abc1234567")
+	newMg(globals.OidcManagedGroupPrefix + "_xyz1234567")
+	newMg(globals.OidcManagedGroupPrefix + "_xyz7654321")
+
+	cases := []struct {
+		name        string
+		req         *pbs.GetManagedGroupRequest
+		wantId      string
+		err         error
+		errContains string
+	}{
+		{
+			name:   "unique prefix",
+			req:    &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Id: globals.OidcManagedGroupPrefix + "_a"},
+			wantId: mg.GetPublicId(),
+		},
+		{
+			name:   "full id",
+			req:    &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Id: mg.GetPublicId()},
+			wantId: mg.GetPublicId(),
+		},
+		{
+			name:        "no match",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Id: globals.OidcManagedGroupPrefix + "_def"},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: "has an id starting with",
+		},
+		{
+			name:        "non existing auth method",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_DoesntExis", Id: globals.OidcManagedGroupPrefix + "_a"},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: "has an id starting with",
+		},
+		{
+			name:        "ambiguous prefix",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: oidcAm.GetPublicId(), Id: globals.OidcManagedGroupPrefix + "_xyz"},
+			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errContains: "Matches more than one ManagedGroup",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetManagedGroup(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				assert.Contains(gErr.Error(), tc.errContains)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.wantId, got.GetItem().GetId())
+		})
+	}
+}
+
 func TestListOidc(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -287,19 +646,27 @@ func TestListOidc(t *testing.T) {
 	for i := 0; i < 3; i++ {
 		mg := oidc.TestManagedGroup(t, conn, amSomeManagedGroups, oidc.TestFakeManagedGroupFilter, oidc.WithName(strconv.Itoa(i)))
 		wantSomeManagedGroups = append(wantSomeManagedGroups, &pb.ManagedGroup{
-			Id:           mg.GetPublicId(),
-			AuthMethodId: mg.GetAuthMethodId(),
-			Name:         wrapperspb.String(strconv.Itoa(i)),
-			CreatedTime:  mg.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  mg.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-			Version:      1,
-			Type:         oidc.Subtype.String(),
+			Id:             mg.GetPublicId(),
+			AuthMethodId:   mg.GetAuthMethodId(),
+			AuthMethodType: oidc.Subtype.String(),
+			Kind:           "login",
+			Name:           wrapperspb.String(strconv.Itoa(i)),
+			CreatedTime:    mg.GetCreateTime().GetTimestamp(),
+			UpdatedTime:    mg.GetUpdateTime().GetTimestamp(),
+			Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+			Version:        1,
+			Type:           oidc.Subtype.String(),
 			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
 				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-					Filter: oidc.TestFakeManagedGroupFilter,
+					Filter:        oidc.TestFakeManagedGroupFilter,
+					FilterVersion: 1,
 				},
 			},
+			FieldUpdatedTimes: &pb.FieldUpdatedTimes{
+				Name:        mg.GetNameUpdateTime().GetTimestamp(),
+				Description: mg.GetDescriptionUpdateTime().GetTimestamp(),
+				Filter:      mg.GetFilterUpdateTime().GetTimestamp(),
+			},
 			AuthorizedActions: oidcAuthorizedActions,
 		})
 	}
@@ -308,23 +675,39 @@ func TestListOidc(t *testing.T) {
 	for i := 0; i < 3; i++ {
 		mg := oidc.TestManagedGroup(t, conn, amOtherManagedGroups, oidc.TestFakeManagedGroupFilter, oidc.WithName(strconv.Itoa(i)))
 		wantOtherManagedGroups = append(wantOtherManagedGroups, &pb.ManagedGroup{
-			Id:           mg.GetPublicId(),
-			AuthMethodId: mg.GetAuthMethodId(),
-			Name:         wrapperspb.String(strconv.Itoa(i)),
-			CreatedTime:  mg.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  mg.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-			Version:      1,
-			Type:         oidc.Subtype.String(),
+			Id:             mg.GetPublicId(),
+			AuthMethodId:   mg.GetAuthMethodId(),
+			AuthMethodType: oidc.Subtype.String(),
+			Kind:           "login",
+			Name:           wrapperspb.String(strconv.Itoa(i)),
+			CreatedTime:    mg.GetCreateTime().GetTimestamp(),
+			UpdatedTime:    mg.GetUpdateTime().GetTimestamp(),
+			Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+			Version:        1,
+			Type:           oidc.Subtype.String(),
 			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
 				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-					Filter: oidc.TestFakeManagedGroupFilter,
+					Filter:        oidc.TestFakeManagedGroupFilter,
+					FilterVersion: 1,
 				},
 			},
+			FieldUpdatedTimes: &pb.FieldUpdatedTimes{
+				Name:        mg.GetNameUpdateTime().GetTimestamp(),
+				Description: mg.GetDescriptionUpdateTime().GetTimestamp(),
+				Filter:      mg.GetFilterUpdateTime().GetTimestamp(),
+			},
 			AuthorizedActions: oidcAuthorizedActions,
 		})
 	}
 
+	var wantSomeManagedGroupIds []*pb.ManagedGroup
+	for _, mg := range wantSomeManagedGroups {
+		wantSomeManagedGroupIds = append(wantSomeManagedGroupIds, &pb.ManagedGroup{Id: mg.GetId()})
+	}
+	sort.Slice(wantSomeManagedGroupIds, func(i, j int) bool {
+		return wantSomeManagedGroupIds[i].GetId() < wantSomeManagedGroupIds[j].GetId()
+	})
+
 	cases := []struct {
 		name     string
 		req      *pbs.ListManagedGroupsRequest
@@ -369,6 +752,44 @@ func TestListOidc(t *testing.T) {
 			},
 			res: &pbs.ListManagedGroupsResponse{},
 		},
+		{
+			name:     "List Some ManagedGroups With Estimated Total",
+			req:      &pbs.ListManagedGroupsRequest{AuthMethodId: amSomeManagedGroups.GetPublicId(), IncludeEstimatedTotal: true},
+			res:      &pbs.ListManagedGroupsResponse{Items: wantSomeManagedGroups, EstimatedTotalItems: uint32(len(wantSomeManagedGroups))},
+			skipAnon: true,
+		},
+		{
+			name: "Filter Some ManagedGroups With Estimated Total",
+			req: &pbs.ListManagedGroupsRequest{
+				AuthMethodId:          amSomeManagedGroups.GetPublicId(),
+				Filter:                fmt.Sprintf(`"/item/name"==%q`, wantSomeManagedGroups[1].Name.GetValue()),
+				IncludeEstimatedTotal: true,
+			},
+			// The estimated total doesn't account for the filter.
+			res:      &pbs.ListManagedGroupsResponse{Items: wantSomeManagedGroups[1:2], EstimatedTotalItems: uint32(len(wantSomeManagedGroups))},
+			skipAnon: true,
+		},
+		{
+			name: "List No ManagedGroups With Estimated Total",
+			req:  &pbs.ListManagedGroupsRequest{AuthMethodId: amNoManagedGroups.GetPublicId(), IncludeEstimatedTotal: true},
+			res:  &pbs.ListManagedGroupsResponse{},
+		},
+		{
+			name:     "List Some ManagedGroup Ids",
+			req:      &pbs.ListManagedGroupsRequest{AuthMethodId: amSomeManagedGroups.GetPublicId(), IdOnly: true},
+			res:      &pbs.ListManagedGroupsResponse{Items: wantSomeManagedGroupIds},
+			skipAnon: true,
+		},
+		{
+			name: "Filter Some ManagedGroup Ids",
+			req: &pbs.ListManagedGroupsRequest{
+				AuthMethodId: amSomeManagedGroups.GetPublicId(),
+				Filter:       fmt.Sprintf(`"/item/name"==%q`, wantSomeManagedGroups[1].Name.GetValue()),
+				IdOnly:       true,
+			},
+			res:      &pbs.ListManagedGroupsResponse{Items: []*pb.ManagedGroup{{Id: wantSomeManagedGroups[1].GetId()}}},
+			skipAnon: true,
+		},
 		{
 			name: "Filter Bad Format",
 			req:  &pbs.ListManagedGroupsRequest{AuthMethodId: amSomeManagedGroups.GetPublicId(), Filter: `"//id/"=="bad"`},
@@ -378,7 +799,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new managed group service.")
 
 			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -390,10 +811,14 @@ func TestListOidc(t *testing.T) {
 				require.NoError(gErr)
 			}
 			sort.Slice(got.Items, func(i, j int) bool {
-				return strings.Compare(got.Items[i].GetName().GetValue(),
-					got.Items[j].GetName().GetValue()) < 0
+				if c := strings.Compare(got.Items[i].GetName().GetValue(),
+					got.Items[j].GetName().GetValue()); c != 0 {
+					return c < 0
+				}
+				return got.Items[i].GetId() < got.Items[j].GetId()
 			})
-			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListManagedGroups() with scope %q got response %q, wanted %q", tc.req, got, tc.res)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform(), protocmp.IgnoreFields(&pbs.ListManagedGroupsResponse{}, "item_count")), "ListManagedGroups() with scope %q got response %q, wanted %q", tc.req, got, tc.res)
+			assert.EqualValues(len(tc.res.GetItems()), got.GetItemCount())
 
 			// Now test with anon
 			if tc.skipAnon {
@@ -412,75 +837,765 @@ func TestListOidc(t *testing.T) {
 	}
 }
 
-func TestListLdap(t *testing.T) {
-	ctx := context.Background()
-	conn, _ := db.TestSetup(t, "postgres")
-	rw := db.New(conn)
-	wrap := db.TestWrapper(t)
-	kmsCache := kms.TestKms(t, conn, wrap)
-	oidcRepoFn := func() (*oidc.Repository, error) {
-		return oidc.NewRepository(ctx, rw, rw, kmsCache)
-	}
-	iamRepoFn := func() (*iam.Repository, error) {
-		return iam.NewRepository(ctx, rw, rw, kmsCache)
+func TestListOidc_defaultSortAndLimit(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	for _, name := range []string{"c", "a", "b"} {
+		oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName(name))
 	}
-	ldapRepoFn := func() (*ldap.Repository, error) {
-		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	names := func(resp *pbs.ListManagedGroupsResponse) []string {
+		var out []string
+		for _, item := range resp.GetItems() {
+			out = append(out, item.GetName().GetValue())
+		}
+		return out
 	}
 
-	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithDefaultListLimit(2), managed_groups.WithDefaultSort("name"))
 	require.NoError(t, err)
-	amNoManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://no-managed-groups"})
-	amSomeManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://some-managed-groups"})
-	amOtherManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://other-managed-groups"})
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
 
-	testGroups := []string{"admin", "users"}
-	var wantSomeManagedGroups []*pb.ManagedGroup
-	for i := 0; i < 3; i++ {
-		mg := ldap.TestManagedGroup(t, conn, amSomeManagedGroups, testGroups, ldap.WithName(ctx, strconv.Itoa(i)))
-		wantSomeManagedGroups = append(wantSomeManagedGroups, &pb.ManagedGroup{
-			Id:           mg.GetPublicId(),
-			AuthMethodId: mg.GetAuthMethodId(),
-			Name:         wrapperspb.String(strconv.Itoa(i)),
-			CreatedTime:  mg.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  mg.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-			Version:      1,
-			Type:         ldap.Subtype.String(),
-			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
-				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
-					GroupNames: testGroups,
-				},
-			},
-			AuthorizedActions: ldapAuthorizedActions,
-		})
-	}
+	got, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names(got))
+	assert.True(t, got.GetTruncated())
+	require.NotEmpty(t, got.GetNextPageToken())
 
-	var wantOtherManagedGroups []*pb.ManagedGroup
-	for i := 0; i < 3; i++ {
-		mg := ldap.TestManagedGroup(t, conn, amOtherManagedGroups, testGroups, ldap.WithName(ctx, strconv.Itoa(i)))
-		wantOtherManagedGroups = append(wantOtherManagedGroups, &pb.ManagedGroup{
-			Id:           mg.GetPublicId(),
-			AuthMethodId: mg.GetAuthMethodId(),
-			Name:         wrapperspb.String(strconv.Itoa(i)),
-			CreatedTime:  mg.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  mg.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-			Version:      1,
-			Type:         ldap.Subtype.String(),
-			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
-				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
-					GroupNames: testGroups,
-				},
-			},
-			AuthorizedActions: ldapAuthorizedActions,
-		})
+	// The next page continues after the last managed group returned.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageToken: got.GetNextPageToken()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, names(got))
+	assert.False(t, got.GetTruncated())
+	assert.Empty(t, got.GetNextPageToken())
+
+	// A token only continues the order it was listed in.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageSize: 1})
+	require.NoError(t, err)
+	_, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), SortBy: "id", PageToken: got.GetNextPageToken()})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	// The request overrides the defaults.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageSize: 3, SortBy: "created_time"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b"}, names(got))
+	assert.False(t, got.GetTruncated())
+
+	// A full page is only truncated when another managed group matches.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Filter: `"/item/name" != "c"`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names(got))
+	assert.False(t, got.GetTruncated())
+}
+
+func TestListOidc_maxProcessed(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	for _, name := range []string{"a", "b", "c"} {
+		oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName(name))
+	}
+	names := func(resp *pbs.ListManagedGroupsResponse) []string {
+		var out []string
+		for _, item := range resp.GetItems() {
+			out = append(out, item.GetName().GetValue())
+		}
+		return out
 	}
 
-	cases := []struct {
-		name        string
-		req         *pbs.ListManagedGroupsRequest
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMaxListProcessed(2), managed_groups.WithDefaultSort("name"))
+	require.NoError(t, err)
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	got, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names(got))
+	assert.True(t, got.GetTruncated())
+
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageToken: got.GetNextPageToken()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, names(got))
+	assert.False(t, got.GetTruncated())
+
+	// Managed groups which don't match the filter count as processed too, and
+	// the next page continues after them.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Filter: `"/item/name" == "c"`})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+	assert.True(t, got.GetTruncated())
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Filter: `"/item/name" == "c"`, PageToken: got.GetNextPageToken()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, names(got))
+	assert.False(t, got.GetTruncated())
+
+	// Reaching the page size first is a truncation too, as "b" matches.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, names(got))
+	assert.True(t, got.GetTruncated())
+
+	s, err = managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMaxListProcessed(3), managed_groups.WithDefaultSort("name"))
+	require.NoError(t, err)
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, names(got))
+	assert.False(t, got.GetTruncated())
+
+	// The estimated total counts the managed groups past the page, and isn't
+	// limited by the maximum processed.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), PageSize: 1, IncludeEstimatedTotal: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, names(got))
+	assert.EqualValues(t, 3, got.GetEstimatedTotalItems())
+	s, err = managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMaxListProcessed(1), managed_groups.WithDefaultSort("name"))
+	require.NoError(t, err)
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), IncludeEstimatedTotal: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, names(got))
+	assert.True(t, got.GetTruncated())
+	assert.EqualValues(t, 3, got.GetEstimatedTotalItems())
+
+	// The estimated total is restricted like the managed groups listed.
+	got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Kind: "synced", IncludeEstimatedTotal: true})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+	assert.Zero(t, got.GetEstimatedTotalItems())
+}
+
+func TestListOidc_sortByMemberCount(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	alice := oidc.TestAccount(t, conn, am, "alice")
+	bob := oidc.TestAccount(t, conn, am, "bob")
+
+	var emptyIds []string
+	for i := 0; i < 3; i++ {
+		mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+		emptyIds = append(emptyIds, mg.GetPublicId())
+	}
+	sort.Strings(emptyIds)
+	one := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, one.GetPublicId(), alice.GetPublicId())
+	two := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, two.GetPublicId(), alice.GetPublicId())
+	oidc.TestManagedGroupMember(t, conn, two.GetPublicId(), bob.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	got, err := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), SortBy: "member_count"})
+	require.NoError(t, err)
+	var gotIds []string
+	for _, item := range got.GetItems() {
+		gotIds = append(gotIds, item.GetId())
+	}
+	// Managed groups without members are ordered by id.
+	assert.Equal(t, append([]string{two.GetPublicId(), one.GetPublicId()}, emptyIds...), gotIds)
+}
+
+func TestListOidc_noMembers(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	alice := oidc.TestAccount(t, conn, am, "alice")
+
+	empty := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("empty"))
+	matched := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("matched"))
+	oidc.TestManagedGroupMember(t, conn, matched.GetPublicId(), alice.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	got, err := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), NoMembers: true})
+	require.NoError(t, err)
+	require.Len(t, got.GetItems(), 1)
+	assert.Equal(t, empty.GetPublicId(), got.GetItems()[0].GetId())
+	assert.NotNil(t, got.GetItems()[0].GetCreatedTime())
+
+	// It is applied along with the filter.
+	got, err = s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), NoMembers: true, Filter: `"/item/name" == "matched"`})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+}
+
+func TestManagedGroupKind(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	create := func(kind string) *pb.ManagedGroup {
+		created, err := s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Kind:         kind,
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+			},
+		}})
+		require.NoError(t, err)
+		return created.GetItem()
+	}
+	// Managed groups are evaluated at login unless set otherwise.
+	login := create("")
+	assert.Equal(t, "login", login.GetKind())
+	synced := create("synced")
+	assert.Equal(t, "synced", synced.GetKind())
+
+	got, err := s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: synced.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, "synced", got.GetItem().GetKind())
+
+	for _, kind := range []string{"login", "synced"} {
+		list, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Kind: kind})
+		require.NoError(t, err)
+		require.Len(t, list.GetItems(), 1, kind)
+		assert.Equal(t, kind, list.GetItems()[0].GetKind())
+	}
+}
+
+func TestManagedGroupOwnerId(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	create := func(ownerId *wrapperspb.StringValue) *pb.ManagedGroup {
+		created, err := s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			OwnerId:      ownerId,
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+			},
+		}})
+		require.NoError(t, err)
+		return created.GetItem()
+	}
+	unowned := create(nil)
+	assert.Nil(t, unowned.GetOwnerId())
+	owned := create(wrapperspb.String("platform-team"))
+	assert.Equal(t, "platform-team", owned.GetOwnerId().GetValue())
+
+	got, err := s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: owned.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, "platform-team", got.GetItem().GetOwnerId().GetValue())
+
+	list, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), OwnerId: "platform-team"})
+	require.NoError(t, err)
+	require.Len(t, list.GetItems(), 1)
+	assert.Equal(t, owned.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id:         unowned.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.OwnerIdField}},
+		Item:       &pb.ManagedGroup{Version: unowned.GetVersion(), OwnerId: wrapperspb.String(globals.UserPrefix + "_1234567890")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, globals.UserPrefix+"_1234567890", updated.GetItem().GetOwnerId().GetValue())
+
+	// Updating the owner id without a value clears it.
+	updated, err = s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id:         owned.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.OwnerIdField}},
+		Item:       &pb.ManagedGroup{Version: owned.GetVersion()},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, updated.GetItem().GetOwnerId())
+}
+
+func TestListOidc_roleAssociation(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, p, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.proj, env.am
+	kmsCache, tokenRepoFn, serversRepoFn := env.kmsCache, env.tokenRepoFn, env.serversRepoFn
+
+	orphan := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("orphan"))
+	one := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("one"))
+	two := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("two"))
+	orgRole := iam.TestRole(t, conn, o.GetPublicId())
+	projRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), one.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), two.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, projRole.GetPublicId(), two.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		req       *pbs.ListManagedGroupsRequest
+		wantIds   []string
+		wantRoles map[string]uint32
+	}{
+		{
+			name:    "unset",
+			req:     &pbs.ListManagedGroupsRequest{},
+			wantIds: []string{orphan.GetPublicId(), one.GetPublicId(), two.GetPublicId()},
+		},
+		{
+			name:    "any",
+			req:     &pbs.ListManagedGroupsRequest{RoleAssociation: "any"},
+			wantIds: []string{one.GetPublicId(), two.GetPublicId()},
+		},
+		{
+			name:    "none",
+			req:     &pbs.ListManagedGroupsRequest{RoleAssociation: "none"},
+			wantIds: []string{orphan.GetPublicId()},
+		},
+		{
+			name:    "with filter",
+			req:     &pbs.ListManagedGroupsRequest{RoleAssociation: "any", Filter: `"/item/name" == "two"`},
+			wantIds: []string{two.GetPublicId()},
+		},
+		{
+			name:      "with role count",
+			req:       &pbs.ListManagedGroupsRequest{IncludeRoleCount: true},
+			wantIds:   []string{orphan.GetPublicId(), one.GetPublicId(), two.GetPublicId()},
+			wantRoles: map[string]uint32{orphan.GetPublicId(): 0, one.GetPublicId(): 1, two.GetPublicId(): 2},
+		},
+		{
+			name:      "filtering on role count",
+			req:       &pbs.ListManagedGroupsRequest{IncludeRoleCount: true, Filter: `"/item/role_count" == 2`},
+			wantIds:   []string{two.GetPublicId()},
+			wantRoles: map[string]uint32{two.GetPublicId(): 2},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tc.req.AuthMethodId = am.GetPublicId()
+			got, err := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
+			require.NoError(err)
+			var gotIds []string
+			for _, item := range got.GetItems() {
+				gotIds = append(gotIds, item.GetId())
+				if tc.wantRoles == nil {
+					assert.Nil(item.GetRoleCount())
+					continue
+				}
+				require.NotNil(item.GetRoleCount())
+				assert.Equal(tc.wantRoles[item.GetId()], item.GetRoleCount().GetValue())
+			}
+			sort.Strings(tc.wantIds)
+			assert.Equal(tc.wantIds, gotIds)
+		})
+	}
+
+	t.Run("unreadable roles", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		// The caller can read the org role, but not the project role.
+		at := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+		r := iam.TestRole(t, conn, o.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=managed-group;actions=list,read")
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), fmt.Sprintf("id=%s;actions=read", orgRole.GetPublicId()))
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", "http://127.0.0.1/v1/managed-groups", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		requestCtx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		requestCtx = context.WithValue(requestCtx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+
+		got, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), IncludeRoleCount: true})
+		require.NoError(err)
+		gotRoles := make(map[string]uint32, len(got.GetItems()))
+		for _, item := range got.GetItems() {
+			gotRoles[item.GetId()] = item.GetRoleCount().GetValue()
+		}
+		assert.Equal(map[string]uint32{orphan.GetPublicId(): 0, one.GetPublicId(): 1, two.GetPublicId(): 1}, gotRoles)
+
+		// A managed group only in roles the caller can't read is in none.
+		onlyProj := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("only-proj"))
+		iam.TestManagedGroupRole(t, conn, projRole.GetPublicId(), onlyProj.GetPublicId())
+		got, err = s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), RoleAssociation: "none"})
+		require.NoError(err)
+		var gotIds []string
+		for _, item := range got.GetItems() {
+			gotIds = append(gotIds, item.GetId())
+		}
+		wantIds := []string{orphan.GetPublicId(), onlyProj.GetPublicId()}
+		sort.Strings(wantIds)
+		assert.Equal(wantIds, gotIds)
+	})
+}
+
+// testStreamManagedGroupsServer records the items sent on a
+// StreamManagedGroups stream. If set, onSend is called after each item is
+// recorded.
+type testStreamManagedGroupsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	onSend func()
+	items  []*pb.ManagedGroup
+}
+
+func (s *testStreamManagedGroupsServer) Context() context.Context { return s.ctx }
+
+func (s *testStreamManagedGroupsServer) Send(resp *pbs.StreamManagedGroupsResponse) error {
+	s.items = append(s.items, resp.GetItem())
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
+
+func TestStreamManagedGroups(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	orphan := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("orphan"))
+	one := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("one"))
+	two := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("two"))
+	iam.TestManagedGroupRole(t, conn, iam.TestRole(t, conn, o.GetPublicId()).GetPublicId(), two.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	cases := []struct {
+		name    string
+		req     *pbs.StreamManagedGroupsRequest
+		wantIds []string
+	}{
+		{
+			name:    "all",
+			req:     &pbs.StreamManagedGroupsRequest{},
+			wantIds: []string{orphan.GetPublicId(), one.GetPublicId(), two.GetPublicId()},
+		},
+		{
+			name:    "with filter",
+			req:     &pbs.StreamManagedGroupsRequest{Filter: `"/item/name" == "one"`},
+			wantIds: []string{one.GetPublicId()},
+		},
+		{
+			name:    "id only",
+			req:     &pbs.StreamManagedGroupsRequest{IdOnly: true, Filter: `"/item/name" != "one"`},
+			wantIds: []string{orphan.GetPublicId(), two.GetPublicId()},
+		},
+		{
+			name:    "role association",
+			req:     &pbs.StreamManagedGroupsRequest{RoleAssociation: "none", IncludeRoleCount: true},
+			wantIds: []string{orphan.GetPublicId(), one.GetPublicId()},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tc.req.AuthMethodId = am.GetPublicId()
+			stream := &testStreamManagedGroupsServer{ctx: authCtx}
+			require.NoError(s.StreamManagedGroups(tc.req, stream))
+
+			// Each item is sent as ListManagedGroups lists it.
+			list, err := s.ListManagedGroups(authCtx, &pbs.ListManagedGroupsRequest{
+				AuthMethodId:     tc.req.GetAuthMethodId(),
+				Filter:           tc.req.GetFilter(),
+				IdOnly:           tc.req.GetIdOnly(),
+				RoleAssociation:  tc.req.GetRoleAssociation(),
+				IncludeRoleCount: tc.req.GetIncludeRoleCount(),
+			})
+			require.NoError(err)
+			assert.Empty(cmp.Diff(list.GetItems(), stream.items, protocmp.Transform()))
+
+			var gotIds []string
+			for _, item := range stream.items {
+				gotIds = append(gotIds, item.GetId())
+			}
+			sort.Strings(tc.wantIds)
+			assert.Equal(tc.wantIds, gotIds)
+		})
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		// The client goes away after the first item.
+		streamCtx, cancel := context.WithCancel(authCtx)
+		defer cancel()
+		stream := &testStreamManagedGroupsServer{ctx: streamCtx, onSend: cancel}
+		err := s.StreamManagedGroups(&pbs.StreamManagedGroupsRequest{AuthMethodId: am.GetPublicId()}, stream)
+		require.Error(t, err)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Len(t, stream.items, 1)
+	})
+}
+
+// testMembershipReportServer records the responses sent on a
+// GenerateManagedGroupMembershipReport stream.
+type testMembershipReportServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*pbs.GenerateManagedGroupMembershipReportResponse
+}
+
+func (s *testMembershipReportServer) Context() context.Context { return s.ctx }
+
+func (s *testMembershipReportServer) Send(resp *pbs.GenerateManagedGroupMembershipReportResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestGenerateManagedGroupMembershipReport(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	alice := oidc.TestAccount(t, conn, am, "alice")
+	bob := oidc.TestAccount(t, conn, am, "bob")
+
+	// A managed group without members isn't reported.
+	oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	one := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, one.GetPublicId(), alice.GetPublicId())
+	two := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, two.GetPublicId(), alice.GetPublicId())
+	oidc.TestManagedGroupMember(t, conn, two.GetPublicId(), bob.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	stream := &testMembershipReportServer{ctx: authCtx}
+	require.NoError(t, s.GenerateManagedGroupMembershipReport(&pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: am.GetPublicId()}, stream))
+
+	want := map[string][]string{
+		one.GetPublicId(): {alice.GetPublicId()},
+		two.GetPublicId(): {alice.GetPublicId(), bob.GetPublicId()},
+	}
+	for _, ids := range want {
+		sort.Strings(ids)
+	}
+	got := map[string][]string{}
+	var gotGroupIds []string
+	for _, resp := range stream.responses {
+		gotGroupIds = append(gotGroupIds, resp.GetManagedGroupId())
+		for _, m := range resp.GetMembers() {
+			assert.NotNil(t, m.GetCreatedTime())
+			got[resp.GetManagedGroupId()] = append(got[resp.GetManagedGroupId()], m.GetAccountId())
+		}
+	}
+	assert.Equal(t, want, got)
+	assert.True(t, sort.StringsAreSorted(gotGroupIds))
+
+	t.Run("invalid auth method id", func(t *testing.T) {
+		err := s.GenerateManagedGroupMembershipReport(&pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: "bad_id"}, &testMembershipReportServer{ctx: authCtx})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("missing auth method", func(t *testing.T) {
+		err := s.GenerateManagedGroupMembershipReport(&pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_DoesntExis"}, &testMembershipReportServer{ctx: authCtx})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGenerateManagedGroupMembershipReport_authorization(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), oidc.TestAccount(t, conn, am, "alice").GetPublicId())
+
+	requestCtx := func(grants ...string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		for _, g := range grants {
+			_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), g)
+		}
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", "http://127.0.0.1/v1/managed-groups:membership-report", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+
+	req := &pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: am.GetPublicId()}
+	err := s.GenerateManagedGroupMembershipReport(req, &testMembershipReportServer{ctx: requestCtx("id=*;type=managed-group;actions=read")})
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	stream := &testMembershipReportServer{ctx: requestCtx("id=*;type=managed-group;actions=read", "id=*;type=auth-method;actions=update")}
+	require.NoError(t, s.GenerateManagedGroupMembershipReport(req, stream))
+	require.Len(t, stream.responses, 1)
+	assert.Equal(t, mg.GetPublicId(), stream.responses[0].GetManagedGroupId())
+}
+
+func TestListManagedGroupMembers(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	var accountIds []string
+	for _, name := range []string{"alice", "bob", "carol"} {
+		accountIds = append(accountIds, oidc.TestAccount(t, conn, am, name).GetPublicId())
+	}
+	sort.Strings(accountIds)
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	// Members matched at login are recorded as such.
+	loginMg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroupMember(t, conn, loginMg.GetPublicId(), accountIds[0])
+	got, err := s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: loginMg.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, got.GetItems(), 1)
+	assert.Equal(t, accountIds[0], got.GetItems()[0].GetAccountId())
+	assert.Equal(t, "login", got.GetItems()[0].GetSource())
+	assert.NotNil(t, got.GetItems()[0].GetCreatedTime())
+	assert.Empty(t, got.GetNextPageToken())
+
+	// Initial members weren't matched, so they're recorded as synced.
+	created, err := s.CreateManagedGroup(authCtx, &pbs.CreateManagedGroupRequest{
+		Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Type:         oidc.Subtype.String(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: oidc.TestFakeManagedGroupFilter,
+				},
+			},
+		},
+		InitialMembers: accountIds,
+	})
+	require.NoError(t, err)
+
+	var gotIds []string
+	req := &pbs.ListManagedGroupMembersRequest{Id: created.GetItem().GetId(), PageSize: 2}
+	for {
+		got, err := s.ListManagedGroupMembers(authCtx, req)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(got.GetItems()), 2)
+		for _, m := range got.GetItems() {
+			assert.Equal(t, "sync", m.GetSource())
+			gotIds = append(gotIds, m.GetAccountId())
+		}
+		if got.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = got.GetNextPageToken()
+	}
+	// The initial members became members together, so they're ordered by
+	// account id.
+	assert.Equal(t, accountIds, gotIds)
+
+	t.Run("membership added while paging", func(t *testing.T) {
+		mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), accountIds[1])
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), accountIds[2])
+		got, err := s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: mg.GetPublicId(), PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, got.GetItems(), 1)
+		require.NotEmpty(t, got.GetNextPageToken())
+		gotIds := []string{got.GetItems()[0].GetAccountId()}
+
+		// The account sorts first by id but became a member last, so it
+		// comes after the members already listed.
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), accountIds[0])
+		got, err = s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: mg.GetPublicId(), PageToken: got.GetNextPageToken()})
+		require.NoError(t, err)
+		assert.Empty(t, got.GetNextPageToken())
+		for _, m := range got.GetItems() {
+			gotIds = append(gotIds, m.GetAccountId())
+		}
+		assert.Equal(t, []string{accountIds[1], accountIds[2], accountIds[0]}, gotIds)
+	})
+	t.Run("page token of another managed group", func(t *testing.T) {
+		got, err := s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: created.GetItem().GetId(), PageSize: 1})
+		require.NoError(t, err)
+		_, err = s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: loginMg.GetPublicId(), PageToken: got.GetNextPageToken()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, err := s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: "bad_id"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("missing managed group", func(t *testing.T) {
+		_, err := s.ListManagedGroupMembers(authCtx, &pbs.ListManagedGroupMembersRequest{Id: globals.OidcManagedGroupPrefix + "_DoesntExis"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListLdap(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	amNoManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://no-managed-groups"})
+	amSomeManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://some-managed-groups"})
+	amOtherManagedGroups := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://other-managed-groups"})
+
+	testGroups := []string{"admin", "users"}
+	var wantSomeManagedGroups []*pb.ManagedGroup
+	for i := 0; i < 3; i++ {
+		mg := ldap.TestManagedGroup(t, conn, amSomeManagedGroups, testGroups, ldap.WithName(ctx, strconv.Itoa(i)))
+		wantSomeManagedGroups = append(wantSomeManagedGroups, &pb.ManagedGroup{
+			Id:             mg.GetPublicId(),
+			AuthMethodId:   mg.GetAuthMethodId(),
+			AuthMethodType: ldap.Subtype.String(),
+			Kind:           "login",
+			Name:           wrapperspb.String(strconv.Itoa(i)),
+			CreatedTime:    mg.GetCreateTime().GetTimestamp(),
+			UpdatedTime:    mg.GetUpdateTime().GetTimestamp(),
+			Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+			Version:        1,
+			Type:           ldap.Subtype.String(),
+			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+					GroupNames: testGroups,
+				},
+			},
+			AuthorizedActions: ldapAuthorizedActions,
+		})
+	}
+
+	var wantOtherManagedGroups []*pb.ManagedGroup
+	for i := 0; i < 3; i++ {
+		mg := ldap.TestManagedGroup(t, conn, amOtherManagedGroups, testGroups, ldap.WithName(ctx, strconv.Itoa(i)))
+		wantOtherManagedGroups = append(wantOtherManagedGroups, &pb.ManagedGroup{
+			Id:             mg.GetPublicId(),
+			AuthMethodId:   mg.GetAuthMethodId(),
+			AuthMethodType: ldap.Subtype.String(),
+			Kind:           "login",
+			Name:           wrapperspb.String(strconv.Itoa(i)),
+			CreatedTime:    mg.GetCreateTime().GetTimestamp(),
+			UpdatedTime:    mg.GetUpdateTime().GetTimestamp(),
+			Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+			Version:        1,
+			Type:           ldap.Subtype.String(),
+			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+					GroupNames: testGroups,
+				},
+			},
+			AuthorizedActions: ldapAuthorizedActions,
+		})
+	}
+
+	cases := []struct {
+		name        string
+		req         *pbs.ListManagedGroupsRequest
 		res         *pbs.ListManagedGroupsResponse
 		err         error
 		errContains string
@@ -534,179 +1649,2792 @@ func TestListLdap(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new managed group service.")
 
-			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
-			if tc.err != nil {
-				require.Error(gErr)
-				assert.True(errors.Is(gErr, tc.err), "ListManagedGroups() with auth method %q got error %v, wanted %v", tc.req, gErr, tc.err)
-				require.NotEmpty(tc.errContains)
-				assert.Contains(gErr.Error(), tc.errContains)
-				return
-			} else {
-				require.NoError(gErr)
-			}
-			sort.Slice(got.Items, func(i, j int) bool {
-				return strings.Compare(got.Items[i].GetName().GetValue(),
-					got.Items[j].GetName().GetValue()) < 0
-			})
-			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListManagedGroups() with scope %q got response %q, wanted %q", tc.req, got, tc.res)
+			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListManagedGroups() with auth method %q got error %v, wanted %v", tc.req, gErr, tc.err)
+				require.NotEmpty(tc.errContains)
+				assert.Contains(gErr.Error(), tc.errContains)
+				return
+			} else {
+				require.NoError(gErr)
+			}
+			sort.Slice(got.Items, func(i, j int) bool {
+				return strings.Compare(got.Items[i].GetName().GetValue(),
+					got.Items[j].GetName().GetValue()) < 0
+			})
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform(), protocmp.IgnoreFields(&pbs.ListManagedGroupsResponse{}, "item_count")), "ListManagedGroups() with scope %q got response %q, wanted %q", tc.req, got, tc.res)
+			assert.EqualValues(len(tc.res.GetItems()), got.GetItemCount())
+
+			// Now test with anon
+			if tc.skipAnon {
+				return
+			}
+			got, gErr = s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId(), auth.WithUserId(globals.AnonymousUserId)), tc.req)
+			require.NoError(gErr)
+			assert.Len(got.Items, len(tc.res.Items))
+			for _, g := range got.GetItems() {
+				assert.Nil(g.Attrs)
+				assert.Nil(g.CreatedTime)
+				assert.Nil(g.UpdatedTime)
+				assert.Empty(g.Version)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	oidcAm := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"})
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	cases := []struct {
+		name        string
+		scope       string
+		req         *pbs.DeleteManagedGroupRequest
+		res         *pbs.DeleteManagedGroupResponse
+		err         error
+		errContains string
+	}{
+		{
+			name: "Delete an existing oidc managed group",
+			req: &pbs.DeleteManagedGroupRequest{
+				Id: oidcMg.GetPublicId(),
+			},
+		},
+		{
+			name: "Delete bad oidc managed group id",
+			req: &pbs.DeleteManagedGroupRequest{
+				Id: globals.OidcManagedGroupPrefix + "_doesntexis",
+			},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: "Resource not found.",
+		},
+		{
+			name: "Delete an existing ldap managed group",
+			req: &pbs.DeleteManagedGroupRequest{
+				Id: ldapMg.GetPublicId(),
+			},
+		},
+		{
+			name: "Delete bad ldap managed group id",
+			req: &pbs.DeleteManagedGroupRequest{
+				Id: globals.LdapManagedGroupPrefix + "_doesntexis",
+			},
+			err:         handlers.ApiErrorWithCode(codes.NotFound),
+			errContains: "Resource not found.",
+		},
+		{
+			name: "Bad managed group id formatting",
+			req: &pbs.DeleteManagedGroupRequest{
+				Id: "bad_format",
+			},
+			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errContains: "Incorrectly formatted identifier.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DeleteManagedGroup(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				require.NotEmpty(tc.errContains)
+				assert.Contains(gErr.Error(), tc.errContains)
+			}
+			assert.EqualValuesf(tc.res, got, "DeleteManagedGroup(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestDelete_version(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, databaseWrapper, oidcAm := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.databaseWrapper, env.am
+
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"})
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new managed group service.")
+	reqCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	for _, mg := range []interface {
+		GetPublicId() string
+		GetVersion() uint32
+	}{oidcMg, ldapMg} {
+		t.Run(mg.GetPublicId(), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, err := s.DeleteManagedGroup(reqCtx, &pbs.DeleteManagedGroupRequest{Id: mg.GetPublicId(), Version: mg.GetVersion() + 1})
+			require.Error(err)
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v, wanted failed precondition", err)
+			assert.Contains(err.Error(), "managed group is at version")
+
+			// The managed group wasn't deleted so it can still be deleted at
+			// its current version.
+			_, err = s.DeleteManagedGroup(reqCtx, &pbs.DeleteManagedGroupRequest{Id: mg.GetPublicId(), Version: mg.GetVersion()})
+			require.NoError(err)
+			_, err = s.GetManagedGroup(reqCtx, &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v, wanted not found", err)
+		})
+	}
+}
+
+func TestTouchManagedGroup(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, databaseWrapper, oidcAm := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.databaseWrapper, env.am
+
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter, oidc.WithName("oidc"))
+
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"}, ldap.WithName(ctx, "ldap"))
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new managed group service.")
+	reqCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	for _, mg := range []interface {
+		GetPublicId() string
+		GetVersion() uint32
+	}{oidcMg, ldapMg} {
+		t.Run(mg.GetPublicId(), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			before, err := s.GetManagedGroup(reqCtx, &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+			require.NoError(err)
+
+			got, err := s.TouchManagedGroup(reqCtx, &pbs.TouchManagedGroupRequest{Id: mg.GetPublicId(), Version: mg.GetVersion()})
+			require.NoError(err)
+			assert.Equal(mg.GetVersion()+1, got.GetItem().GetVersion())
+			assert.True(got.GetItem().GetUpdatedTime().AsTime().After(before.GetItem().GetUpdatedTime().AsTime()))
+
+			// Nothing but the version and updated time changed.
+			after, err := s.GetManagedGroup(reqCtx, &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+			require.NoError(err)
+			assert.Empty(cmp.Diff(before.GetItem(), after.GetItem(), protocmp.Transform(),
+				protocmp.IgnoreFields(&pb.ManagedGroup{}, "version", "updated_time")))
+
+			// The version is checked.
+			_, err = s.TouchManagedGroup(reqCtx, &pbs.TouchManagedGroupRequest{Id: mg.GetPublicId(), Version: mg.GetVersion()})
+			require.Error(err)
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v, wanted not found", err)
+		})
+	}
+}
+
+func TestDelete_twice(t *testing.T) {
+	ctx := context.TODO()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	oidcAm := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(err, "Error when getting new user service")
+	req := &pbs.DeleteManagedGroupRequest{
+		Id: oidcMg.GetPublicId(),
+	}
+	_, gErr := s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), req)
+	assert.NoError(gErr, "First attempt")
+	_, gErr = s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), req)
+	assert.Error(gErr, "Second attempt")
+	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected not found for the second delete.")
+}
+
+func TestDelete_returnDeleted(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, oidcAm := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+	otherMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(err, "Error when getting new user service")
+	reqCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	// Without return_deleted no response is returned.
+	got, err := s.DeleteManagedGroup(reqCtx, &pbs.DeleteManagedGroupRequest{Id: otherMg.GetPublicId()})
+	require.NoError(err)
+	assert.Nil(got)
+
+	req := &pbs.DeleteManagedGroupRequest{
+		Id:            oidcMg.GetPublicId(),
+		ReturnDeleted: true,
+	}
+	got, err = s.DeleteManagedGroup(reqCtx, req)
+	require.NoError(err, "First attempt")
+	assert.True(got.GetDeleted())
+	got, err = s.DeleteManagedGroup(reqCtx, req)
+	require.NoError(err, "Second attempt")
+	require.NotNil(got)
+	assert.False(got.GetDeleted())
+}
+
+func TestDelete_rolePrincipal(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, databaseWrapper, oidcAm := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.databaseWrapper, env.am
+
+	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"})
+
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, role.GetPublicId(), oidcMg.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, role.GetPublicId(), ldapMg.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(err, "Error when getting new managed group service")
+
+	for _, id := range []string{oidcMg.GetPublicId(), ldapMg.GetPublicId()} {
+		_, gErr := s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.DeleteManagedGroupRequest{Id: id})
+		require.Error(gErr, "Delete without force")
+		assert.Contains(gErr.Error(), role.GetPublicId())
+
+		got, gErr := s.GetManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.GetManagedGroupRequest{Id: id})
+		require.NoError(gErr, "Managed group should still exist")
+		assert.Equal(id, got.GetItem().GetId())
+
+		_, gErr = s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.DeleteManagedGroupRequest{Id: id, Force: true})
+		require.NoError(gErr, "Delete with force")
+	}
+
+	iamRepo, err := iamRepoFn()
+	require.NoError(err)
+	principals, err := iamRepo.ListPrincipalRoles(ctx, role.GetPublicId())
+	require.NoError(err)
+	assert.Empty(principals)
+}
+
+func TestPreviewManagedGroupMatches(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	env := newServiceTestEnv(t)
+	conn, iamRepoFn, o, am := env.conn, env.iamRepoFn, env.org, env.am
+	s := env.service(t)
+
+	alice := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`)
+	emails := oidc.TestManagedGroup(t, conn, am, `"/userinfo/email" matches ".*@example.com"`)
+
+	claims, err := structpb.NewStruct(map[string]any{
+		"token":    map[string]any{"sub": "alice"},
+		"userinfo": map[string]any{"email": "alice@example.com"},
+	})
+	require.NoError(err)
+	got, err := s.PreviewManagedGroupMatches(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.PreviewManagedGroupMatchesRequest{
+		AuthMethodId: am.GetPublicId(),
+		Claims:       claims,
+	})
+	require.NoError(err)
+	assert.False(got.GetTruncated())
+	assert.ElementsMatch([]string{alice.GetPublicId(), emails.GetPublicId()}, got.GetManagedGroupIds())
+}
+
+func TestGetManagedGroupFilterCapabilities(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new managed group service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	oidcAm := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+		oidc.WithClaimAliases(map[string]string{"roles": "/userinfo/roles", "groups": "/token/groups"}),
+	)
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+
+	cases := []struct {
+		name string
+		req  *pbs.GetManagedGroupFilterCapabilitiesRequest
+		want *pbs.GetManagedGroupFilterCapabilitiesResponse
+	}{
+		{
+			name: "oidc",
+			req:  &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: oidcAm.GetPublicId()},
+			want: &pbs.GetManagedGroupFilterCapabilitiesResponse{
+				AuthMethodType:   oidc.Subtype.String(),
+				FiltersSupported: true,
+				Operators:        oidc.ManagedGroupFilterOperators(),
+				SelectorPrefixes: []string{"/token/", "/userinfo/"},
+				ClaimAliases: []*pbs.ManagedGroupFilterClaimAlias{
+					{Alias: "groups", Selector: "/token/groups"},
+					{Alias: "roles", Selector: "/userinfo/roles"},
+				},
+			},
+		},
+		{
+			name: "ldap",
+			req:  &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: ldapAm.GetPublicId()},
+			want: &pbs.GetManagedGroupFilterCapabilitiesResponse{AuthMethodType: ldap.Subtype.String()},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := s.GetManagedGroupFilterCapabilities(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tc.want, got, protocmp.Transform()))
+		})
+	}
+
+	_, err = s.GetManagedGroupFilterCapabilities(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_DoesntExis"})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v", err)
+}
+
+func TestCreate_maxPerAuthMethod(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMaxManagedGroupsPerAuthMethod(2))
+	require.NoError(t, err, "Error when getting new managed group service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	other := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap2"})
+
+	newItem := func(amId, name string) *pb.ManagedGroup {
+		return &pb.ManagedGroup{
+			AuthMethodId: amId,
+			Name:         wrapperspb.String(name),
+			Type:         ldap.Subtype.String(),
+			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+					GroupNames: []string{"admin"},
+				},
+			},
+		}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	_, err = s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: newItem(am.GetPublicId(), "first")})
+	require.NoError(t, err)
+	_, err = s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: newItem(am.GetPublicId(), "second")})
+	require.NoError(t, err)
+
+	_, err = s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: newItem(am.GetPublicId(), "third")})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.ResourceExhausted)), "got error %v", err)
+	assert.Contains(t, err.Error(), "maximum of 2 managed groups")
+
+	// An upsert which would create a managed group is capped too, but one
+	// which updates an existing managed group isn't.
+	_, err = s.UpsertManagedGroup(requestCtx, &pbs.UpsertManagedGroupRequest{Item: newItem(am.GetPublicId(), "third")})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.ResourceExhausted)), "got error %v", err)
+	_, err = s.UpsertManagedGroup(requestCtx, &pbs.UpsertManagedGroupRequest{Item: newItem(am.GetPublicId(), "first")})
+	require.NoError(t, err)
+
+	// The cap applies to each auth method.
+	_, err = s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: newItem(other.GetPublicId(), "first")})
+	require.NoError(t, err)
+}
+
+func TestUpdate_preview(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new managed group service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+	mg := ldap.TestManagedGroup(t, conn, am, []string{"admin"}, ldap.WithName(ctx, "admins"))
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	newReq := func(version uint32) *pbs.UpdateManagedGroupRequest {
+		return &pbs.UpdateManagedGroupRequest{
+			Id: mg.GetPublicId(),
+			Item: &pb.ManagedGroup{
+				Version: version,
+				Name:    wrapperspb.String("operators"),
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin", "ops"},
+					},
+				},
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "attributes.group_names"}},
+			Preview:    true,
+		}
+	}
+	got, err := s.UpdateManagedGroup(requestCtx, newReq(mg.GetVersion()))
+	require.NoError(t, err)
+	assert.Equal(t, "operators", got.GetItem().GetName().GetValue())
+	assert.Equal(t, []string{"admin", "ops"}, got.GetItem().GetLdapManagedGroupAttributes().GetGroupNames())
+	assert.Equal(t, fmt.Sprintf("managed-groups/%s", mg.GetPublicId()), got.GetItem().GetUri())
+	require.Len(t, got.GetChanges(), 2)
+	assert.Equal(t, "name", got.GetChanges()[0].GetField())
+	assert.Equal(t, "admins", got.GetChanges()[0].GetBefore().GetStringValue())
+	assert.Equal(t, "operators", got.GetChanges()[0].GetAfter().GetStringValue())
+	assert.Equal(t, "attributes.group_names", got.GetChanges()[1].GetField())
+
+	// Nothing was written.
+	current, err := s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, "admins", current.GetItem().GetName().GetValue())
+	assert.Equal(t, mg.GetVersion(), current.GetItem().GetVersion())
+
+	// The preview-update binding has its own method, which always previews.
+	req := newReq(mg.GetVersion())
+	previewed, err := s.PreviewUpdateManagedGroup(requestCtx, &pbs.PreviewUpdateManagedGroupRequest{
+		Id:         req.GetId(),
+		Item:       req.GetItem(),
+		UpdateMask: req.GetUpdateMask(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "operators", previewed.GetItem().GetName().GetValue())
+	assert.Len(t, previewed.GetChanges(), 2)
+	current, err = s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, "admins", current.GetItem().GetName().GetValue())
+
+	_, err = s.UpdateManagedGroup(requestCtx, newReq(mg.GetVersion()+1))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+
+	// Names are unique with case, so a name differing only by case from
+	// another managed group's doesn't fail the preview.
+	ldap.TestManagedGroup(t, conn, am, []string{"ops"}, ldap.WithName(ctx, "OPERATORS"))
+	_, err = s.UpdateManagedGroup(requestCtx, newReq(mg.GetVersion()))
+	require.NoError(t, err)
+
+	// A name in use by another managed group fails the preview as it would
+	// fail the update.
+	ldap.TestManagedGroup(t, conn, am, []string{"ops"}, ldap.WithName(ctx, "operators"))
+	_, err = s.UpdateManagedGroup(requestCtx, newReq(mg.GetVersion()))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+}
+
+func TestCreate_rateLimited(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMutationRateLimit(rate.Every(time.Hour), 1))
+	require.NoError(t, err, "Error when getting new managed group service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
+
+	newReq := func(name string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Name:         wrapperspb.String(name),
+			Type:         ldap.Subtype.String(),
+			Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+				LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+					GroupNames: []string{"admin"},
+				},
+			},
+		}}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	_, err = s.CreateManagedGroup(requestCtx, newReq("first"))
+	require.NoError(t, err)
+
+	_, err = s.CreateManagedGroup(requestCtx, newReq("second"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.ResourceExhausted)), "got error %v", err)
+	assert.Contains(t, err.Error(), "retry after")
+
+	// Reads are not limited by default
+	got, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(t, err)
+	assert.Len(t, got.GetItems(), 1)
+}
+
+func TestExportManagedGroups(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, iamRepoFn, org, databaseWrapper, oidcAm := env.ctx, env.conn, env.iamRepoFn, env.org, env.databaseWrapper, env.am
+	s := env.service(t)
+
+	oidc.TestManagedGroup(t, conn, oidcAm, `"/token/sub" == "bob"`, oidc.WithName("bob"))
+	oidc.TestManagedGroup(t, conn, oidcAm, `"/token/sub" == "alice"`, oidc.WithName("alice"), oidc.WithDescription("alice's group"))
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "dev"}, ldap.WithName(ctx, "admins"))
+	emptyAm := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap2"})
+
+	cases := []struct {
+		name string
+		req  *pbs.ExportManagedGroupsRequest
+		want *pbs.ManagedGroupsDocument
+	}{
+		{
+			name: "oidc",
+			req:  &pbs.ExportManagedGroupsRequest{AuthMethodId: oidcAm.GetPublicId()},
+			want: &pbs.ManagedGroupsDocument{
+				Version: 1,
+				Type:    oidc.Subtype.String(),
+				ManagedGroups: []*pbs.ManagedGroupDefinition{
+					{Name: "alice", Description: "alice's group", Filter: `"/token/sub" == "alice"`},
+					{Name: "bob", Filter: `"/token/sub" == "bob"`},
+				},
+			},
+		},
+		{
+			name: "ldap",
+			req:  &pbs.ExportManagedGroupsRequest{AuthMethodId: ldapAm.GetPublicId()},
+			want: &pbs.ManagedGroupsDocument{
+				Version: 1,
+				Type:    ldap.Subtype.String(),
+				ManagedGroups: []*pbs.ManagedGroupDefinition{
+					{Name: "admins", GroupNames: []string{"admin", "dev"}},
+				},
+			},
+		},
+		{
+			name: "empty",
+			req:  &pbs.ExportManagedGroupsRequest{AuthMethodId: emptyAm.GetPublicId()},
+			want: &pbs.ManagedGroupsDocument{
+				Version: 1,
+				Type:    ldap.Subtype.String(),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := s.ExportManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), tc.req)
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tc.want, got.GetDocument(), protocmp.Transform()))
+		})
+	}
+}
+
+func TestImportManagedGroups(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Couldn't create new managed groups service.")
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	newAm := func(clientId string) *oidc.AuthMethod {
+		return oidc.TestAuthMethod(
+			t, conn, databaseWrapper, org.PublicId, oidc.ActivePrivateState,
+			clientId, "fido",
+			oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+			oidc.WithSigningAlgs(oidc.RS256),
+			oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+		)
+	}
+	doc := &pbs.ManagedGroupsDocument{
+		Version: 1,
+		Type:    oidc.Subtype.String(),
+		ManagedGroups: []*pbs.ManagedGroupDefinition{
+			{Name: "alice", Description: "alice's group", Filter: `"/token/sub" == "alice"`},
+			{Name: "bad", Filter: "foobar"},
+			{Name: "bob", Filter: `"/token/sub" == "bob"`},
+			{Name: "alice", Filter: `"/token/sub" == "alice2"`},
+		},
+	}
+	statuses := func(resp *pbs.ImportManagedGroupsResponse) []string {
+		var out []string
+		for _, r := range resp.GetResults() {
+			out = append(out, r.GetStatus())
+		}
+		return out
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())
+	oidcRepo, err := oidcRepoFn()
+	require.NoError(t, err)
+
+	t.Run("import", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := newAm("import")
+		got, err := s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc})
+		require.NoError(err)
+		assert.Equal([]string{"created", "failed", "created", "failed"}, statuses(got))
+		assert.Contains(got.GetResults()[1].GetError(), "attributes.filter")
+		assert.Contains(got.GetResults()[3].GetError(), "earlier managed group")
+
+		alice, err := oidcRepo.LookupManagedGroupByName(ctx, am.GetPublicId(), "alice")
+		require.NoError(err)
+		require.NotNil(alice)
+		assert.Equal(got.GetResults()[0].GetId(), alice.GetPublicId())
+		assert.Equal("alice's group", alice.GetDescription())
+		assert.Equal(`"/token/sub" == "alice"`, alice.GetFilter())
+
+		// Importing again fails every group unless existing ones are skipped
+		got, err = s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc})
+		require.NoError(err)
+		assert.Equal([]string{"failed", "failed", "failed", "failed"}, statuses(got))
+		got, err = s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc, SkipExisting: true})
+		require.NoError(err)
+		assert.Equal([]string{"skipped", "failed", "skipped", "failed"}, statuses(got))
+		assert.Equal(alice.GetPublicId(), got.GetResults()[0].GetId())
+	})
+	t.Run("names differing by case", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := newAm("case")
+		// Names are unique with case, so these don't collide with the
+		// imported alice and bob.
+		upper := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`, oidc.WithName("ALICE"))
+		oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`, oidc.WithName("Bob"))
+		got, err := s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc, SkipExisting: true})
+		require.NoError(err)
+		assert.Equal([]string{"created", "failed", "created", "failed"}, statuses(got))
+		assert.NotEqual(upper.GetPublicId(), got.GetResults()[0].GetId())
+	})
+	t.Run("dry run", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := newAm("dry-run")
+		oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`, oidc.WithName("bob"))
+		got, err := s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc, SkipExisting: true, DryRun: true})
+		require.NoError(err)
+		assert.Equal([]string{"would_create", "failed", "skipped", "failed"}, statuses(got))
+
+		mgs, err := oidcRepo.ListManagedGroups(ctx, am.GetPublicId())
+		require.NoError(err)
+		assert.Len(mgs, 1)
+	})
+	t.Run("validated as when created", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithRejectMatchAllFilters(true))
+		require.NoError(err)
+		am := newAm("validated")
+		doc := &pbs.ManagedGroupsDocument{
+			Version: 1,
+			Type:    oidc.Subtype.String(),
+			ManagedGroups: []*pbs.ManagedGroupDefinition{
+				{Name: "everyone", Filter: `"/token/sub" != ""`},
+				{Name: "aliased", Filter: `@roles contains "admin"`},
+			},
+		}
+		got, err := s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc})
+		require.NoError(err)
+		assert.Equal([]string{"failed", "failed"}, statuses(got))
+		assert.Contains(got.GetResults()[0].GetError(), "match every authenticated user")
+		assert.Contains(got.GetResults()[1].GetError(), "undefined claim alias @roles")
+
+		got, err = s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Document: doc, AllowMatchAll: true})
+		require.NoError(err)
+		assert.Equal([]string{"created", "failed"}, statuses(got))
+	})
+	t.Run("round trip", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		from, to := newAm("export-from"), newAm("import-to")
+		oidc.TestManagedGroup(t, conn, from, `"/token/sub" == "carol"`, oidc.WithName("carol"))
+		oidc.TestManagedGroup(t, conn, from, `"/token/sub" == "dave"`, oidc.WithName("dave"))
+		exported, err := s.ExportManagedGroups(requestCtx, &pbs.ExportManagedGroupsRequest{AuthMethodId: from.GetPublicId()})
+		require.NoError(err)
+		_, err = s.ImportManagedGroups(requestCtx, &pbs.ImportManagedGroupsRequest{AuthMethodId: to.GetPublicId(), Document: exported.GetDocument()})
+		require.NoError(err)
+		reexported, err := s.ExportManagedGroups(requestCtx, &pbs.ExportManagedGroupsRequest{AuthMethodId: to.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(exported.GetDocument(), reexported.GetDocument(), protocmp.Transform()))
+	})
+}
+
+func TestHideUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	mg := ldap.TestManagedGroup(t, conn, am, []string{"admin"})
+	// The user of this token has no grants on managed groups.
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+
+	requestCtx := func(method, id string) context.Context {
+		req := httptest.NewRequest(method, fmt.Sprintf("http://127.0.0.1/v1/managed-groups/%s", id), nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	missingId := globals.LdapManagedGroupPrefix + "_DoesntExis"
+
+	cases := []struct {
+		name    string
+		hide    bool
+		id      string
+		wantErr error
+	}{
+		{
+			name:    "default existing",
+			id:      mg.GetPublicId(),
+			wantErr: handlers.ForbiddenError(),
+		},
+		{
+			name:    "default missing",
+			id:      missingId,
+			wantErr: handlers.NotFoundError(),
+		},
+		{
+			name:    "hidden existing",
+			hide:    true,
+			id:      mg.GetPublicId(),
+			wantErr: handlers.NotFoundError(),
+		},
+		{
+			name:    "hidden missing",
+			hide:    true,
+			id:      missingId,
+			wantErr: handlers.NotFoundError(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithHideUnauthorized(tc.hide))
+			require.NoError(t, err)
+
+			_, err = s.GetManagedGroup(requestCtx("GET", tc.id), &pbs.GetManagedGroupRequest{Id: tc.id})
+			require.EqualError(t, err, tc.wantErr.Error())
+
+			_, err = s.UpdateManagedGroup(requestCtx("PATCH", tc.id), &pbs.UpdateManagedGroupRequest{
+				Id:         tc.id,
+				Item:       &pb.ManagedGroup{Version: 1, Description: wrapperspb.String("desc")},
+				UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DescriptionField}},
+			})
+			require.EqualError(t, err, tc.wantErr.Error())
+
+			_, err = s.DeleteManagedGroup(requestCtx("DELETE", tc.id), &pbs.DeleteManagedGroupRequest{Id: tc.id})
+			require.EqualError(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestAnonymous(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	failingRepoFn := func() (*oidc.Repository, error) {
+		return nil, errors.New("repo unavailable")
+	}
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("existing"))
+	acct := oidc.TestAccount(t, conn, am, "alice")
+
+	// The request carries no token, so it is made as the anonymous user which
+	// has no grants on managed groups.
+	requestCtx := func() context.Context {
+		requestInfo := authpb.RequestInfo{
+			Path:   "/v1/managed-groups",
+			Method: "GET",
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	claims, err := structpb.NewStruct(map[string]any{"token": map[string]any{"sub": "alice"}})
+	require.NoError(t, err)
+
+	// rpcs calls every RPC of the service with the provided ids.
+	rpcs := func(s managed_groups.Service, amId, mgId, acctId string) map[string]error {
+		item := func() *pb.ManagedGroup {
+			return &pb.ManagedGroup{
+				AuthMethodId: amId,
+				Name:         wrapperspb.String("existing"),
+				Type:         oidc.Subtype.String(),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+				},
+			}
+		}
+		errs := map[string]error{}
+		var err error
+		_, err = s.ListManagedGroups(requestCtx(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId})
+		errs["list"] = err
+		_, err = s.ListManagedGroupsByMember(requestCtx(), &pbs.ListManagedGroupsByMemberRequest{AccountId: acctId})
+		errs["list by member"] = err
+		_, err = s.GetManagedGroup(requestCtx(), &pbs.GetManagedGroupRequest{Id: mgId})
+		errs["get"] = err
+		_, err = s.GetManagedGroup(requestCtx(), &pbs.GetManagedGroupRequest{AuthMethodId: amId, Name: "existing"})
+		errs["get by name"] = err
+		_, err = s.GetManagedGroup(requestCtx(), &pbs.GetManagedGroupRequest{AuthMethodId: amId, Id: mgId[:len(mgId)-2]})
+		errs["get by id prefix"] = err
+		_, err = s.CreateManagedGroup(requestCtx(), &pbs.CreateManagedGroupRequest{Item: item()})
+		errs["create"] = err
+		_, err = s.UpdateManagedGroup(requestCtx(), &pbs.UpdateManagedGroupRequest{
+			Id:         mgId,
+			Item:       &pb.ManagedGroup{Version: 1, Description: wrapperspb.String("desc")},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DescriptionField}},
+		})
+		errs["update"] = err
+		_, err = s.DeleteManagedGroup(requestCtx(), &pbs.DeleteManagedGroupRequest{Id: mgId})
+		errs["delete"] = err
+		_, err = s.UpsertManagedGroup(requestCtx(), &pbs.UpsertManagedGroupRequest{Item: item()})
+		errs["upsert"] = err
+		_, err = s.PreviewManagedGroupMatches(requestCtx(), &pbs.PreviewManagedGroupMatchesRequest{AuthMethodId: amId, Claims: claims})
+		errs["preview matches"] = err
+		_, err = s.ExportManagedGroups(requestCtx(), &pbs.ExportManagedGroupsRequest{AuthMethodId: amId})
+		errs["export"] = err
+		_, err = s.ImportManagedGroups(requestCtx(), &pbs.ImportManagedGroupsRequest{
+			AuthMethodId: amId,
+			Document:     &pbs.ManagedGroupsDocument{Version: 1, Type: oidc.Subtype.String()},
+		})
+		errs["import"] = err
+		_, err = s.RefreshAuthMethodManagedGroups(requestCtx(), &pbs.RefreshAuthMethodManagedGroupsRequest{AuthMethodId: amId})
+		errs["refresh"] = err
+		_, err = s.GetManagedGroupGrants(requestCtx(), &pbs.GetManagedGroupGrantsRequest{Id: mgId})
+		errs["get grants"] = err
+		_, err = s.GetManagedGroupHistory(requestCtx(), &pbs.GetManagedGroupHistoryRequest{Id: mgId})
+		errs["get history"] = err
+		_, err = s.ReplaceInFilters(requestCtx(), &pbs.ReplaceInFiltersRequest{AuthMethodId: amId, OldPrefix: "/token/groups", NewPrefix: "/userinfo/groups"})
+		errs["replace in filters"] = err
+		_, err = s.ValidateStoredFilters(requestCtx(), &pbs.ValidateStoredFiltersRequest{AuthMethodId: amId})
+		errs["validate stored filters"] = err
+		_, err = s.AddManagedGroupToRoles(requestCtx(), &pbs.AddManagedGroupToRolesRequest{Id: mgId, RoleIds: []string{globals.RolePrefix + "_1234567890"}})
+		errs["add to roles"] = err
+		_, err = s.AuthorizeManagedGroup(requestCtx(), &pbs.AuthorizeManagedGroupRequest{Id: mgId, Action: action.Read.String()})
+		errs["authorize"] = err
+		return errs
+	}
+
+	cases := []struct {
+		name               string
+		oidcRepo           common.OidcAuthRepoFactory
+		amId, mgId, acctId string
+	}{
+		{
+			name:     "existing",
+			oidcRepo: oidcRepoFn,
+			amId:     am.GetPublicId(),
+			mgId:     mg.GetPublicId(),
+			acctId:   acct.GetPublicId(),
+		},
+		{
+			name:     "missing",
+			oidcRepo: oidcRepoFn,
+			amId:     globals.OidcAuthMethodPrefix + "_DoesntExis",
+			mgId:     globals.OidcManagedGroupPrefix + "_DoesntExis",
+			acctId:   globals.OidcAccountPrefix + "_DoesntExis",
+		},
+		{
+			name:     "repo unavailable",
+			oidcRepo: failingRepoFn,
+			amId:     am.GetPublicId(),
+			mgId:     mg.GetPublicId(),
+			acctId:   acct.GetPublicId(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := managed_groups.NewService(ctx, tc.oidcRepo, ldapRepoFn, iamRepoFn, managed_groups.WithHideUnauthorized(true))
+			require.NoError(t, err)
+			for rpc, err := range rpcs(s, tc.amId, tc.mgId, tc.acctId) {
+				assert.EqualError(t, err, handlers.UnauthenticatedError().Error(), rpc)
+			}
+		})
+	}
+}
+
+func TestCreateOidc_matchOptions(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	created, err := s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+		AuthMethodId: am.GetPublicId(),
+		Type:         oidc.Subtype.String(),
+		Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+			OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+				Filter:       `"Admins" in "/token/groups"`,
+				MatchOptions: &pb.OidcManagedGroupMatchOptions{CaseInsensitive: true},
+			},
+		},
+	}})
+	require.NoError(t, err)
+	assert.True(t, created.GetItem().GetOidcManagedGroupAttributes().GetMatchOptions().GetCaseInsensitive())
+
+	repo, err := oidcRepoFn()
+	require.NoError(t, err)
+	stored, err := repo.LookupManagedGroup(ctx, created.GetItem().GetId())
+	require.NoError(t, err)
+	matched, err := oidc.MatchManagedGroups(ctx, []*oidc.ManagedGroup{stored}, map[string]any{
+		"token": map[string]any{"groups": []any{"admins"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, matched, 1)
+
+	// Clearing the options restores exact matching.
+	updated, err := s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.ManagedGroup{
+			Version: created.GetItem().GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.match_options"}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, updated.GetItem().GetOidcManagedGroupAttributes().GetMatchOptions())
+	assert.Equal(t, `"Admins" in "/token/groups"`, updated.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+}
+
+func TestCreateOidc_disabled(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	req := &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+		AuthMethodId: am.GetPublicId(),
+		Name:         wrapperspb.String("disabled"),
+		Type:         oidc.Subtype.String(),
+		Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+			OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+				Filter:   oidc.TestFakeManagedGroupFilter,
+				Disabled: true,
+			},
+		},
+	}}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	_, err = s.CreateManagedGroup(requestCtx, req)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+
+	s, err = managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithAllowCreateDisabled(true))
+	require.NoError(t, err)
+	created, err := s.CreateManagedGroup(requestCtx, req)
+	require.NoError(t, err)
+	assert.True(t, created.GetItem().GetOidcManagedGroupAttributes().GetDisabled())
+
+	updated, err := s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.ManagedGroup{
+			Version: created.GetItem().GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.disabled"}},
+	})
+	require.NoError(t, err)
+	assert.False(t, updated.GetItem().GetOidcManagedGroupAttributes().GetDisabled())
+	assert.Equal(t, oidc.TestFakeManagedGroupFilter, updated.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+}
+
+func TestCreateOidc_initialMembers(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	alice := oidc.TestAccount(t, conn, am, "alice")
+	bob := oidc.TestAccount(t, conn, am, "bob")
+	newReq := func(name string, initialMembers ...string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: am.GetPublicId(),
+				Name:         wrapperspb.String(name),
+				Type:         oidc.Subtype.String(),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+				},
+			},
+			InitialMembers: initialMembers,
+		}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	created, err := s.CreateManagedGroup(requestCtx, newReq("seeded", alice.GetPublicId(), bob.GetPublicId()))
+	require.NoError(t, err)
+	got, err := s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{alice.GetPublicId(), bob.GetPublicId()}, got.GetItem().GetMemberIds())
+
+	_, err = s.CreateManagedGroup(requestCtx, newReq("unknown", alice.GetPublicId(), globals.OidcAccountPrefix+"_DoesntExis"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	assert.Contains(t, err.Error(), "initial_members")
+
+	// Nothing is created when an account is unknown.
+	_, err = s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{AuthMethodId: am.GetPublicId(), Name: "unknown"})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()))
+}
+
+func TestCreateOidc_scopeId(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	newReq := func(name, scopeId string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: am.GetPublicId(),
+				Name:         wrapperspb.String(name),
+				Type:         oidc.Subtype.String(),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+				},
+			},
+			ScopeId: scopeId,
+		}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	created, err := s.CreateManagedGroup(requestCtx, newReq("matching", o.GetPublicId()))
+	require.NoError(t, err)
+	assert.Equal(t, o.GetPublicId(), created.GetItem().GetScope().GetId())
+
+	_, err = s.CreateManagedGroup(requestCtx, newReq("mismatched", scope.Global.String()))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	assert.Contains(t, err.Error(), globals.ScopeIdField)
+
+	// Nothing is created when the scope doesn't match.
+	_, err = s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{AuthMethodId: am.GetPublicId(), Name: "mismatched"})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()))
+}
+
+func TestManagedGroupTags(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	untagged := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("untagged"))
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	created, err := s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{
+		Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Name:         wrapperspb.String("tagged"),
+			Tags:         map[string]string{"team": "eng", "env": "prod"},
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "eng", "env": "prod"}, created.GetItem().GetTags())
+	id := created.GetItem().GetId()
+
+	got, err := s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "eng", "env": "prod"}, got.GetItem().GetTags())
+
+	listIds := func(tags ...string) []string {
+		t.Helper()
+		l, err := s.ListManagedGroups(requestCtx, &pbs.ListManagedGroupsRequest{AuthMethodId: am.GetPublicId(), Tags: tags})
+		require.NoError(t, err)
+		var ids []string
+		for _, item := range l.GetItems() {
+			ids = append(ids, item.GetId())
+		}
+		return ids
+	}
+	assert.ElementsMatch(t, []string{id, untagged.GetPublicId()}, listIds())
+	assert.Equal(t, []string{id}, listIds("team=eng"))
+	assert.Equal(t, []string{id}, listIds("team=eng", "env=prod"))
+	assert.Empty(t, listIds("team=eng", "env=dev"))
+
+	// Updating only the tags replaces them and moves to the next version.
+	updated, err := s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id:         id,
+		Item:       &pb.ManagedGroup{Version: got.GetItem().GetVersion(), Tags: map[string]string{"team": "ops"}},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.TagsField}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops"}, updated.GetItem().GetTags())
+	assert.Equal(t, "tagged", updated.GetItem().GetName().GetValue())
+	assert.Equal(t, got.GetItem().GetVersion()+1, updated.GetItem().GetVersion())
+	assert.Empty(t, listIds("team=eng"))
+	assert.Equal(t, []string{id}, listIds("team=ops"))
+
+	// Updating other fields leaves the tags as they are.
+	renamed, err := s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id:         id,
+		Item:       &pb.ManagedGroup{Version: updated.GetItem().GetVersion(), Name: wrapperspb.String("renamed")},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.NameField}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops"}, renamed.GetItem().GetTags())
+
+	// A stale version changes nothing.
+	_, err = s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id:         id,
+		Item:       &pb.ManagedGroup{Version: updated.GetItem().GetVersion()},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.TagsField}},
+	})
+	require.Error(t, err)
+	got, err = s.GetManagedGroup(requestCtx, &pbs.GetManagedGroupRequest{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops"}, got.GetItem().GetTags())
+}
+
+func TestCreateOidc_concurrentSameName(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	const creates = 5
+	errs := make(chan error, creates)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < creates; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := s.CreateManagedGroup(requestCtx, &pbs.CreateManagedGroupRequest{
+				Item: &pb.ManagedGroup{
+					AuthMethodId: am.GetPublicId(),
+					Name:         wrapperspb.String("racing"),
+					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: oidc.TestFakeManagedGroupFilter},
+					},
+				},
+			})
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		var apiErr *handlers.ApiError
+		require.True(t, errors.As(err, &apiErr), "got error %v", err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		require.Len(t, apiErr.Inner.GetDetails().GetRequestFields(), 1)
+		field := apiErr.Inner.GetDetails().GetRequestFields()[0]
+		assert.Equal(t, globals.NameField, field.GetName())
+		assert.Equal(t, managed_groups.FieldErrorAlreadyExists, field.GetCode())
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestCreateOidc_uniqueFilters(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, oidcRepoFn, ldapRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.org, env.am
+
+	existing := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice" and "admin" in "/token/groups"`)
+	other := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`)
+	create := func(filter string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Type:         oidc.Subtype.String(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+			},
+		}}
+	}
+	equivalent := `token.groups contains admin and token.sub == alice`
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	// Equivalent filters are allowed by default.
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	created, err := s.CreateManagedGroup(requestCtx, create(equivalent))
+	require.NoError(t, err)
+	_, err = s.DeleteManagedGroup(requestCtx, &pbs.DeleteManagedGroupRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+
+	s, err = managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithUniqueFilters(true))
+	require.NoError(t, err)
+	_, err = s.CreateManagedGroup(requestCtx, create(equivalent))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	assert.Contains(t, err.Error(), existing.GetPublicId())
+
+	// Updating another managed group to an equivalent filter fails as well,
+	// but a managed group can be updated to a filter equivalent to its own.
+	update := func(mg *oidc.ManagedGroup, filter string) *pbs.UpdateManagedGroupRequest {
+		return &pbs.UpdateManagedGroupRequest{
+			Id: mg.GetPublicId(),
+			Item: &pb.ManagedGroup{
+				Version: mg.GetVersion(),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+				},
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.filter"}},
+		}
+	}
+	_, err = s.UpdateManagedGroup(requestCtx, update(other, equivalent))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	assert.Contains(t, err.Error(), existing.GetPublicId())
+	_, err = s.UpdateManagedGroup(requestCtx, update(existing, equivalent))
+	require.NoError(t, err)
+}
+
+func TestCreateOidc_claimAliases(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+		oidc.WithClaimAliases(map[string]string{"groups": "/token/groups"}),
+	)
+	newReq := func(filter string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Type:         oidc.Subtype.String(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: filter,
+				},
+			},
+		}}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	// The filter is stored as written so it follows changes to the alias.
+	created, err := s.CreateManagedGroup(requestCtx, newReq(`@groups contains "admin"`))
+	require.NoError(t, err)
+	assert.Equal(t, `@groups contains "admin"`, created.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+
+	_, err = s.CreateManagedGroup(requestCtx, newReq(`@roles contains "admin"`))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	assert.Contains(t, err.Error(), "undefined claim alias @roles")
+
+	_, err = s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.ManagedGroup{
+			Version: created.GetItem().GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: `@roles contains "admin"`,
+				},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.filter"}},
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+}
+
+func TestCreateOidc_claimTypes(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	newAm := func(clientId string, opt ...oidc.Option) *oidc.AuthMethod {
+		opt = append(opt,
+			oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+			oidc.WithSigningAlgs(oidc.RS256),
+			oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+		)
+		return oidc.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState, clientId, "fido", opt...)
+	}
+	typedAm := newAm("alice-rp",
+		oidc.WithClaimAliases(map[string]string{"groups": "/token/groups"}),
+		oidc.WithClaimTypes(map[string]string{"/token/groups": oidc.ClaimTypeList}),
+	)
+	untypedAm := newAm("bob-rp")
+	newReq := func(am *oidc.AuthMethod, filter string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Type:         oidc.Subtype.String(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: filter,
+				},
+			},
+		}}
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	for _, filter := range []string{`"/token/groups" == "admin"`, `@groups == "admin"`} {
+		_, err = s.CreateManagedGroup(requestCtx, newReq(typedAm, filter))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		assert.Contains(t, err.Error(), "attributes.filter")
+		assert.Contains(t, err.Error(), "is a list")
+	}
+
+	created, err := s.CreateManagedGroup(requestCtx, newReq(typedAm, `"admin" in "/token/groups"`))
+	require.NoError(t, err)
+
+	// Without declared types the filter can't be known to never match.
+	_, err = s.CreateManagedGroup(requestCtx, newReq(untypedAm, `"/token/groups" == "admin"`))
+	require.NoError(t, err)
+
+	_, err = s.UpdateManagedGroup(requestCtx, &pbs.UpdateManagedGroupRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.ManagedGroup{
+			Version: created.GetItem().GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: `"/token/groups" matches "adm.*"`,
+				},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.filter"}},
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+
+	// Replacing in filters checks the new filters the same way.
+	renamed, err := s.CreateManagedGroup(requestCtx, newReq(typedAm, `"/token/grps" == "admin"`))
+	require.NoError(t, err)
+	replaced, err := s.ReplaceInFilters(requestCtx, &pbs.ReplaceInFiltersRequest{
+		AuthMethodId: typedAm.GetPublicId(),
+		OldPrefix:    "/token/grps",
+		NewPrefix:    "/token/groups",
+		Apply:        true,
+	})
+	require.NoError(t, err)
+	require.Len(t, replaced.GetReplacements(), 1)
+	assert.Equal(t, renamed.GetItem().GetId(), replaced.GetReplacements()[0].GetId())
+	assert.Equal(t, "failed", replaced.GetReplacements()[0].GetStatus())
+	assert.Contains(t, replaced.GetReplacements()[0].GetError(), "is a list")
+	assert.Empty(t, replaced.GetReplacements()[0].GetNewFilter())
+}
+
+func TestAuthorizeManagedGroup(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	granted := ldap.TestManagedGroup(t, conn, am, []string{"admin"})
+	ungranted := ldap.TestManagedGroup(t, conn, am, []string{"dev"})
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	r := iam.TestRole(t, conn, org.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), fmt.Sprintf("id=%s;actions=no-op,read", granted.GetPublicId()))
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+
+	requestCtx := func(id string) context.Context {
+		req := httptest.NewRequest("POST", fmt.Sprintf("http://127.0.0.1/v1/managed-groups/%s:authorize", id), nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+
+	cases := []struct {
+		name    string
+		id      string
+		action  action.Type
+		want    bool
+		wantErr error
+	}{
+		{
+			name:   "granted action",
+			id:     granted.GetPublicId(),
+			action: action.Read,
+			want:   true,
+		},
+		{
+			name:   "ungranted action",
+			id:     granted.GetPublicId(),
+			action: action.Delete,
+		},
+		{
+			name:    "no no-op grant",
+			id:      ungranted.GetPublicId(),
+			action:  action.Read,
+			wantErr: handlers.ForbiddenError(),
+		},
+		{
+			name:    "missing managed group",
+			id:      globals.LdapManagedGroupPrefix + "_DoesntExis",
+			action:  action.Read,
+			wantErr: handlers.NotFoundError(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := s.AuthorizeManagedGroup(requestCtx(tc.id), &pbs.AuthorizeManagedGroupRequest{Id: tc.id, Action: tc.action.String()})
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.GetAuthorized())
+		})
+	}
+}
+
+func TestRefreshAuthMethodManagedGroups(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, rw, kmsCache, oidcRepoFn, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.rw, env.kmsCache, env.oidcRepoFn, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	acct := oidc.TestAccount(t, conn, am, "alice")
+	acct.TokenClaims = `{"sub":"alice"}`
+	_, err := rw.Update(ctx, acct, []string{"TokenClaims"}, nil)
+	require.NoError(t, err)
+	stale := oidc.TestAccount(t, conn, am, "bob")
+	oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), stale.GetPublicId())
+	// The caller can't update other, so its stale member is left as it is.
+	other := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "carol"`)
+	oidc.TestManagedGroupMember(t, conn, other.GetPublicId(), stale.GetPublicId())
+
+	requestCtx := func(grants ...string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		for _, grant := range grants {
+			_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		}
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-groups:refresh", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+
+	req := &pbs.RefreshAuthMethodManagedGroupsRequest{AuthMethodId: am.GetPublicId()}
+	_, err = s.RefreshAuthMethodManagedGroups(requestCtx("id=*;type=managed-group;actions=read"), req)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+	// Listing alone doesn't allow any of the managed groups to be updated.
+	_, err = s.RefreshAuthMethodManagedGroups(requestCtx("id=*;type=managed-group;actions=list"), req)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	got, err := s.RefreshAuthMethodManagedGroups(requestCtx("id=*;type=managed-group;actions=list", fmt.Sprintf("id=%s;actions=update", mg.GetPublicId())), req)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.GetAdded())
+	assert.Equal(t, uint32(1), got.GetRemoved())
+	assert.Equal(t, uint32(1), got.GetUnauthorizedCount())
+	assert.Empty(t, cmp.Diff([]*pbs.ManagedGroupRefresh{{Id: mg.GetPublicId(), Added: 1, Removed: 1}}, got.GetRefreshed(), protocmp.Transform()))
+
+	repo, err := oidcRepoFn()
+	require.NoError(t, err)
+	memberships, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.Equal(t, acct.GetPublicId(), memberships[0].GetMemberId())
+	memberships, err = repo.ListManagedGroupMembershipsByGroup(ctx, other.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.Equal(t, stale.GetPublicId(), memberships[0].GetMemberId())
+
+	// Once the caller can update every managed group, only other is left to
+	// change.
+	got, err = s.RefreshAuthMethodManagedGroups(requestCtx("id=*;type=managed-group;actions=list,update"), req)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.GetRemoved())
+	assert.Empty(t, cmp.Diff([]*pbs.ManagedGroupRefresh{{Id: other.GetPublicId(), Removed: 1}}, got.GetRefreshed(), protocmp.Transform()))
+}
+
+func TestGetManagedGroupGrants(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	role := iam.TestRole(t, conn, org.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "id=*;type=host-catalog;actions=read")
+	iam.TestManagedGroupRole(t, conn, role.GetPublicId(), mg.GetPublicId())
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", fmt.Sprintf("http://127.0.0.1/v1/managed-groups/%s:grants", mg.GetPublicId()), nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+
+	req := &pbs.GetManagedGroupGrantsRequest{Id: mg.GetPublicId()}
+	_, err := s.GetManagedGroupGrants(requestCtx("id=*;type=managed-group;actions=update"), req)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	got, err := s.GetManagedGroupGrants(requestCtx("id=*;type=managed-group;actions=read"), req)
+	require.NoError(t, err)
+	want := &pbs.GetManagedGroupGrantsResponse{
+		Grants: []*pbs.ManagedGroupGrant{{
+			Grant:        "id=*;type=host-catalog;actions=read",
+			RoleId:       role.GetPublicId(),
+			GrantScopeId: org.GetPublicId(),
+		}},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+}
+
+func TestAddManagedGroupToRoles(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, proj, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.proj, env.am
+	iamRepo := iam.TestRepo(t, conn, env.wrap)
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+	orgRole := iam.TestRole(t, conn, org.GetPublicId())
+	projRole := iam.TestRole(t, conn, proj.GetPublicId())
+	memberRole := iam.TestRole(t, conn, org.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, memberRole.GetPublicId(), mg.GetPublicId())
+	deniedRole := iam.TestRole(t, conn, org.GetPublicId())
+
+	requestCtx := func(grants ...string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		for _, g := range grants {
+			_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), g)
+		}
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		pr := iam.TestRole(t, conn, proj.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, pr.GetPublicId(), fmt.Sprintf("id=%s;actions=add-principals", projRole.GetPublicId()))
+		_ = iam.TestUserRole(t, conn, pr.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", fmt.Sprintf("http://127.0.0.1/v1/managed-groups/%s:add-to-roles", mg.GetPublicId()), nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	roleGrants := []string{
+		"id=*;type=managed-group;actions=update",
+		fmt.Sprintf("id=%s;actions=add-principals", orgRole.GetPublicId()),
+		fmt.Sprintf("id=%s;actions=add-principals", memberRole.GetPublicId()),
+	}
+
+	req := &pbs.AddManagedGroupToRolesRequest{
+		Id:      mg.GetPublicId(),
+		RoleIds: []string{orgRole.GetPublicId(), projRole.GetPublicId(), memberRole.GetPublicId(), deniedRole.GetPublicId(), globals.RolePrefix + "_doesntexis"},
+	}
+	_, err := s.AddManagedGroupToRoles(requestCtx("id=*;type=managed-group;actions=read", roleGrants[1]), req)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	got, err := s.AddManagedGroupToRoles(requestCtx(roleGrants...), req)
+	require.NoError(t, err)
+	const notGranted = "Role not found or the add-principals action is not granted on it."
+	want := &pbs.AddManagedGroupToRolesResponse{
+		Results: []*pbs.ManagedGroupRoleAddition{
+			{RoleId: orgRole.GetPublicId(), Status: "added"},
+			{RoleId: projRole.GetPublicId(), Status: "added"},
+			{RoleId: memberRole.GetPublicId(), Status: "already_principal"},
+			{RoleId: deniedRole.GetPublicId(), Status: "failed", Error: notGranted},
+			{RoleId: globals.RolePrefix + "_doesntexis", Status: "failed", Error: notGranted},
+		},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+	for _, r := range []*iam.Role{orgRole, projRole} {
+		_, principals, _, err := iamRepo.LookupRole(ctx, r.GetPublicId())
+		require.NoError(t, err)
+		require.Len(t, principals, 1)
+		assert.Equal(t, mg.GetPublicId(), principals[0].GetPrincipalId())
+	}
+	_, principals, _, err := iamRepo.LookupRole(ctx, deniedRole.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, principals)
+}
+
+func TestCreate_roleIds(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, oidcRepoFn, iamRepoFn, tokenRepoFn, serversRepoFn, org, proj, am := env.ctx, env.conn, env.kmsCache, env.oidcRepoFn, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.proj, env.am
+	iamRepo := iam.TestRepo(t, conn, env.wrap)
+	s := env.service(t)
+
+	orgRole := iam.TestRole(t, conn, org.GetPublicId())
+	projRole := iam.TestRole(t, conn, proj.GetPublicId())
+	deniedRole := iam.TestRole(t, conn, org.GetPublicId())
+
+	requestCtx := func(grants ...string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		for _, g := range grants {
+			_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), g)
+		}
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		pr := iam.TestRole(t, conn, proj.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, pr.GetPublicId(), fmt.Sprintf("id=%s;actions=add-principals", projRole.GetPublicId()))
+		_ = iam.TestUserRole(t, conn, pr.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-groups", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	grants := []string{
+		"id=*;type=managed-group;actions=create,read",
+		fmt.Sprintf("id=%s;actions=add-principals", orgRole.GetPublicId()),
+	}
+	createReq := func(name string, roleIds ...string) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: am.GetPublicId(),
+				Name:         wrapperspb.String(name),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: oidc.TestFakeManagedGroupFilter,
+					},
+				},
+			},
+			RoleIds: roleIds,
+		}
+	}
+	oidcRepo, err := oidcRepoFn()
+	require.NoError(t, err)
+
+	for _, roleId := range []string{deniedRole.GetPublicId(), globals.RolePrefix + "_doesntexis"} {
+		t.Run("not granted "+roleId, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, err := s.CreateManagedGroup(requestCtx(grants...), createReq("denied", orgRole.GetPublicId(), roleId))
+			require.Error(err)
+			assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+			assert.Contains(err.Error(), fmt.Sprintf("Role %q not found or the add-principals action is not granted on it.", roleId))
+
+			found, err := oidcRepo.LookupManagedGroupByName(ctx, am.GetPublicId(), "denied")
+			require.NoError(err)
+			assert.Nil(found)
+			_, principals, _, err := iamRepo.LookupRole(ctx, orgRole.GetPublicId())
+			require.NoError(err)
+			assert.Empty(principals)
+		})
+	}
+
+	t.Run("added", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.CreateManagedGroup(requestCtx(grants...), createReq("added", orgRole.GetPublicId(), projRole.GetPublicId()))
+		require.NoError(err)
+		for _, r := range []*iam.Role{orgRole, projRole} {
+			_, principals, _, err := iamRepo.LookupRole(ctx, r.GetPublicId())
+			require.NoError(err)
+			require.Len(principals, 1)
+			assert.Equal(got.GetItem().GetId(), principals[0].GetPrincipalId())
+		}
+	})
+}
+
+func TestReplaceInFilters(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, oidcRepoFn, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.oidcRepoFn, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	renamed := oidc.TestManagedGroup(t, conn, am, `"admin" in "/token/groups"`)
+	unsafe := oidc.TestManagedGroup(t, conn, am, `"/token/groups" contains "/token/groups"`)
+	untouched := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	frozen := oidc.TestManagedGroup(t, conn, am, `"ops" in "/token/groups"`)
+	listEquality := oidc.TestManagedGroup(t, conn, am, `"/token/groups" == "dev"`)
+	repo, err := oidcRepoFn()
+	require.NoError(t, err)
+	freeze := oidc.AllocManagedGroup()
+	freeze.PublicId, freeze.Frozen = frozen.GetPublicId(), true
+	_, _, err = repo.UpdateManagedGroup(ctx, org.GetPublicId(), freeze, frozen.GetVersion(), []string{oidc.FrozenField})
+	require.NoError(t, err)
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-groups:replace-in-filters", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	lookupFilter := func(id string) string {
+		repo, err := oidcRepoFn()
+		require.NoError(t, err)
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		require.NoError(t, err)
+		return mg.GetFilter()
+	}
+
+	req := &pbs.ReplaceInFiltersRequest{AuthMethodId: am.GetPublicId(), OldPrefix: "/token/groups", NewPrefix: "/userinfo/groups"}
+	_, err = s.ReplaceInFilters(requestCtx("id=*;type=managed-group;actions=read"), req)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	// Without apply the changes are only reported.
+	got, err := s.ReplaceInFilters(requestCtx("id=*;type=managed-group;actions=update"), req)
+	require.NoError(t, err)
+	byId := map[string]*pbs.ManagedGroupFilterReplacement{}
+	for _, r := range got.GetReplacements() {
+		byId[r.GetId()] = r
+	}
+	require.Len(t, byId, 4)
+	assert.Equal(t, "would_replace", byId[renamed.GetPublicId()].GetStatus())
+	assert.Equal(t, `"admin" in "/userinfo/groups"`, byId[renamed.GetPublicId()].GetNewFilter())
+	assert.Empty(t, byId[renamed.GetPublicId()].GetWarnings())
+	// Lint warnings about the new filter don't prevent replacing it.
+	assert.Equal(t, "would_replace", byId[listEquality.GetPublicId()].GetStatus())
+	assert.Len(t, byId[listEquality.GetPublicId()].GetWarnings(), 1)
+	assert.Equal(t, "failed", byId[unsafe.GetPublicId()].GetStatus())
+	assert.NotEmpty(t, byId[unsafe.GetPublicId()].GetError())
+	// Frozen groups fail unless forced.
+	assert.Equal(t, "failed", byId[frozen.GetPublicId()].GetStatus())
+	assert.Contains(t, byId[frozen.GetPublicId()].GetError(), "frozen")
+	assert.Equal(t, `"admin" in "/token/groups"`, lookupFilter(renamed.GetPublicId()))
+
+	// A group which fails doesn't prevent the others from being updated.
+	req.Apply = true
+	got, err = s.ReplaceInFilters(requestCtx("id=*;type=managed-group;actions=update"), req)
+	require.NoError(t, err)
+	require.Len(t, got.GetReplacements(), 4)
+	assert.Equal(t, `"admin" in "/userinfo/groups"`, lookupFilter(renamed.GetPublicId()))
+	assert.Equal(t, `"/token/groups" contains "/token/groups"`, lookupFilter(unsafe.GetPublicId()))
+	assert.Equal(t, `"/token/sub" == "alice"`, lookupFilter(untouched.GetPublicId()))
+	assert.Equal(t, `"ops" in "/token/groups"`, lookupFilter(frozen.GetPublicId()))
+
+	req.Force = true
+	got, err = s.ReplaceInFilters(requestCtx("id=*;type=managed-group;actions=update"), req)
+	require.NoError(t, err)
+	require.Len(t, got.GetReplacements(), 2)
+	assert.Equal(t, `"ops" in "/userinfo/groups"`, lookupFilter(frozen.GetPublicId()))
+}
+
+func TestGetManagedGroupHistory(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	r := iam.TestRole(t, conn, org.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=managed-group;actions=read,update")
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	requestCtx := func(method, path string) context.Context {
+		req := httptest.NewRequest(method, "http://127.0.0.1"+path, nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	historyPath := fmt.Sprintf("/v1/managed-groups/%s:history", mg.GetPublicId())
+
+	got, err := s.GetManagedGroupHistory(requestCtx("GET", historyPath), &pbs.GetManagedGroupHistoryRequest{Id: mg.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetRevisions())
+
+	updated, err := s.UpdateManagedGroup(requestCtx("PATCH", "/v1/managed-groups/"+mg.GetPublicId()), &pbs.UpdateManagedGroupRequest{
+		Id: mg.GetPublicId(),
+		Item: &pb.ManagedGroup{
+			Version: mg.GetVersion(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "bob"`},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.filter"}},
+	})
+	require.NoError(t, err)
+
+	got, err = s.GetManagedGroupHistory(requestCtx("GET", historyPath), &pbs.GetManagedGroupHistoryRequest{Id: mg.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, got.GetRevisions(), 1)
+	rev := got.GetRevisions()[0]
+	assert.Equal(t, at.GetIamUserId(), rev.GetReplacedById())
+	assert.Equal(t, mg.GetPublicId(), rev.GetItem().GetId())
+	assert.Equal(t, mg.GetVersion(), rev.GetItem().GetVersion())
+	assert.Equal(t, `"/token/sub" == "alice"`, rev.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+	assert.Equal(t, mg.GetUpdateTime().AsTime(), rev.GetItem().GetUpdatedTime().AsTime())
+	assert.Equal(t, updated.GetItem().GetUpdatedTime().AsTime(), rev.GetReplacedTime().AsTime())
+
+	// The current version is still what GetManagedGroup returns.
+	current, err := s.GetManagedGroup(requestCtx("GET", "/v1/managed-groups/"+mg.GetPublicId()), &pbs.GetManagedGroupRequest{Id: mg.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, `"/token/sub" == "bob"`, current.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+}
+
+func TestDiffManagedGroupVersions(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`, oidc.WithDescription("first"))
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	r := iam.TestRole(t, conn, org.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=managed-group;actions=read,update")
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	requestCtx := func(method, path string) context.Context {
+		req := httptest.NewRequest(method, "http://127.0.0.1"+path, nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	diffPath := fmt.Sprintf("/v1/managed-groups/%s:diff", mg.GetPublicId())
+
+	updated, err := s.UpdateManagedGroup(requestCtx("PATCH", "/v1/managed-groups/"+mg.GetPublicId()), &pbs.UpdateManagedGroupRequest{
+		Id: mg.GetPublicId(),
+		Item: &pb.ManagedGroup{
+			Version: mg.GetVersion(),
+			Name:    wrapperspb.String("admins"),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "bob"`},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "description", "attributes.filter"}},
+	})
+	require.NoError(t, err)
+
+	got, err := s.DiffManagedGroupVersions(requestCtx("GET", diffPath), &pbs.DiffManagedGroupVersionsRequest{
+		Id:          mg.GetPublicId(),
+		FromVersion: mg.GetVersion(),
+		ToVersion:   updated.GetItem().GetVersion(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mg.GetVersion(), got.GetFrom().GetVersion())
+	assert.Equal(t, updated.GetItem().GetVersion(), got.GetTo().GetVersion())
+	require.Len(t, got.GetAdded(), 1)
+	assert.Equal(t, "name", got.GetAdded()[0].GetField())
+	assert.Equal(t, "admins", got.GetAdded()[0].GetAfter().GetStringValue())
+	require.Len(t, got.GetRemoved(), 1)
+	assert.Equal(t, "description", got.GetRemoved()[0].GetField())
+	assert.Equal(t, "first", got.GetRemoved()[0].GetBefore().GetStringValue())
+	require.Len(t, got.GetChanged(), 1)
+	assert.Equal(t, "attributes.filter", got.GetChanged()[0].GetField())
+	assert.Empty(t, cmp.Diff([]*pbs.ManagedGroupFilterDiffSegment{
+		{Op: "equal", Text: `"/token/sub" == `},
+		{Op: "delete", Text: `"alice"`},
+		{Op: "insert", Text: `"bob"`},
+	}, got.GetFilterDiff(), protocmp.Transform()))
+
+	// Comparing a version with itself reports no changes.
+	got, err = s.DiffManagedGroupVersions(requestCtx("GET", diffPath), &pbs.DiffManagedGroupVersionsRequest{
+		Id:          mg.GetPublicId(),
+		FromVersion: mg.GetVersion(),
+		ToVersion:   mg.GetVersion(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetAdded())
+	assert.Empty(t, got.GetRemoved())
+	assert.Empty(t, got.GetChanged())
+
+	_, err = s.DiffManagedGroupVersions(requestCtx("GET", diffPath), &pbs.DiffManagedGroupVersionsRequest{
+		Id:          mg.GetPublicId(),
+		FromVersion: mg.GetVersion(),
+		ToVersion:   updated.GetItem().GetVersion() + 1,
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v, wanted not found error", err)
+}
+
+func TestManagedGroupTemplates(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	otherOrg, _ := iam.TestScopes(t, iam.TestRepo(t, conn, env.wrap))
+	s := env.service(t)
+
+	otherTmpl := oidc.TestManagedGroupTemplate(t, conn, otherOrg.PublicId, "others", `"/token/sub" == "alice"`)
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-group-templates", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	allowed := requestCtx("id=*;type=managed-group;actions=create,list,delete,read")
+
+	createReq := &pbs.CreateManagedGroupTemplateRequest{Item: &pbs.ManagedGroupTemplate{
+		ScopeId:     org.GetPublicId(),
+		Name:        "admins",
+		Description: "admins of {{auth_method_id}}",
+		Filter:      `"{{auth_method_id}}-admin" in "/token/groups"`,
+	}}
+	_, err := s.CreateManagedGroupTemplate(requestCtx("id=*;type=managed-group;actions=read"), createReq)
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+	created, err := s.CreateManagedGroupTemplate(allowed, createReq)
+	require.NoError(t, err)
+	tmpl := created.GetItem()
+	assert.True(t, strings.HasPrefix(tmpl.GetId(), globals.OidcManagedGroupTemplatePrefix+"_"))
+	assert.Equal(t, createReq.GetItem().GetFilter(), tmpl.GetFilter())
+	assert.EqualValues(t, 1, tmpl.GetVersion())
+
+	_, err = s.CreateManagedGroupTemplate(allowed, createReq)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %v, wanted invalid argument error", err)
+
+	listed, err := s.ListManagedGroupTemplates(allowed, &pbs.ListManagedGroupTemplatesRequest{ScopeId: org.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, listed.GetItems(), 1)
+	assert.Empty(t, cmp.Diff(tmpl, listed.GetItems()[0], protocmp.Transform()))
+
+	fromReq := &pbs.CreateManagedGroupFromTemplateRequest{TemplateId: tmpl.GetId(), AuthMethodId: am.GetPublicId()}
+	got, err := s.CreateManagedGroupFromTemplate(allowed, fromReq)
+	require.NoError(t, err)
+	assert.Equal(t, am.GetPublicId(), got.GetItem().GetAuthMethodId())
+	assert.Equal(t, "admins", got.GetItem().GetName().GetValue())
+	assert.Equal(t, "admins of "+am.GetPublicId(), got.GetItem().GetDescription().GetValue())
+	assert.Equal(t, fmt.Sprintf(`"%s-admin" in "/token/groups"`, am.GetPublicId()), got.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+	assert.Equal(t, "managed-groups/"+got.GetItem().GetId(), got.GetUri())
+	assert.Equal(t, got.GetUri(), got.GetItem().GetUri())
+
+	// The managed group's name is taken, so it can only be created once.
+	_, err = s.CreateManagedGroupFromTemplate(allowed, fromReq)
+	require.Error(t, err)
+
+	// Templates of other scopes can't be used.
+	_, err = s.CreateManagedGroupFromTemplate(allowed, &pbs.CreateManagedGroupFromTemplateRequest{TemplateId: otherTmpl.GetPublicId(), AuthMethodId: am.GetPublicId()})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v, wanted not found error", err)
+
+	_, err = s.DeleteManagedGroupTemplate(allowed, &pbs.DeleteManagedGroupTemplateRequest{Id: tmpl.GetId()})
+	require.NoError(t, err)
+	_, err = s.DeleteManagedGroupTemplate(allowed, &pbs.DeleteManagedGroupTemplateRequest{Id: tmpl.GetId()})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v, wanted not found error", err)
+
+	// The managed group created from the template is left alone.
+	_, err = s.GetManagedGroup(allowed, &pbs.GetManagedGroupRequest{Id: got.GetItem().GetId()})
+	require.NoError(t, err)
+}
+
+func TestListManagedGroupsByMember(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
+
+	acct := oidc.TestAccount(t, conn, am, "alice")
+	var memberIds []string
+	for i := 0; i < 3; i++ {
+		mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+		oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), acct.GetPublicId())
+		memberIds = append(memberIds, mg.GetPublicId())
+	}
+	sort.Strings(memberIds)
+	// A managed group the account isn't a member of.
+	_ = oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter)
+
+	requestCtx := func(grants ...string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		for _, grant := range grants {
+			_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		}
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", "http://127.0.0.1/v1/managed-groups:by-member", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	ids := func(items []*pb.ManagedGroup) []string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.GetId())
+		}
+		return out
+	}
+
+	allCtx := requestCtx("id=*;type=managed-group;actions=list,read")
+	got, err := s.ListManagedGroupsByMember(allCtx, &pbs.ListManagedGroupsByMemberRequest{AccountId: acct.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, memberIds, ids(got.GetItems()))
+	assert.Empty(t, got.GetNextPageToken())
+	for _, item := range got.GetItems() {
+		assert.Equal(t, am.GetPublicId(), item.GetAuthMethodId())
+		assert.Equal(t, oidc.TestFakeManagedGroupFilter, item.GetOidcManagedGroupAttributes().GetFilter())
+		assert.ElementsMatch(t, []string{"read"}, item.GetAuthorizedActions())
+	}
+
+	// Paging through the managed groups one at a time.
+	var paged []string
+	req := &pbs.ListManagedGroupsByMemberRequest{AccountId: acct.GetPublicId(), PageSize: 1}
+	for {
+		got, err := s.ListManagedGroupsByMember(allCtx, req)
+		require.NoError(t, err)
+		require.Len(t, got.GetItems(), 1)
+		paged = append(paged, got.GetItems()[0].GetId())
+		if got.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = got.GetNextPageToken()
+	}
+	assert.Equal(t, memberIds, paged)
+
+	// Only the managed groups the caller is authorized on are returned, and
+	// no next page is reported for unauthorized ones.
+	got, err = s.ListManagedGroupsByMember(requestCtx(
+		"id=*;type=managed-group;actions=list",
+		fmt.Sprintf("id=%s;actions=read", memberIds[0]),
+	), &pbs.ListManagedGroupsByMemberRequest{AccountId: acct.GetPublicId(), PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, memberIds[:1], ids(got.GetItems()))
+	assert.Empty(t, got.GetNextPageToken())
+
+	// Listing is authorized in the auth method of the account.
+	_, err = s.ListManagedGroupsByMember(requestCtx("id=*;type=managed-group;actions=read"),
+		&pbs.ListManagedGroupsByMemberRequest{AccountId: acct.GetPublicId()})
+	assert.True(t, errors.Is(err, handlers.ForbiddenError()), "got error %v", err)
+
+	_, err = s.ListManagedGroupsByMember(allCtx, &pbs.ListManagedGroupsByMemberRequest{AccountId: globals.OidcAccountPrefix + "_DoesntExis"})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+}
+
+func TestValidateStoredFilters(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, rw, kmsCache, oidcRepoFn, ldapRepoFn, iamRepoFn, tokenRepoFn, serversRepoFn, org, databaseWrapper, am := env.ctx, env.conn, env.rw, env.kmsCache, env.oidcRepoFn, env.ldapRepoFn, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.databaseWrapper, env.am
+	s := env.service(t)
+
+	otherAm := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, oidc.ActivePrivateState,
+		"bob-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.bob.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.bob.com/callback")[0]),
+	)
+	_ = oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	undefinedAlias := oidc.TestManagedGroup(t, conn, am, `"admin" in @groups`)
+	broken := oidc.TestManagedGroup(t, conn, otherAm, `"/token/sub" == "bob"`)
+	// Stored filters are only ever written once validated, so one which no
+	// longer compiles is written directly.
+	_, err := rw.Exec(ctx, "update auth_oidc_managed_group set filter = ? where public_id = ?",
+		[]any{`"/token/sub" ==`, broken.GetPublicId()})
+	require.NoError(t, err)
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", "http://127.0.0.1/v1/managed-groups:validate-stored-filters", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+
+	_, err = s.ValidateStoredFilters(requestCtx("id=*;type=managed-group;actions=list"), &pbs.ValidateStoredFiltersRequest{AuthMethodId: am.GetPublicId()})
+	require.EqualError(t, err, handlers.ForbiddenError().Error())
+
+	got, err := s.ValidateStoredFilters(requestCtx("id=*;type=managed-group;actions=read"), &pbs.ValidateStoredFiltersRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), got.GetValidatedCount())
+	require.Len(t, got.GetFailures(), 1)
+	assert.Equal(t, undefinedAlias.GetPublicId(), got.GetFailures()[0].GetId())
+	assert.Equal(t, am.GetPublicId(), got.GetFailures()[0].GetAuthMethodId())
+	assert.Contains(t, got.GetFailures()[0].GetError(), "undefined claim alias @groups")
+
+	got, err = s.ValidateStoredFilters(requestCtx("id=*;type=managed-group;actions=read"), &pbs.ValidateStoredFiltersRequest{ScopeId: org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), got.GetValidatedCount())
+	var failed []string
+	for _, f := range got.GetFailures() {
+		failed = append(failed, f.GetId())
+	}
+	assert.ElementsMatch(t, []string{undefinedAlias.GetPublicId(), broken.GetPublicId()}, failed)
+	assert.False(t, got.GetTruncated())
+	assert.Empty(t, got.GetNextAfterAuthMethodId())
+
+	// A scope with more auth methods than a request fans out to is validated
+	// one part at a time.
+	limited, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn, managed_groups.WithMaxScopeFanOut(1))
+	require.NoError(t, err)
+	amIds := []string{am.GetPublicId(), otherAm.GetPublicId()}
+	sort.Strings(amIds)
+	var validated uint32
+	failed = nil
+	req := &pbs.ValidateStoredFiltersRequest{ScopeId: org.GetPublicId()}
+	for _, amId := range amIds {
+		got, err = limited.ValidateStoredFilters(requestCtx("id=*;type=managed-group;actions=read"), req)
+		require.NoError(t, err)
+		validated += got.GetValidatedCount()
+		for _, f := range got.GetFailures() {
+			assert.Equal(t, amId, f.GetAuthMethodId())
+			failed = append(failed, f.GetId())
+		}
+		if amId == amIds[len(amIds)-1] {
+			assert.False(t, got.GetTruncated())
+			assert.Empty(t, got.GetNextAfterAuthMethodId())
+			break
+		}
+		assert.True(t, got.GetTruncated())
+		assert.Equal(t, amId, got.GetNextAfterAuthMethodId())
+		req.AfterAuthMethodId = got.GetNextAfterAuthMethodId()
+	}
+	assert.Equal(t, uint32(3), validated)
+	assert.ElementsMatch(t, []string{undefinedAlias.GetPublicId(), broken.GetPublicId()}, failed)
+
+	// Nothing is modified.
+	repo, err := oidcRepoFn()
+	require.NoError(t, err)
+	stored, err := repo.LookupManagedGroup(ctx, broken.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, `"/token/sub" ==`, stored.GetFilter())
+	assert.Equal(t, broken.GetVersion(), stored.GetVersion())
+}
+
+func TestCountManagedGroups(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, databaseWrapper, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.databaseWrapper, env.am
+	s := env.service(t)
+
+	emptyAm := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, oidc.ActivePrivateState,
+		"bob-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.bob.com")[0]),
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.bob.com/callback")[0]),
+	)
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	_ = oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	_ = oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`)
+	_ = ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin"})
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("GET", "http://127.0.0.1/v1/managed-groups:count", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	counts := func(resp *pbs.CountManagedGroupsResponse) map[string]uint32 {
+		got := make(map[string]uint32)
+		for _, c := range resp.GetCounts() {
+			assert.Equal(t, org.GetPublicId(), c.GetScopeId())
+			got[c.GetAuthMethodId()] = c.GetCount()
+		}
+		return got
+	}
+
+	got, err := s.CountManagedGroups(requestCtx("ids=*;type=managed-group;actions=list"), &pbs.CountManagedGroupsRequest{ScopeId: org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint32{am.GetPublicId(): 2, emptyAm.GetPublicId(): 0, ldapAm.GetPublicId(): 1}, counts(got))
+	assert.True(t, sort.SliceIsSorted(got.GetCounts(), func(i, j int) bool {
+		return got.GetCounts()[i].GetAuthMethodId() < got.GetCounts()[j].GetAuthMethodId()
+	}))
+
+	// Only the auth methods whose managed groups can be listed are counted.
+	pinned := fmt.Sprintf("ids=%s;type=managed-group;actions=list", am.GetPublicId())
+	got, err = s.CountManagedGroups(requestCtx(pinned), &pbs.CountManagedGroupsRequest{ScopeId: org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint32{am.GetPublicId(): 2}, counts(got))
+
+	// The org's auth methods are only counted from global when recursive.
+	got, err = s.CountManagedGroups(requestCtx(pinned), &pbs.CountManagedGroupsRequest{ScopeId: scope.Global.String()})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetCounts())
+	got, err = s.CountManagedGroups(requestCtx(pinned), &pbs.CountManagedGroupsRequest{ScopeId: scope.Global.String(), Recursive: true})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint32{am.GetPublicId(): 2}, counts(got))
+
+	got, err = s.CountManagedGroups(requestCtx("ids=*;type=managed-group;actions=read"), &pbs.CountManagedGroupsRequest{ScopeId: org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetCounts())
 
-			// Now test with anon
-			if tc.skipAnon {
-				return
-			}
-			got, gErr = s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId(), auth.WithUserId(globals.AnonymousUserId)), tc.req)
-			require.NoError(gErr)
-			assert.Len(got.Items, len(tc.res.Items))
-			for _, g := range got.GetItems() {
-				assert.Nil(g.Attrs)
-				assert.Nil(g.CreatedTime)
-				assert.Nil(g.UpdatedTime)
-				assert.Empty(g.Version)
-			}
-		})
+	// The number of auth methods counted is bounded, and the rest are
+	// counted by continuing after the last one.
+	limited := env.service(t, managed_groups.WithMaxScopeFanOut(2))
+	all := make(map[string]uint32)
+	var parts int
+	req := &pbs.CountManagedGroupsRequest{ScopeId: scope.Global.String(), Recursive: true}
+	for {
+		got, err := limited.CountManagedGroups(requestCtx("ids=*;type=managed-group;actions=list"), req)
+		require.NoError(t, err)
+		parts++
+		assert.LessOrEqual(t, len(got.GetCounts()), 2)
+		for id, c := range counts(got) {
+			all[id] = c
+		}
+		if !got.GetTruncated() {
+			assert.Empty(t, got.GetNextAfterAuthMethodId())
+			break
+		}
+		require.NotEmpty(t, got.GetNextAfterAuthMethodId())
+		req.AfterAuthMethodId = got.GetNextAfterAuthMethodId()
 	}
+	assert.Equal(t, 2, parts)
+	assert.Equal(t, map[string]uint32{am.GetPublicId(): 2, emptyAm.GetPublicId(): 0, ldapAm.GetPublicId(): 1}, all)
 }
 
-func TestDelete(t *testing.T) {
-	ctx := context.Background()
-	conn, _ := db.TestSetup(t, "postgres")
-	rw := db.New(conn)
-	wrap := db.TestWrapper(t)
-	kmsCache := kms.TestKms(t, conn, wrap)
-	oidcRepoFn := func() (*oidc.Repository, error) {
-		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+func TestBatchGetManagedGroups(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, iamRepoFn, tokenRepoFn, serversRepoFn, org, databaseWrapper, am := env.ctx, env.conn, env.kmsCache, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.databaseWrapper, env.am
+	s := env.service(t)
+
+	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+	omg1 := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	omg2 := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`)
+	lmg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin"})
+	missingId := globals.OidcManagedGroupPrefix + "_1234567890"
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-groups:batch-get", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
 	}
-	iamRepoFn := func() (*iam.Repository, error) {
-		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	itemIds := func(resp *pbs.BatchGetManagedGroupsResponse) []string {
+		var ids []string
+		for _, item := range resp.GetItems() {
+			ids = append(ids, item.GetId())
+		}
+		return ids
 	}
-	ldapRepoFn := func() (*ldap.Repository, error) {
-		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+
+	// Items and missing ids are returned in the order they were requested.
+	ids := []string{lmg.GetPublicId(), missingId, omg2.GetPublicId(), omg1.GetPublicId()}
+	got, err := s.BatchGetManagedGroups(requestCtx("ids=*;type=managed-group;actions=read"), &pbs.BatchGetManagedGroupsRequest{Ids: ids})
+	require.NoError(t, err)
+	assert.Equal(t, []string{lmg.GetPublicId(), omg2.GetPublicId(), omg1.GetPublicId()}, itemIds(got))
+	assert.Equal(t, []string{missingId}, got.GetMissingIds())
+	for _, item := range got.GetItems() {
+		assert.Equal(t, org.GetPublicId(), item.GetScope().GetId())
+		assert.Contains(t, item.GetAuthorizedActions(), action.Read.String())
 	}
 
-	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	// Managed groups which can't be read are reported as missing, whether
+	// the grants are pinned to an auth method or name a managed group.
+	pinned := fmt.Sprintf("ids=%s;type=managed-group;actions=read", am.GetPublicId())
+	got, err = s.BatchGetManagedGroups(requestCtx(pinned), &pbs.BatchGetManagedGroupsRequest{Ids: ids})
+	require.NoError(t, err)
+	assert.Equal(t, []string{omg2.GetPublicId(), omg1.GetPublicId()}, itemIds(got))
+	assert.Equal(t, []string{lmg.GetPublicId(), missingId}, got.GetMissingIds())
 
-	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	byId := fmt.Sprintf("ids=%s;actions=read", omg1.GetPublicId())
+	got, err = s.BatchGetManagedGroups(requestCtx(byId), &pbs.BatchGetManagedGroupsRequest{Ids: ids})
 	require.NoError(t, err)
-	oidcAm := oidc.TestAuthMethod(
-		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
-		"alice-rp", "fido",
-		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
-		oidc.WithSigningAlgs(oidc.RS256),
-		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
-	)
-	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+	assert.Equal(t, []string{omg1.GetPublicId()}, itemIds(got))
+	assert.Equal(t, []string{lmg.GetPublicId(), missingId, omg2.GetPublicId()}, got.GetMissingIds())
 
-	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
-	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"})
+	got, err = s.BatchGetManagedGroups(requestCtx("ids=*;type=managed-group;actions=list"), &pbs.BatchGetManagedGroupsRequest{Ids: ids})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+	assert.Equal(t, ids, got.GetMissingIds())
+}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
-	require.NoError(t, err, "Error when getting new user service.")
+func TestSetManagedGroupsEnabled(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, kmsCache, oidcRepoFn, iamRepoFn, tokenRepoFn, serversRepoFn, org, am := env.ctx, env.conn, env.kmsCache, env.oidcRepoFn, env.iamRepoFn, env.tokenRepoFn, env.serversRepoFn, env.org, env.am
+	s := env.service(t)
 
-	cases := []struct {
-		name        string
-		scope       string
-		req         *pbs.DeleteManagedGroupRequest
-		res         *pbs.DeleteManagedGroupResponse
-		err         error
-		errContains string
-	}{
-		{
-			name: "Delete an existing oidc managed group",
-			req: &pbs.DeleteManagedGroupRequest{
-				Id: oidcMg.GetPublicId(),
-			},
+	mg1 := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	mg2 := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "bob"`)
+	mg3 := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "carol"`)
+	missingId := globals.OidcManagedGroupPrefix + "_1234567890"
+
+	requestCtx := func(grant string) context.Context {
+		at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		r := iam.TestRole(t, conn, org.GetPublicId())
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), grant)
+		_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		req := httptest.NewRequest("POST", "http://127.0.0.1/v1/managed-groups:set-enabled", nil)
+		requestInfo := authpb.RequestInfo{
+			Path:        req.URL.Path,
+			Method:      req.Method,
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		ctx := auth.NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+		return context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{})
+	}
+	disabled := func(id string) bool {
+		t.Helper()
+		repo, err := oidcRepoFn()
+		require.NoError(t, err)
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		require.NoError(t, err)
+		return mg.GetDisabled()
+	}
+
+	// Each managed group fails on its own, and the others are updated.
+	got, err := s.SetManagedGroupsEnabled(requestCtx("ids=*;type=managed-group;actions=update"), &pbs.SetManagedGroupsEnabledRequest{
+		Items: []*pbs.ManagedGroupVersion{
+			{Id: mg2.GetPublicId(), Version: 1},
+			{Id: missingId, Version: 1},
+			{Id: mg3.GetPublicId(), Version: 2},
+			{Id: mg1.GetPublicId(), Version: 1},
 		},
-		{
-			name: "Delete bad oidc managed group id",
-			req: &pbs.DeleteManagedGroupRequest{
-				Id: globals.OidcManagedGroupPrefix + "_doesntexis",
-			},
-			err:         handlers.ApiErrorWithCode(codes.NotFound),
-			errContains: "Resource not found.",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pbs.SetManagedGroupsEnabledResponse{
+		Results: []*pbs.ManagedGroupEnabledResult{
+			{Id: mg2.GetPublicId(), Status: "updated", Version: 2},
+			{Id: missingId, Status: "failed", Error: "Managed group not found or the update action is not granted on it."},
+			{Id: mg3.GetPublicId(), Status: "failed", Error: "Managed group is at version 1, not 2."},
+			{Id: mg1.GetPublicId(), Status: "updated", Version: 2},
 		},
-		{
-			name: "Delete an existing ldap managed group",
-			req: &pbs.DeleteManagedGroupRequest{
-				Id: ldapMg.GetPublicId(),
-			},
+	}, got, protocmp.Transform()))
+	assert.True(t, disabled(mg1.GetPublicId()))
+	assert.True(t, disabled(mg2.GetPublicId()))
+	assert.False(t, disabled(mg3.GetPublicId()))
+
+	// A managed group already in the requested state is left unchanged.
+	got, err = s.SetManagedGroupsEnabled(requestCtx("ids=*;type=managed-group;actions=update"), &pbs.SetManagedGroupsEnabledRequest{
+		Items:   []*pbs.ManagedGroupVersion{{Id: mg1.GetPublicId(), Version: 2}, {Id: mg3.GetPublicId(), Version: 1}},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pbs.SetManagedGroupsEnabledResponse{
+		Results: []*pbs.ManagedGroupEnabledResult{
+			{Id: mg1.GetPublicId(), Status: "updated", Version: 3},
+			{Id: mg3.GetPublicId(), Status: "unchanged", Version: 1},
 		},
-		{
-			name: "Delete bad ldap managed group id",
-			req: &pbs.DeleteManagedGroupRequest{
-				Id: globals.LdapManagedGroupPrefix + "_doesntexis",
-			},
-			err:         handlers.ApiErrorWithCode(codes.NotFound),
-			errContains: "Resource not found.",
+	}, got, protocmp.Transform()))
+	assert.False(t, disabled(mg1.GetPublicId()))
+
+	// Each managed group is authorized on its own.
+	byId := fmt.Sprintf("ids=%s;actions=update", mg1.GetPublicId())
+	got, err = s.SetManagedGroupsEnabled(requestCtx(byId), &pbs.SetManagedGroupsEnabledRequest{
+		Items:   []*pbs.ManagedGroupVersion{{Id: mg1.GetPublicId(), Version: 3}, {Id: mg2.GetPublicId(), Version: 2}},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pbs.SetManagedGroupsEnabledResponse{
+		Results: []*pbs.ManagedGroupEnabledResult{
+			{Id: mg1.GetPublicId(), Status: "unchanged", Version: 3},
+			{Id: mg2.GetPublicId(), Status: "failed", Error: "Managed group not found or the update action is not granted on it."},
 		},
-		{
-			name: "Bad managed group id formatting",
-			req: &pbs.DeleteManagedGroupRequest{
-				Id: "bad_format",
+	}, got, protocmp.Transform()))
+	assert.True(t, disabled(mg2.GetPublicId()))
+
+	got, err = s.SetManagedGroupsEnabled(requestCtx("ids=*;type=managed-group;actions=read"), &pbs.SetManagedGroupsEnabledRequest{
+		Items:   []*pbs.ManagedGroupVersion{{Id: mg2.GetPublicId(), Version: 2}},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, got.GetResults(), 1)
+	assert.Equal(t, "failed", got.GetResults()[0].GetStatus())
+	assert.True(t, disabled(mg2.GetPublicId()))
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := s.SetManagedGroupsEnabled(requestCtx("ids=*;type=managed-group;actions=update"), &pbs.SetManagedGroupsEnabledRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestUpdateOidc_nameOnlyIgnoresAttributes(t *testing.T) {
+	env := newServiceTestEnv(t)
+	conn, iamRepoFn, o, am := env.conn, env.iamRepoFn, env.org, env.am
+	s := env.service(t)
+
+	mg := oidc.TestManagedGroup(t, conn, am, oidc.TestFakeManagedGroupFilter, oidc.WithName("original"))
+
+	// The request goes through the interceptor converting the attributes, as
+	// it does when received by the controller.
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	update := func(version uint32, name string, paths ...string) (*pbs.UpdateManagedGroupResponse, error) {
+		// The attributes don't hold a valid filter, and have a field managed
+		// groups don't have.
+		malformed, err := structpb.NewStruct(map[string]any{"filter": 5, "unknown": "field"})
+		require.NoError(t, err)
+		req := &pbs.UpdateManagedGroupRequest{
+			Id: mg.GetPublicId(),
+			Item: &pb.ManagedGroup{
+				Version: version,
+				Name:    wrapperspb.String(name),
+				Attrs:   &pb.ManagedGroup_Attributes{Attributes: malformed},
 			},
-			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
-			errContains: "Incorrectly formatted identifier.",
-		},
-	}
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			assert, require := assert.New(t), require.New(t)
-			got, gErr := s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
-			if tc.err != nil {
-				require.Error(gErr)
-				assert.True(errors.Is(gErr, tc.err), "DeleteManagedGroup(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
-				require.NotEmpty(tc.errContains)
-				assert.Contains(gErr.Error(), tc.errContains)
-			}
-			assert.EqualValuesf(tc.res, got, "DeleteManagedGroup(%q) got response %q, wanted %q", tc.req, got, tc.res)
+			UpdateMask: &field_mask.FieldMask{Paths: paths},
+		}
+		got, err := subtypes.AttributeTransformerInterceptor(ctx)(ctx, req, nil, func(ctx context.Context, req any) (any, error) {
+			return s.UpdateManagedGroup(ctx, req.(*pbs.UpdateManagedGroupRequest))
 		})
+		if err != nil {
+			return nil, err
+		}
+		return got.(*pbs.UpdateManagedGroupResponse), nil
 	}
+
+	// The attributes aren't part of the mask.
+	got, err := update(mg.GetVersion(), "renamed", globals.NameField)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", got.GetItem().GetName().GetValue())
+	assert.Equal(t, oidc.TestFakeManagedGroupFilter, got.GetItem().GetAttributes().AsMap()["filter"])
+
+	// Comma separated paths are split as the mask manager splits them.
+	got, err = update(got.GetItem().GetVersion(), "again", "name,description")
+	require.NoError(t, err)
+	assert.Equal(t, "again", got.GetItem().GetName().GetValue())
+
+	_, err = update(got.GetItem().GetVersion(), "filtered", "name,attributes.filter")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
 }
 
-func TestDelete_twice(t *testing.T) {
-	ctx := context.TODO()
-	assert, require := assert.New(t), require.New(t)
-	conn, _ := db.TestSetup(t, "postgres")
-	wrap := db.TestWrapper(t)
-	rw := db.New(conn)
-	kmsCache := kms.TestKms(t, conn, wrap)
+func TestUpdateOidc_frozen(t *testing.T) {
+	env := newServiceTestEnv(t)
+	ctx, conn, rw, oidcRepoFn, iamRepoFn, o, am := env.ctx, env.conn, env.rw, env.oidcRepoFn, env.iamRepoFn, env.org, env.am
+	s := env.service(t)
 
-	oidcRepoFn := func() (*oidc.Repository, error) {
-		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	mg := oidc.TestManagedGroup(t, conn, am, `"/token/sub" == "alice"`)
+	withClaims := func(subject string) *oidc.Account {
+		acct := oidc.TestAccount(t, conn, am, subject)
+		acct.TokenClaims = fmt.Sprintf(`{"sub":%q}`, subject)
+		_, err := rw.Update(ctx, acct, []string{"TokenClaims"}, nil)
+		require.NoError(t, err)
+		return acct
 	}
-	iamRepoFn := func() (*iam.Repository, error) {
-		return iam.NewRepository(ctx, rw, rw, kmsCache)
+	alice, bob := withClaims("alice"), withClaims("bob")
+	oidc.TestManagedGroupMember(t, conn, mg.GetPublicId(), alice.GetPublicId())
+
+	repo, err := oidcRepoFn()
+	require.NoError(t, err)
+	memberIds := func() []string {
+		memberships, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		require.NoError(t, err)
+		var ids []string
+		for _, m := range memberships {
+			ids = append(ids, m.GetMemberId())
+		}
+		return ids
 	}
-	ldapRepoFn := func() (*ldap.Repository, error) {
-		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	reqCtx := auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	update := func(version uint32, attrs *pb.OidcManagedGroupAttributes, paths ...string) *pbs.UpdateManagedGroupRequest {
+		return &pbs.UpdateManagedGroupRequest{
+			Id: mg.GetPublicId(),
+			Item: &pb.ManagedGroup{
+				Version: version,
+				Attrs:   &pb.ManagedGroup_OidcManagedGroupAttributes{OidcManagedGroupAttributes: attrs},
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: paths},
+		}
 	}
 
-	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	got, err := s.UpdateManagedGroup(reqCtx, update(mg.GetVersion(), &pb.OidcManagedGroupAttributes{Frozen: true}, "attributes.frozen"))
+	require.NoError(t, err)
+	assert.True(t, got.GetItem().GetOidcManagedGroupAttributes().GetFrozen())
+	version := got.GetItem().GetVersion()
 
-	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), o.PublicId, kms.KeyPurposeDatabase)
-	require.NoError(err)
+	bobFilter := &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "bob"`}
+	_, err = s.UpdateManagedGroup(reqCtx, update(version, bobFilter, "attributes.filter"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v, wanted failed precondition", err)
 
-	oidcAm := oidc.TestAuthMethod(
-		t, conn, databaseWrapper, o.PublicId, oidc.ActivePrivateState,
-		"alice-rp", "fido",
-		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
-		oidc.WithSigningAlgs(oidc.RS256),
-		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
-	)
-	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
+	forced := update(version, bobFilter, "attributes.filter")
+	forced.Force = true
+	got, err = s.UpdateManagedGroup(reqCtx, forced)
+	require.NoError(t, err)
+	assert.Equal(t, `"/token/sub" == "bob"`, got.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+	assert.True(t, got.GetItem().GetOidcManagedGroupAttributes().GetFrozen())
+	// The members are still the ones matched by the previous filter.
+	assert.ElementsMatch(t, []string{alice.GetPublicId()}, memberIds())
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
-	require.NoError(err, "Error when getting new user service")
-	req := &pbs.DeleteManagedGroupRequest{
-		Id: oidcMg.GetPublicId(),
-	}
-	_, gErr := s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), req)
-	assert.NoError(gErr, "First attempt")
-	_, gErr = s.DeleteManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), req)
-	assert.Error(gErr, "Second attempt")
-	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected not found for the second delete.")
+	unfreeze := update(got.GetItem().GetVersion(), &pb.OidcManagedGroupAttributes{}, "attributes.frozen")
+	unfreeze.Refresh = true
+	got, err = s.UpdateManagedGroup(reqCtx, unfreeze)
+	require.NoError(t, err)
+	assert.False(t, got.GetItem().GetOidcManagedGroupAttributes().GetFrozen())
+	assert.ElementsMatch(t, []string{bob.GetPublicId()}, memberIds())
+	// The refresh incremented the version, which the returned item reflects.
+	current, err := repo.LookupManagedGroup(ctx, mg.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, current.GetVersion(), got.GetItem().GetVersion())
 }
 
 func TestCreateOidc(t *testing.T) {
@@ -725,7 +4453,7 @@ func TestCreateOidc(t *testing.T) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new managed group service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -739,6 +4467,11 @@ func TestCreateOidc(t *testing.T) {
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
 	)
 
+	// The fake filter doesn't reference token or userinfo claims.
+	fakeFilterWarnings, err := oidc.LintManagedGroupFilter(ctx, oidc.TestFakeManagedGroupFilter)
+	require.NoError(t, err)
+	require.Len(t, fakeFilterWarnings, 1)
+
 	cases := []struct {
 		name string
 		req  *pbs.CreateManagedGroupRequest
@@ -763,19 +4496,26 @@ func TestCreateOidc(t *testing.T) {
 			res: &pbs.CreateManagedGroupResponse{
 				Uri: fmt.Sprintf("managed-groups/%s_", globals.OidcManagedGroupPrefix),
 				Item: &pb.ManagedGroup{
-					AuthMethodId: am.GetPublicId(),
-					Name:         &wrapperspb.StringValue{Value: "name"},
-					Description:  &wrapperspb.StringValue{Value: "desc"},
-					Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-					Version:      1,
-					Type:         oidc.Subtype.String(),
+					AuthMethodId:   am.GetPublicId(),
+					AuthMethodType: oidc.Subtype.String(),
+					Kind:           "login",
+					Name:           &wrapperspb.StringValue{Value: "name"},
+					Description:    &wrapperspb.StringValue{Value: "desc"},
+					Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Version:        1,
+					Type:           oidc.Subtype.String(),
 					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
 						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-							Filter: oidc.TestFakeManagedGroupFilter,
+							Filter:              oidc.TestFakeManagedGroupFilter,
+							FilterVersion:       1,
+							FilterSyntaxVersion: oidc.FilterSyntaxVersion,
+							FilterHasWarnings:   true,
+							FilterComplexity:    1,
 						},
 					},
 					AuthorizedActions: oidcAuthorizedActions,
 				},
+				Warnings: fakeFilterWarnings,
 			},
 		},
 		{
@@ -793,17 +4533,24 @@ func TestCreateOidc(t *testing.T) {
 			res: &pbs.CreateManagedGroupResponse{
 				Uri: fmt.Sprintf("managed-groups/%s_", globals.OidcManagedGroupPrefix),
 				Item: &pb.ManagedGroup{
-					AuthMethodId: am.GetPublicId(),
-					Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-					Version:      1,
-					Type:         oidc.Subtype.String(),
+					AuthMethodId:   am.GetPublicId(),
+					AuthMethodType: oidc.Subtype.String(),
+					Kind:           "login",
+					Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Version:        1,
+					Type:           oidc.Subtype.String(),
 					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
 						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-							Filter: oidc.TestFakeManagedGroupFilter,
+							Filter:              oidc.TestFakeManagedGroupFilter,
+							FilterVersion:       1,
+							FilterSyntaxVersion: oidc.FilterSyntaxVersion,
+							FilterHasWarnings:   true,
+							FilterComplexity:    1,
 						},
 					},
 					AuthorizedActions: oidcAuthorizedActions,
 				},
+				Warnings: fakeFilterWarnings,
 			},
 		},
 		{
@@ -839,6 +4586,23 @@ func TestCreateOidc(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Can't specify filter version",
+			req: &pbs.CreateManagedGroupRequest{
+				Item: &pb.ManagedGroup{
+					AuthMethodId: am.GetPublicId(),
+					Type:         oidc.Subtype.String(),
+					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+							Filter:        oidc.TestFakeManagedGroupFilter,
+							FilterVersion: 3,
+						},
+					},
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Can't specify Created Time",
 			req: &pbs.CreateManagedGroupRequest{
@@ -873,6 +4637,22 @@ func TestCreateOidc(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Non existing auth method",
+			req: &pbs.CreateManagedGroupRequest{
+				Item: &pb.ManagedGroup{
+					AuthMethodId: globals.OidcAuthMethodPrefix + "_doesntexis",
+					Type:         oidc.Subtype.String(),
+					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+							Filter: oidc.TestFakeManagedGroupFilter,
+						},
+					},
+				},
+			},
+			res: nil,
+			err: handlers.NotFoundError(),
+		},
 		{
 			name: "Can't specify bad filter",
 			req: &pbs.CreateManagedGroupRequest{
@@ -903,11 +4683,18 @@ func TestCreateOidc(t *testing.T) {
 			require.NoError(gErr)
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.Uri)
+				assert.Equal(got.GetUri(), got.GetItem().GetUri())
 				assert.True(strings.HasPrefix(got.GetItem().GetId(), globals.OidcManagedGroupPrefix+"_"))
+				// Every field starts out changed when the managed group is
+				// created.
+				created := got.GetItem().GetCreatedTime()
+				assert.Empty(cmp.Diff(&pb.FieldUpdatedTimes{Name: created, Description: created, Filter: created}, got.GetItem().GetFieldUpdatedTimes(), protocmp.Transform()))
 				// Clear all values which are hard to compare against.
 				got.Uri, tc.res.Uri = "", ""
+				got.Item.Uri = ""
 				got.Item.Id, tc.res.Item.Id = "", ""
 				got.Item.CreatedTime, got.Item.UpdatedTime, tc.res.Item.CreatedTime, tc.res.Item.UpdatedTime = nil, nil, nil, nil
+				got.Item.FieldUpdatedTimes = nil
 			}
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "CreateManagedGroup(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
@@ -930,7 +4717,7 @@ func TestCreateLdap(t *testing.T) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new managed group service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -963,12 +4750,14 @@ func TestCreateLdap(t *testing.T) {
 			res: &pbs.CreateManagedGroupResponse{
 				Uri: fmt.Sprintf("managed-groups/%s_", globals.LdapManagedGroupPrefix),
 				Item: &pb.ManagedGroup{
-					AuthMethodId: am.GetPublicId(),
-					Name:         &wrapperspb.StringValue{Value: "name"},
-					Description:  &wrapperspb.StringValue{Value: "desc"},
-					Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-					Version:      1,
-					Type:         ldap.Subtype.String(),
+					AuthMethodId:   am.GetPublicId(),
+					AuthMethodType: ldap.Subtype.String(),
+					Kind:           "login",
+					Name:           &wrapperspb.StringValue{Value: "name"},
+					Description:    &wrapperspb.StringValue{Value: "desc"},
+					Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Version:        1,
+					Type:           ldap.Subtype.String(),
 					Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
 						LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
 							GroupNames: []string{"admin", "users"},
@@ -993,10 +4782,12 @@ func TestCreateLdap(t *testing.T) {
 			res: &pbs.CreateManagedGroupResponse{
 				Uri: fmt.Sprintf("managed-groups/%s_", globals.LdapManagedGroupPrefix),
 				Item: &pb.ManagedGroup{
-					AuthMethodId: am.GetPublicId(),
-					Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
-					Version:      1,
-					Type:         ldap.Subtype.String(),
+					AuthMethodId:   am.GetPublicId(),
+					AuthMethodType: ldap.Subtype.String(),
+					Kind:           "login",
+					Scope:          &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Version:        1,
+					Type:           ldap.Subtype.String(),
 					Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
 						LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
 							GroupNames: []string{"admin", "users"},
@@ -1109,9 +4900,11 @@ func TestCreateLdap(t *testing.T) {
 			require.NoError(gErr)
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.Uri)
+				assert.Equal(got.GetUri(), got.GetItem().GetUri())
 				assert.True(strings.HasPrefix(got.GetItem().GetId(), globals.LdapManagedGroupPrefix+"_"))
 				// Clear all values which are hard to compare against.
 				got.Uri, tc.res.Uri = "", ""
+				got.Item.Uri = ""
 				got.Item.Id, tc.res.Item.Id = "", ""
 				got.Item.CreatedTime, got.Item.UpdatedTime, tc.res.Item.CreatedTime, tc.res.Item.UpdatedTime = nil, nil, nil, nil
 			}
@@ -1120,6 +4913,69 @@ func TestCreateLdap(t *testing.T) {
 	}
 }
 
+func TestUpsertOidc(t *testing.T) {
+	env := newServiceTestEnv(t)
+	iamRepoFn, o, am := env.iamRepoFn, env.org, env.am
+	s := env.service(t)
+
+	upsert := func(name, filter string, description *wrapperspb.StringValue) (*pbs.UpsertManagedGroupResponse, error) {
+		return s.UpsertManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.UpsertManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: am.GetPublicId(),
+				Name:         &wrapperspb.StringValue{Value: name},
+				Description:  description,
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: filter,
+					},
+				},
+			},
+		})
+	}
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := upsert("upserted", oidc.TestFakeManagedGroupFilter, &wrapperspb.StringValue{Value: "kept"})
+	require.NoError(err)
+	assert.True(created.GetCreated())
+	assert.True(strings.HasPrefix(created.GetItem().GetId(), globals.OidcManagedGroupPrefix+"_"))
+	assert.Equal(uint32(1), created.GetItem().GetVersion())
+	assert.Equal(oidcAuthorizedActions, created.GetItem().GetAuthorizedActions())
+
+	const newFilter = `"/token/sub" == "bob"`
+	updated, err := upsert("upserted", newFilter, nil)
+	require.NoError(err)
+	assert.False(updated.GetCreated())
+	assert.Equal(created.GetItem().GetId(), updated.GetItem().GetId())
+	assert.Equal(uint32(2), updated.GetItem().GetVersion())
+	assert.Equal(newFilter, updated.GetItem().GetOidcManagedGroupAttributes().GetFilter())
+	// The description was omitted, so it's kept.
+	assert.Equal("kept", updated.GetItem().GetDescription().GetValue())
+
+	// Names are unique with case, so a name differing only by case creates
+	// another managed group, and each is then upserted by its own name.
+	upper, err := upsert("UPSERTED", oidc.TestFakeManagedGroupFilter, nil)
+	require.NoError(err)
+	assert.True(upper.GetCreated())
+	assert.NotEqual(created.GetItem().GetId(), upper.GetItem().GetId())
+	updated, err = upsert("upserted", oidc.TestFakeManagedGroupFilter, nil)
+	require.NoError(err)
+	assert.False(updated.GetCreated())
+	assert.Equal(created.GetItem().GetId(), updated.GetItem().GetId())
+
+	_, err = s.UpsertManagedGroup(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.UpsertManagedGroupRequest{
+		Item: &pb.ManagedGroup{
+			AuthMethodId: am.GetPublicId(),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: newFilter,
+				},
+			},
+		},
+	})
+	require.Error(err)
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+}
+
 func TestUpdateOidc(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1147,13 +5003,14 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new managed_groups service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
 	defaultAttributes := &pb.ManagedGroup_OidcManagedGroupAttributes{
 		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
-			Filter: oidc.TestFakeManagedGroupFilter,
+			Filter:        oidc.TestFakeManagedGroupFilter,
+			FilterVersion: 1,
 		},
 	}
 
@@ -1163,6 +5020,16 @@ func TestUpdateOidc(t *testing.T) {
 		},
 	}
 
+	// Only changing the filter increments its version.
+	modifiedFilterAttributes := &pb.ManagedGroup_OidcManagedGroupAttributes{
+		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+			Filter:              `"/token/zip" == "zap"`,
+			FilterVersion:       2,
+			FilterSyntaxVersion: oidc.FilterSyntaxVersion,
+			FilterComplexity:    1,
+		},
+	}
+
 	badAttributes := &pb.ManagedGroup_OidcManagedGroupAttributes{
 		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
 			Filter: `"foobar"`,
@@ -1203,6 +5070,8 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "new"},
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Type:              oidc.Subtype.String(),
@@ -1227,6 +5096,8 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "new"},
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Type:              oidc.Subtype.String(),
@@ -1298,6 +5169,8 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              oidc.Subtype.String(),
 					Attrs:             defaultAttributes,
@@ -1321,6 +5194,8 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "updated"},
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              oidc.Subtype.String(),
@@ -1345,6 +5220,8 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "default"},
 					Description:       &wrapperspb.StringValue{Value: "notignored"},
 					Type:              oidc.Subtype.String(),
@@ -1447,10 +5324,12 @@ func TestUpdateOidc(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    oidc.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "default"},
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              oidc.Subtype.String(),
-					Attrs:             modifiedAttributes,
+					Attrs:             modifiedFilterAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
 				},
@@ -1497,11 +5376,27 @@ func TestUpdateOidc(t *testing.T) {
 				// Verify it is a auth_method updated after it was created
 				assert.True(gotUpdateTime.AsTime().After(created.AsTime()), "Updated account should have been updated after it's creation. Was updated %v, which is after %v", gotUpdateTime, created)
 
+				// Only the fields whose value changed report the update time.
+				fieldTime := func(changed bool) *timestamppb.Timestamp {
+					if changed {
+						return gotUpdateTime
+					}
+					return created
+				}
+				wantFieldTimes := &pb.FieldUpdatedTimes{
+					Name:        fieldTime(got.GetItem().GetName().GetValue() != mg.GetName()),
+					Description: fieldTime(got.GetItem().GetDescription().GetValue() != mg.GetDescription()),
+					Filter:      fieldTime(got.GetItem().GetOidcManagedGroupAttributes().GetFilter() != mg.GetFilter()),
+				}
+				assert.Empty(cmp.Diff(wantFieldTimes, got.GetItem().GetFieldUpdatedTimes(), protocmp.Transform()))
+
 				// Clear all values which are hard to compare against.
 				got.Item.UpdatedTime, tc.res.Item.UpdatedTime = nil, nil
+				got.Item.FieldUpdatedTimes = nil
 
 				assert.EqualValues(2, got.Item.Version)
 				tc.res.Item.Version = 2
+				tc.res.Item.Uri = fmt.Sprintf("managed-groups/%s", mg.GetPublicId())
 			}
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "UpdateManagedGroup(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
@@ -1530,7 +5425,7 @@ func TestUpdateLdap(t *testing.T) {
 	require.NoError(t, err)
 	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
 
-	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn)
+	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new managed_groups service.")
 
 	testGroups := []string{"test", "admin"}
@@ -1588,6 +5483,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "new"},
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Type:              ldap.Subtype.String(),
@@ -1612,6 +5509,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "new"},
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Type:              ldap.Subtype.String(),
@@ -1684,6 +5583,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              ldap.Subtype.String(),
 					Attrs:             defaultAttributes,
@@ -1707,6 +5608,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "updated"},
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              ldap.Subtype.String(),
@@ -1731,6 +5634,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "default"},
 					Description:       &wrapperspb.StringValue{Value: "notignored"},
 					Type:              ldap.Subtype.String(),
@@ -1824,7 +5729,7 @@ func TestUpdateLdap(t *testing.T) {
 				},
 			},
 			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
-			errContains: "name: \"attributes.filter\", desc: \"Field cannot be empty.",
+			errContains: "name: \"attributes.group_names\", desc: \"Field cannot be empty.",
 		},
 		{
 			name: "Update group names With Good Value",
@@ -1839,6 +5744,8 @@ func TestUpdateLdap(t *testing.T) {
 			res: &pbs.UpdateManagedGroupResponse{
 				Item: &pb.ManagedGroup{
 					AuthMethodId:      am.GetPublicId(),
+					AuthMethodType:    ldap.Subtype.String(),
+					Kind:              "login",
 					Name:              &wrapperspb.StringValue{Value: "default"},
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					Type:              ldap.Subtype.String(),
@@ -1896,6 +5803,7 @@ func TestUpdateLdap(t *testing.T) {
 
 				assert.EqualValues(2, got.Item.Version)
 				tc.res.Item.Version = 2
+				tc.res.Item.Uri = fmt.Sprintf("managed-groups/%s", mg.GetPublicId())
 			}
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "UpdateManagedGroup(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
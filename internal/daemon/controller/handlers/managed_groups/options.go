@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"golang.org/x/time/rate"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withMutationRateLimit   rate.Limit
+	withMutationBurst       int
+	withReadRateLimit       rate.Limit
+	withReadBurst           int
+	withHideUnauthorized    bool
+	withAllowCreateDisabled bool
+	withDefaultListLimit    int
+	withDefaultSort         string
+	withMaxPerAuthMethod    int
+	withMaxListProcessed    int
+	withMaxScopeFanOut      int
+	withUniqueFilters       bool
+	withRejectMatchAll      bool
+	withCompressListOver    int
+	withRpcObservations     bool
+}
+
+func getDefaultOptions() options {
+	return options{
+		withMutationRateLimit: rate.Inf,
+		withReadRateLimit:     rate.Inf,
+		withDefaultSort:       sortById,
+		withMaxPerAuthMethod:  defaultMaxManagedGroupsPerAuthMethod,
+	}
+}
+
+// WithMutationRateLimit sets the per user token bucket applied to create,
+// update, upsert and delete requests. Mutations are not limited by default.
+func WithMutationRateLimit(limit rate.Limit, burst int) Option {
+	return func(o *options) {
+		o.withMutationRateLimit = limit
+		o.withMutationBurst = burst
+	}
+}
+
+// WithReadRateLimit sets the per user token bucket applied to get, list and
+// preview requests. Reads are not limited by default.
+func WithReadRateLimit(limit rate.Limit, burst int) Option {
+	return func(o *options) {
+		o.withReadRateLimit = limit
+		o.withReadBurst = burst
+	}
+}
+
+// WithHideUnauthorized makes requests on a single managed group which the
+// caller isn't authorized to perform fail with the same error as requests on
+// a managed group which doesn't exist, so that callers can't learn which
+// managed groups exist.
+func WithHideUnauthorized(hide bool) Option {
+	return func(o *options) {
+		o.withHideUnauthorized = hide
+	}
+}
+
+// WithAllowCreateDisabled allows managed groups to be created disabled. By
+// default a create request which disables the managed group is rejected.
+func WithAllowCreateDisabled(allow bool) Option {
+	return func(o *options) {
+		o.withAllowCreateDisabled = allow
+	}
+}
+
+// WithDefaultListLimit sets the maximum number of managed groups returned by a
+// list request which doesn't set page_size. A limit of 0, the default, returns
+// every managed group. The response is truncated when more managed groups
+// match, with a next_page_token to list them. NewService fails if the limit is
+// negative.
+func WithDefaultListLimit(limit int) Option {
+	return func(o *options) {
+		o.withDefaultListLimit = limit
+	}
+}
+
+// WithDefaultSort sets the field list requests which don't set sort_by order
+// managed groups by. It must be one of "id", the default, "name",
+// "created_time", "updated_time" or "member_count", otherwise NewService
+// fails.
+func WithDefaultSort(field string) Option {
+	return func(o *options) {
+		o.withDefaultSort = field
+	}
+}
+
+// WithMaxManagedGroupsPerAuthMethod sets the number of managed groups an auth
+// method can hold, 10000 by default. Creating a managed group in an auth method
+// which already holds that many fails. UnlimitedManagedGroups removes the cap.
+// NewService fails if max is neither positive nor UnlimitedManagedGroups.
+func WithMaxManagedGroupsPerAuthMethod(max int) Option {
+	return func(o *options) {
+		o.withMaxPerAuthMethod = max
+	}
+}
+
+// WithMaxListProcessed sets the number of managed groups a list request
+// authorizes, builds and matches against its filter before it stops and
+// reports the list as truncated. A max of 0, the default, processes every
+// managed group of the auth method. NewService fails if max is negative.
+func WithMaxListProcessed(max int) Option {
+	return func(o *options) {
+		o.withMaxListProcessed = max
+	}
+}
+
+// WithMaxScopeFanOut sets the number of auth methods a request made for a
+// whole scope, such as validating the stored filters of every auth method of
+// a scope, fans out to. When the scope holds more, the request covers the
+// first max auth methods by id and reports where to continue from. A max of
+// 0, the default, covers every auth method of the scope. NewService fails if
+// max is negative.
+func WithMaxScopeFanOut(max int) Option {
+	return func(o *options) {
+		o.withMaxScopeFanOut = max
+	}
+}
+
+// WithCompressListOver has list responses holding more than threshold managed
+// groups sent compressed with gzip to clients which accept it. A threshold of
+// 0, the default, leaves compression to the transport. NewService fails if
+// threshold is negative.
+func WithCompressListOver(threshold int) Option {
+	return func(o *options) {
+		o.withCompressListOver = threshold
+	}
+}
+
+// WithUniqueFilters rejects creating or updating an OIDC managed group whose
+// filter is equivalent to the filter of another managed group of its auth
+// method, since an account matching one would always be a member of both. By
+// default equivalent filters are allowed.
+func WithUniqueFilters(unique bool) Option {
+	return func(o *options) {
+		o.withUniqueFilters = unique
+	}
+}
+
+// WithRejectMatchAllFilters rejects writing an OIDC managed group filter which
+// appears to match every account which authenticates, such as
+// `"/token/sub" != ""`, unless the request sets allow_match_all. This applies
+// wherever a filter is written: on create, update, upsert, import, creating
+// from a template and replacing in filters. Such a filter
+// is almost always a mistake, and every role the managed group is a principal
+// of would be granted to everyone. By default these filters are allowed.
+func WithRejectMatchAllFilters(reject bool) Option {
+	return func(o *options) {
+		o.withRejectMatchAll = reject
+	}
+}
+
+// WithRpcObservations writes an observation event at the start and the end of
+// every managed group RPC, holding the ids of the request and of the resource
+// it's performed on and its outcome. By default they aren't written.
+func WithRpcObservations(observe bool) Option {
+	return func(o *options) {
+		o.withRpcObservations = observe
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("default", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		testOpts := options{
+			withMutationRateLimit: rate.Inf,
+			withReadRateLimit:     rate.Inf,
+			withDefaultSort:       sortById,
+			withMaxPerAuthMethod:  defaultMaxManagedGroupsPerAuthMethod,
+		}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMutationRateLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMutationRateLimit(rate.Limit(2), 5))
+		testOpts := getDefaultOptions()
+		testOpts.withMutationRateLimit = rate.Limit(2)
+		testOpts.withMutationBurst = 5
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithReadRateLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithReadRateLimit(rate.Limit(100), 200))
+		testOpts := getDefaultOptions()
+		testOpts.withReadRateLimit = rate.Limit(100)
+		testOpts.withReadBurst = 200
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCompressListOver", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithCompressListOver(500))
+		testOpts := getDefaultOptions()
+		testOpts.withCompressListOver = 500
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRpcObservations", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRpcObservations(true))
+		testOpts := getDefaultOptions()
+		testOpts.withRpcObservations = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUniqueFilters", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUniqueFilters(true))
+		testOpts := getDefaultOptions()
+		testOpts.withUniqueFilters = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRejectMatchAllFilters", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRejectMatchAllFilters(true))
+		testOpts := getDefaultOptions()
+		testOpts.withRejectMatchAll = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHideUnauthorized", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithHideUnauthorized(true))
+		testOpts := getDefaultOptions()
+		testOpts.withHideUnauthorized = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAllowCreateDisabled", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAllowCreateDisabled(true))
+		testOpts := getDefaultOptions()
+		testOpts.withAllowCreateDisabled = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDefaultListLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDefaultListLimit(25))
+		testOpts := getDefaultOptions()
+		testOpts.withDefaultListLimit = 25
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDefaultSort", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDefaultSort(sortByName))
+		testOpts := getDefaultOptions()
+		testOpts.withDefaultSort = sortByName
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxManagedGroupsPerAuthMethod", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxManagedGroupsPerAuthMethod(UnlimitedManagedGroups))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxPerAuthMethod = UnlimitedManagedGroups
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxListProcessed", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxListProcessed(1000))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxListProcessed = 1000
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxScopeFanOut", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxScopeFanOut(10))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxScopeFanOut = 10
+		assert.Equal(opts, testOpts)
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// memberPageToken is the cursor of a page of ListManagedGroupMembers. Members
+// are listed ordered by the time they became members and then by account id,
+// so a page is queried strictly after the last member of the previous one.
+// Memberships created while a client pages through the members come after
+// the cursor and memberships removed before it don't move it, so neither
+// causes members to be skipped or returned twice.
+type memberPageToken struct {
+	ManagedGroupId string    `json:"m"`
+	AccountId      string    `json:"a"`
+	CreatedTime    time.Time `json:"t"`
+}
+
+// encode returns the token as the opaque string clients pass back in
+// page_token.
+func (t memberPageToken) encode() string {
+	// Marshaling a struct of strings and a time can't fail.
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeMemberPageToken decodes the page_token of a request listing the
+// members of the managed group.
+func decodeMemberPageToken(managedGroupId, s string) (memberPageToken, error) {
+	var t memberPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, fmt.Errorf("token is not base64 url encoded: %w", err)
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, fmt.Errorf("token is malformed: %w", err)
+	}
+	switch {
+	case t.AccountId == "" || t.CreatedTime.IsZero():
+		return t, fmt.Errorf("token is missing its cursor")
+	case t.ManagedGroupId != managedGroupId:
+		return t, fmt.Errorf("token is for managed group %q", t.ManagedGroupId)
+	}
+	return t, nil
+}
+
+// memberPageTokenOf returns the token of a page ending with the member.
+func memberPageTokenOf(managedGroupId string, m *pbs.ManagedGroupMembership) memberPageToken {
+	return memberPageToken{
+		ManagedGroupId: managedGroupId,
+		AccountId:      m.GetAccountId(),
+		CreatedTime:    m.GetCreatedTime().AsTime(),
+	}
+}
+
+// memberOfPageToken is the cursor of a page of ListManagedGroupsByMember. The
+// managed groups of an account are listed ordered by id, so a page starts
+// strictly after the last managed group of the previous one.
+type memberOfPageToken struct {
+	AccountId      string `json:"a"`
+	ManagedGroupId string `json:"m"`
+}
+
+// encode returns the token as the opaque string clients pass back in
+// page_token.
+func (t memberOfPageToken) encode() string {
+	// Marshaling a struct of strings can't fail.
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeMemberOfPageToken decodes the page_token of a request listing the
+// managed groups of the account.
+func decodeMemberOfPageToken(accountId, s string) (memberOfPageToken, error) {
+	var t memberOfPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, fmt.Errorf("token is not base64 url encoded: %w", err)
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, fmt.Errorf("token is malformed: %w", err)
+	}
+	switch {
+	case t.ManagedGroupId == "":
+		return t, fmt.Errorf("token is missing its cursor")
+	case t.AccountId != accountId:
+		return t, fmt.Errorf("token is for account %q", t.AccountId)
+	}
+	return t, nil
+}
+
+// listPageToken is the cursor of a page of ListManagedGroups. It holds the
+// position, in the order the page was listed by, of the last managed group the
+// page covers, so the next page starts strictly after it. Managed groups are
+// ordered by the sort_by field and then by id, so no two share a position.
+// Positions don't depend on the repository a managed group was read from, so
+// a token walks managed groups of every subtype in the same order. Subtype
+// records the repository holding the managed group the page ended with.
+type listPageToken struct {
+	AuthMethodId string    `json:"a"`
+	SortBy       string    `json:"s"`
+	Subtype      string    `json:"t"`
+	Id           string    `json:"i"`
+	Name         string    `json:"n,omitempty"`
+	CreatedTime  time.Time `json:"c"`
+	UpdatedTime  time.Time `json:"u"`
+	MemberCount  int       `json:"k,omitempty"`
+}
+
+// encode returns the token as the opaque string clients pass back in
+// page_token.
+func (t listPageToken) encode() string {
+	// Marshaling a struct of strings, times and an int can't fail.
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeListPageToken decodes the page_token of a request listing the managed
+// groups of the auth method.
+func decodeListPageToken(authMethodId, s string) (listPageToken, error) {
+	var t listPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, fmt.Errorf("token is not base64 url encoded: %w", err)
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, fmt.Errorf("token is malformed: %w", err)
+	}
+	switch {
+	case t.Id == "" || !validSortField(t.SortBy):
+		return t, fmt.Errorf("token is missing its cursor")
+	case IdActions[subtypes.Subtype(t.Subtype)] == nil || subtypes.SubtypeFromId(domain, t.Id) != subtypes.Subtype(t.Subtype):
+		return t, fmt.Errorf("token's cursor is not a managed group of subtype %q", t.Subtype)
+	case t.AuthMethodId != authMethodId:
+		return t, fmt.Errorf("token is for auth method %q", t.AuthMethodId)
+	}
+	return t, nil
+}
+
+// listPageTokenOf returns the token of a page ending with the managed group
+// when listed ordered by sortBy. memberCounts holds the number of members of
+// each managed group and is only read when ordering by member count.
+func listPageTokenOf(authMethodId, sortBy string, mg auth.ManagedGroup, memberCounts map[string]int) listPageToken {
+	return listPageToken{
+		AuthMethodId: authMethodId,
+		SortBy:       sortBy,
+		Subtype:      string(subtypes.SubtypeFromId(domain, mg.GetPublicId())),
+		Id:           mg.GetPublicId(),
+		Name:         mg.GetName(),
+		CreatedTime:  mg.GetCreateTime().GetTimestamp().AsTime(),
+		UpdatedTime:  mg.GetUpdateTime().GetTimestamp().AsTime(),
+		MemberCount:  memberCounts[mg.GetPublicId()],
+	}
+}
+
+// before reports whether the position of t comes before that of o. Both must
+// be ordered by the same field. The largest managed groups come first when
+// ordering by member count; every other field is ascending.
+func (t listPageToken) before(o listPageToken) bool {
+	switch t.SortBy {
+	case sortByName:
+		if t.Name != o.Name {
+			return t.Name < o.Name
+		}
+	case sortByCreatedTime:
+		if !t.CreatedTime.Equal(o.CreatedTime) {
+			return t.CreatedTime.Before(o.CreatedTime)
+		}
+	case sortByUpdatedTime:
+		if !t.UpdatedTime.Equal(o.UpdatedTime) {
+			return t.UpdatedTime.Before(o.UpdatedTime)
+		}
+	case sortByMemberCount:
+		if t.MemberCount != o.MemberCount {
+			return t.MemberCount > o.MemberCount
+		}
+	}
+	return t.Id < o.Id
+}
+
+// orderForPage orders the managed groups by their position and, when after is
+// set, drops those at or before it, the position the previous page ended at.
+// The managed groups can be of any subtype.
+func orderForPage(ul []auth.ManagedGroup, position func(auth.ManagedGroup) listPageToken, after *listPageToken) []auth.ManagedGroup {
+	sort.Slice(ul, func(i, j int) bool {
+		return position(ul[i]).before(position(ul[j]))
+	})
+	if after == nil {
+		return ul
+	}
+	// The page starts after the position of the last managed group the
+	// previous page covered, whether or not it's still listed.
+	return ul[sort.Search(len(ul), func(i int) bool {
+		return after.before(position(ul[i]))
+	}):]
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemberPageToken(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	m := &pbs.ManagedGroupMembership{AccountId: "acctoidc_1234567890", CreatedTime: timestamppb.New(created)}
+	token := memberPageTokenOf("mgoidc_1234567890", m)
+
+	got, err := decodeMemberPageToken("mgoidc_1234567890", token.encode())
+	require.NoError(t, err)
+	assert.Equal(t, "acctoidc_1234567890", got.AccountId)
+	assert.True(t, created.Equal(got.CreatedTime))
+
+	_, err = decodeMemberPageToken("mgoidc_0987654321", token.encode())
+	assert.Error(t, err)
+	_, err = decodeMemberPageToken("mgoidc_1234567890", "not a token")
+	assert.Error(t, err)
+	_, err = decodeMemberPageToken("mgoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"m":"mgoidc_1234567890"}`)))
+	assert.Error(t, err)
+}
+
+func TestMemberOfPageToken(t *testing.T) {
+	t.Parallel()
+	token := memberOfPageToken{AccountId: "acctoidc_1234567890", ManagedGroupId: "mgoidc_1234567890"}
+
+	got, err := decodeMemberOfPageToken("acctoidc_1234567890", token.encode())
+	require.NoError(t, err)
+	assert.Equal(t, "mgoidc_1234567890", got.ManagedGroupId)
+
+	_, err = decodeMemberOfPageToken("acctoidc_0987654321", token.encode())
+	assert.Error(t, err)
+	_, err = decodeMemberOfPageToken("acctoidc_1234567890", "not a token")
+	assert.Error(t, err)
+	_, err = decodeMemberOfPageToken("acctoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"a":"acctoidc_1234567890"}`)))
+	assert.Error(t, err)
+}
+
+func TestListPageToken(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	token := listPageToken{AuthMethodId: "amoidc_1234567890", SortBy: sortByCreatedTime, Subtype: "oidc", Id: "mgoidc_1234567890", Name: "a", CreatedTime: created, UpdatedTime: created, MemberCount: 2}
+
+	got, err := decodeListPageToken("amoidc_1234567890", token.encode())
+	require.NoError(t, err)
+	assert.Equal(t, "mgoidc_1234567890", got.Id)
+	assert.Equal(t, 2, got.MemberCount)
+	assert.True(t, created.Equal(got.CreatedTime))
+
+	_, err = decodeListPageToken("amoidc_0987654321", token.encode())
+	assert.Error(t, err)
+	_, err = decodeListPageToken("amoidc_1234567890", "not a token")
+	assert.Error(t, err)
+	_, err = decodeListPageToken("amoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"a":"amoidc_1234567890","s":"id"}`)))
+	assert.Error(t, err)
+	_, err = decodeListPageToken("amoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"a":"amoidc_1234567890","s":"size","t":"oidc","i":"mgoidc_1234567890"}`)))
+	assert.Error(t, err)
+	_, err = decodeListPageToken("amoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"a":"amoidc_1234567890","s":"id","t":"ldap","i":"mgoidc_1234567890"}`)))
+	assert.Error(t, err)
+	_, err = decodeListPageToken("amoidc_1234567890", base64.RawURLEncoding.EncodeToString([]byte(`{"a":"amoidc_1234567890","s":"id","t":"password","i":"mgpw_1234567890"}`)))
+	assert.Error(t, err)
+}
+
+func TestListPageToken_before(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := earlier.Add(time.Second)
+	a := listPageToken{Id: "mgoidc_a", Name: "z", CreatedTime: later, UpdatedTime: earlier, MemberCount: 1}
+	b := listPageToken{Id: "mgoidc_b", Name: "y", CreatedTime: earlier, UpdatedTime: earlier, MemberCount: 2}
+
+	tests := []struct {
+		sortBy string
+		want   bool
+	}{
+		{sortBy: sortById, want: true},
+		{sortBy: sortByName, want: false},
+		{sortBy: sortByCreatedTime, want: false},
+		// Equal times are ordered by id.
+		{sortBy: sortByUpdatedTime, want: true},
+		// The largest managed groups come first.
+		{sortBy: sortByMemberCount, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			a, b := a, b
+			a.SortBy, b.SortBy = tt.sortBy, tt.sortBy
+			assert.Equal(t, tt.want, a.before(b))
+			assert.Equal(t, !tt.want, b.before(a))
+			assert.False(t, a.before(a))
+		})
+	}
+}
+
+func TestOrderForPage_mixedSubtypes(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := earlier.Add(time.Second)
+	oidcMg := func(id, name string, created time.Time) auth.ManagedGroup {
+		mg := oidc.AllocManagedGroup()
+		mg.PublicId, mg.Name = id, name
+		mg.CreateTime, mg.UpdateTime = timestamp.New(created), timestamp.New(earlier)
+		return mg
+	}
+	ldapMg := func(id, name string, created time.Time) auth.ManagedGroup {
+		mg := ldap.AllocManagedGroup()
+		mg.PublicId, mg.Name = id, name
+		mg.CreateTime, mg.UpdateTime = timestamp.New(created), timestamp.New(earlier)
+		return mg
+	}
+	groups := []auth.ManagedGroup{
+		oidcMg("mgoidc_b", "d", earlier),
+		ldapMg("mgldap_b", "a", later),
+		oidcMg("mgoidc_a", "b", later),
+		ldapMg("mgldap_a", "c", earlier),
+		ldapMg("mgldap_c", "b", earlier),
+	}
+	memberCounts := map[string]int{"mgoidc_a": 2, "mgldap_b": 2, "mgldap_c": 1}
+
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{sortBy: sortById, want: []string{"mgldap_a", "mgldap_b", "mgldap_c", "mgoidc_a", "mgoidc_b"}},
+		// Ties are ordered by id, whichever the subtype.
+		{sortBy: sortByName, want: []string{"mgldap_b", "mgldap_c", "mgoidc_a", "mgldap_a", "mgoidc_b"}},
+		{sortBy: sortByCreatedTime, want: []string{"mgldap_a", "mgldap_c", "mgoidc_b", "mgldap_b", "mgoidc_a"}},
+		{sortBy: sortByUpdatedTime, want: []string{"mgldap_a", "mgldap_b", "mgldap_c", "mgoidc_a", "mgoidc_b"}},
+		{sortBy: sortByMemberCount, want: []string{"mgldap_b", "mgoidc_a", "mgldap_c", "mgldap_a", "mgoidc_b"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.sortBy, func(t *testing.T) {
+			t.Parallel()
+			position := func(mg auth.ManagedGroup) listPageToken {
+				return listPageTokenOf("amoidc_1234567890", tt.sortBy, mg, memberCounts)
+			}
+			// Every page of 2 is listed from a freshly shuffled slice, as
+			// each request reads the managed groups again.
+			var got []string
+			var after *listPageToken
+			for page := 0; page < len(groups); page++ {
+				ul := append([]auth.ManagedGroup(nil), groups...)
+				rand.New(rand.NewSource(int64(page))).Shuffle(len(ul), func(i, j int) { ul[i], ul[j] = ul[j], ul[i] })
+				ul = orderForPage(ul, position, after)
+				if len(ul) == 0 {
+					break
+				}
+				if len(ul) > 2 {
+					ul = ul[:2]
+				}
+				for _, mg := range ul {
+					got = append(got, mg.GetPublicId())
+				}
+				next, err := decodeListPageToken("amoidc_1234567890", position(ul[len(ul)-1]).encode())
+				require.NoError(t, err)
+				after = &next
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
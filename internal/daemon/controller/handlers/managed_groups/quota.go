@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// UnlimitedManagedGroups can be passed to WithMaxManagedGroupsPerAuthMethod
+	// to allow any number of managed groups in an auth method.
+	UnlimitedManagedGroups = -1
+
+	// defaultMaxManagedGroupsPerAuthMethod is the number of managed groups an
+	// auth method can hold unless configured otherwise.
+	defaultMaxManagedGroupsPerAuthMethod = 10000
+
+	// quotaLockStripes is the number of locks the creations of managed groups
+	// are serialized on. Auth methods share a lock when their ids hash to the
+	// same stripe.
+	quotaLockStripes = 64
+)
+
+// managedGroupQuota caps the number of managed groups in each auth method.
+//
+// The managed groups of an auth method are counted before one is created, so
+// concurrent creations could each see room for one more. Creations in the same
+// auth method are serialized by this controller while it counts and creates,
+// which keeps a single controller under the cap. Controllers don't coordinate,
+// so with several of them an auth method can end up slightly over the cap.
+type managedGroupQuota struct {
+	max   int
+	locks [quotaLockStripes]sync.Mutex
+}
+
+func newManagedGroupQuota(max int) *managedGroupQuota {
+	return &managedGroupQuota{max: max}
+}
+
+// unlimited reports whether the quota doesn't cap anything.
+func (q *managedGroupQuota) unlimited() bool {
+	return q.max == UnlimitedManagedGroups
+}
+
+// lock serializes the creation of managed groups in the auth method until the
+// returned func is called.
+func (q *managedGroupQuota) lock(authMethodId string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(authMethodId))
+	mu := &q.locks[h.Sum32()%quotaLockStripes]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// check returns a ResourceExhausted error naming the cap if an auth method
+// which holds count managed groups can't hold another one.
+func (q *managedGroupQuota) check(authMethodId string, count int) error {
+	if q.unlimited() || count < q.max {
+		return nil
+	}
+	return handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted,
+		"Auth method %q already has the maximum of %d managed groups.", authMethodId, q.max)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func Test_managedGroupQuota(t *testing.T) {
+	t.Parallel()
+	t.Run("unlimited", func(t *testing.T) {
+		q := newManagedGroupQuota(UnlimitedManagedGroups)
+		assert.True(t, q.unlimited())
+		assert.NoError(t, q.check("amoidc_1234567890", defaultMaxManagedGroupsPerAuthMethod*10))
+	})
+	t.Run("at-the-cap", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		q := newManagedGroupQuota(2)
+		assert.False(q.unlimited())
+		require.NoError(q.check("amoidc_1234567890", 1))
+		err := q.check("amoidc_1234567890", 2)
+		require.Error(err)
+		var apiErr *handlers.ApiError
+		require.ErrorAs(err, &apiErr)
+		assert.Equal(codes.ResourceExhausted.String(), apiErr.Inner.GetKind())
+		assert.Contains(err.Error(), "maximum of 2 managed groups")
+		assert.Contains(err.Error(), "amoidc_1234567890")
+	})
+	t.Run("lock-serializes", func(t *testing.T) {
+		q := newManagedGroupQuota(1)
+		var wg sync.WaitGroup
+		var created int
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer q.lock("amoidc_1234567890")()
+				if q.check("amoidc_1234567890", created) == nil {
+					created++
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, created)
+	})
+}
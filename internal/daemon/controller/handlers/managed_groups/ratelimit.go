@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+)
+
+// maxTrackedPrincipals bounds the number of users a principalLimiter tracks.
+const maxTrackedPrincipals = 10000
+
+// principalLimiter keeps a token bucket per user id.
+type principalLimiter struct {
+	limit      rate.Limit
+	burst      int
+	maxTracked int
+	now        func() time.Time
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newPrincipalLimiter(limit rate.Limit, burst int) *principalLimiter {
+	return &principalLimiter{
+		limit:      limit,
+		burst:      burst,
+		maxTracked: maxTrackedPrincipals,
+		now:        time.Now,
+		limiters:   make(map[string]*rate.Limiter),
+	}
+}
+
+// allow takes a token from the user's bucket. If none is available it returns
+// a ResourceExhausted error which tells the caller when to retry.
+func (l *principalLimiter) allow(userId string) error {
+	if l == nil || l.limit == rate.Inf {
+		return nil
+	}
+	now := l.now()
+
+	l.mu.Lock()
+	lim, ok := l.limiters[userId]
+	if !ok {
+		if len(l.limiters) >= l.maxTracked {
+			l.prune(now)
+		}
+		lim = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[userId] = lim
+	}
+	l.mu.Unlock()
+
+	r := lim.ReserveN(now, 1)
+	if !r.OK() {
+		return handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted, "Too many managed group requests.")
+	}
+	delay := r.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+	r.CancelAt(now)
+	return handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted,
+		"Too many managed group requests; retry after %d seconds.", int(math.Ceil(delay.Seconds())))
+}
+
+// prune forgets users whose bucket is full, since a new bucket behaves the
+// same. If every tracked user is still limited it forgets the one whose bucket
+// is the fullest instead, so a new user can always be tracked without
+// exceeding maxTracked. It must be called with l.mu held.
+func (l *principalLimiter) prune(now time.Time) {
+	fullestId, fullest := "", math.Inf(-1)
+	for id, lim := range l.limiters {
+		tokens := lim.TokensAt(now)
+		if tokens >= float64(l.burst) {
+			delete(l.limiters, id)
+			continue
+		}
+		if tokens > fullest {
+			fullestId, fullest = id, tokens
+		}
+	}
+	if len(l.limiters) >= l.maxTracked {
+		delete(l.limiters, fullestId)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+)
+
+func Test_principalLimiter(t *testing.T) {
+	t.Parallel()
+	t.Run("unlimited", func(t *testing.T) {
+		l := newPrincipalLimiter(rate.Inf, 0)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, l.allow("u_1234567890"))
+		}
+		assert.Empty(t, l.limiters)
+	})
+	t.Run("burst-then-limited", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		now := time.Now()
+		l := newPrincipalLimiter(rate.Every(10*time.Second), 2)
+		l.now = func() time.Time { return now }
+
+		require.NoError(l.allow("u_1234567890"))
+		require.NoError(l.allow("u_1234567890"))
+		err := l.allow("u_1234567890")
+		require.Error(err)
+		var apiErr *handlers.ApiError
+		require.ErrorAs(err, &apiErr)
+		assert.Equal(codes.ResourceExhausted.String(), apiErr.Inner.GetKind())
+		assert.Contains(err.Error(), "retry after 10 seconds")
+
+		// Other users have their own bucket
+		require.NoError(l.allow("u_0987654321"))
+
+		// A denied request doesn't consume a token
+		now = now.Add(10 * time.Second)
+		require.NoError(l.allow("u_1234567890"))
+		require.Error(l.allow("u_1234567890"))
+	})
+	t.Run("prune", func(t *testing.T) {
+		now := time.Now()
+		l := newPrincipalLimiter(rate.Every(time.Second), 1)
+		l.now = func() time.Time { return now }
+		require.NoError(t, l.allow("u_1234567890"))
+		require.NoError(t, l.allow("u_0987654321"))
+		now = now.Add(time.Second)
+		require.NoError(t, l.allow("u_0987654321"))
+
+		l.mu.Lock()
+		l.prune(now)
+		l.mu.Unlock()
+		assert.NotContains(t, l.limiters, "u_1234567890")
+		assert.Contains(t, l.limiters, "u_0987654321")
+	})
+	t.Run("bounded", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		now := time.Now()
+		l := newPrincipalLimiter(rate.Every(10*time.Second), 2)
+		l.maxTracked = 2
+		l.now = func() time.Time { return now }
+		// Neither bucket refills before the third user arrives.
+		require.NoError(l.allow("u_1111111111"))
+		require.NoError(l.allow("u_1111111111"))
+		require.NoError(l.allow("u_2222222222"))
+		require.NoError(l.allow("u_3333333333"))
+
+		assert.Len(l.limiters, 2)
+		// The user with the fullest bucket is forgotten.
+		assert.Contains(l.limiters, "u_1111111111")
+		assert.NotContains(l.limiters, "u_2222222222")
+		assert.Contains(l.limiters, "u_3333333333")
+	})
+}
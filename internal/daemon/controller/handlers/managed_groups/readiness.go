@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// readinessProbeSuffix completes the managed group id which is looked up to
+// exercise each repository. Such an id is never issued, so the lookup finds
+// nothing.
+const readinessProbeSuffix = "_readiness"
+
+// RepoReadiness is the readiness of the repository backing one managed group
+// subtype.
+type RepoReadiness struct {
+	Subtype subtypes.Subtype
+	// FactoryErr is set when the repository could not be acquired.
+	FactoryErr error
+	// QueryErr is set when the repository was acquired but querying it failed.
+	QueryErr error
+}
+
+// Ready reports whether the repository was acquired and queried successfully.
+func (r RepoReadiness) Ready() bool {
+	return r.FactoryErr == nil && r.QueryErr == nil
+}
+
+// Readiness is the result of Service.Readiness.
+type Readiness struct {
+	Repos []RepoReadiness
+}
+
+// Ready reports whether every repository is ready.
+func (r Readiness) Ready() bool {
+	for _, repo := range r.Repos {
+		if !repo.Ready() {
+			return false
+		}
+	}
+	return true
+}
+
+// Readiness acquires the repository of each managed group subtype and runs a
+// trivial query against it. It is meant to be aggregated by the controller's
+// health checks and does no authorization.
+func (s Service) Readiness(ctx context.Context) Readiness {
+	oidcResult := RepoReadiness{Subtype: oidc.Subtype}
+	if repo, err := s.oidcRepoFn(); err != nil {
+		oidcResult.FactoryErr = err
+	} else if _, err := repo.LookupManagedGroup(ctx, globals.OidcManagedGroupPrefix+readinessProbeSuffix); err != nil {
+		oidcResult.QueryErr = err
+	}
+
+	ldapResult := RepoReadiness{Subtype: ldap.Subtype}
+	if repo, err := s.ldapRepoFn(); err != nil {
+		ldapResult.FactoryErr = err
+	} else if _, err := repo.LookupManagedGroup(ctx, globals.LdapManagedGroupPrefix+readinessProbeSuffix); err != nil {
+		ldapResult.QueryErr = err
+	}
+
+	return Readiness{Repos: []RepoReadiness{oidcResult, ldapResult}}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadiness(t *testing.T) {
+	ctx := context.Background()
+	factoryErr := errors.New("no repository for you")
+
+	t.Run("factory errors", func(t *testing.T) {
+		s, err := managed_groups.NewService(ctx,
+			func() (*oidc.Repository, error) { return nil, factoryErr },
+			func() (*ldap.Repository, error) { return nil, factoryErr },
+			func() (*iam.Repository, error) { return nil, factoryErr },
+		)
+		require.NoError(t, err)
+		got := s.Readiness(ctx)
+		assert.False(t, got.Ready())
+		require.Len(t, got.Repos, 2)
+		for _, r := range got.Repos {
+			assert.False(t, r.Ready())
+			assert.ErrorIs(t, r.FactoryErr, factoryErr)
+			assert.NoError(t, r.QueryErr)
+		}
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		kmsCache := kms.TestKms(t, conn, db.TestWrapper(t))
+		s, err := managed_groups.NewService(ctx,
+			func() (*oidc.Repository, error) { return oidc.NewRepository(ctx, rw, rw, kmsCache) },
+			func() (*ldap.Repository, error) { return ldap.NewRepository(ctx, rw, rw, kmsCache) },
+			func() (*iam.Repository, error) { return iam.NewRepository(ctx, rw, rw, kmsCache) },
+		)
+		require.NoError(t, err)
+		got := s.Readiness(ctx)
+		assert.True(t, got.Ready(), "%+v", got)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+	"database/sql/driver"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+)
+
+// repoUnavailableMsg is the message of the error returned when a repository
+// can't be acquired or used because the database can't be reached.
+const repoUnavailableMsg = "Managed group storage is temporarily unavailable; retry the request later."
+
+// repoError translates the error returned by one of the service's repository
+// factories or by a repository call. An error caused by the database being
+// unreachable, e.g. a dropped connection during a failover, is returned as an
+// Unavailable API error so clients know the request can be retried with
+// backoff. An API error, such as one already translated by a helper which
+// called the repository, is returned as is. Any other error is wrapped with
+// opt, so its code is kept for the API error it surfaces as.
+func repoError(ctx context.Context, op errors.Op, err error, opt ...errors.Option) error {
+	var apiErr *handlers.ApiError
+	switch {
+	case errors.As(err, &apiErr):
+		return err
+	case isTransientRepoError(err):
+		return handlers.ApiErrorWithCodeAndMessage(codes.Unavailable, repoUnavailableMsg)
+	}
+	return errors.Wrap(ctx, err, op, opt...)
+}
+
+// isTransientRepoError reports whether err was caused by a connection to the
+// database failing rather than by the request or the controller's code.
+func isTransientRepoError(err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, driver.ErrBadConn),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Match(errors.T(errors.Unavailable), err),
+		pgconn.Timeout(err),
+		pgconn.SafeToRetry(err):
+		return true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 is connection exceptions, 57P01-57P03 are the server
+		// shutting down or not accepting connections yet.
+		return strings.HasPrefix(pgErr.Code, "08") ||
+			pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// requestContextError translates the error of a request's context which was
+// canceled, e.g. by the client disconnecting, or whose deadline passed while
+// the request was processed into the matching API error.
+func requestContextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return handlers.ApiErrorWithCodeAndMessage(codes.DeadlineExceeded, "The request's deadline passed before it was completed.")
+	}
+	return handlers.ApiErrorWithCodeAndMessage(codes.Canceled, "The request was canceled before it was completed.")
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRepoError(t *testing.T) {
+	ctx := context.Background()
+	const op = "managed_groups.TestRepoError"
+	tests := []struct {
+		name          string
+		err           error
+		wantTransient bool
+	}{
+		{
+			name:          "bad-conn",
+			err:           fmt.Errorf("acquiring connection: %w", driver.ErrBadConn),
+			wantTransient: true,
+		},
+		{
+			name:          "deadline-exceeded",
+			err:           context.DeadlineExceeded,
+			wantTransient: true,
+		},
+		{
+			name:          "net-error",
+			err:           &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")},
+			wantTransient: true,
+		},
+		{
+			name:          "connection-exception",
+			err:           errors.Wrap(ctx, &pgconn.PgError{Code: "08006"}, op),
+			wantTransient: true,
+		},
+		{
+			name:          "server-shutting-down",
+			err:           &pgconn.PgError{Code: "57P01"},
+			wantTransient: true,
+		},
+		{
+			name:          "unavailable-code",
+			err:           errors.New(ctx, errors.Unavailable, op, "database is unavailable"),
+			wantTransient: true,
+		},
+		{
+			name: "invalid-parameter",
+			err:  errors.New(ctx, errors.InvalidParameter, op, "kms is nil"),
+		},
+		{
+			name: "undefined-table",
+			err:  &pgconn.PgError{Code: "42P01"},
+		},
+		{
+			name: "plain-error",
+			err:  fmt.Errorf("nil pointer"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got := repoError(ctx, op, tc.err)
+			require.Error(got)
+			var apiErr *handlers.ApiError
+			if tc.wantTransient {
+				require.True(errors.As(got, &apiErr), "got error %v", got)
+				assert.Equal(codes.Unavailable.String(), apiErr.Inner.GetKind())
+				assert.Contains(apiErr.Inner.GetMessage(), "retry")
+				return
+			}
+			assert.False(errors.As(got, &apiErr), "got error %v", got)
+			assert.ErrorIs(got, tc.err)
+			assert.Equal(codes.Internal.String(), handlers.ToApiError(got).GetKind())
+		})
+	}
+
+	t.Run("api-error", func(t *testing.T) {
+		// An error already translated by a helper isn't wrapped again, which
+		// would hide it behind an Internal error.
+		err := handlers.ApiErrorWithCodeAndMessage(codes.Unavailable, repoUnavailableMsg)
+		assert.Equal(t, err, repoError(ctx, op, err))
+		notFound := handlers.NotFoundError()
+		assert.Equal(t, notFound, repoError(ctx, op, notFound))
+	})
+	t.Run("with-msg", func(t *testing.T) {
+		err := repoError(ctx, op, errors.New(ctx, errors.RecordNotFound, op, "not found"), errors.WithMsg("unable to look up managed group"))
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+		assert.Contains(t, err.Error(), "unable to look up managed group")
+	})
+}
+
+func TestRepoError_paths(t *testing.T) {
+	ctx := context.Background()
+	blip := &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}
+	s := Service{
+		oidcRepoFn: func() (*oidc.Repository, error) { return nil, blip },
+		ldapRepoFn: func() (*ldap.Repository, error) { return nil, blip },
+	}
+
+	// Both the single resource and the list paths translate a factory error
+	// the same way.
+	_, err := s.memberIdsFromRepo(ctx, oidc.AllocManagedGroup())
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unavailable)), "got error %v", err)
+	_, err = s.listFromRepo(ctx, "amoidc_1234567890", listOptions{})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unavailable)), "got error %v", err)
+	_, err = s.countFromRepo(ctx, "amldap_1234567890", listOptions{})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unavailable)), "got error %v", err)
+}
+
+func TestRequestContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := requestContextError(ctx.Err())
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Canceled)), "got error %v", err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-ctx.Done()
+	err = requestContextError(ctx.Err())
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.DeadlineExceeded)), "got error %v", err)
+}
+
+func TestMissingAuthMethodError(t *testing.T) {
+	const amId = "amoidc_1234567890"
+
+	// An auth method looked up by its id alone may never have existed.
+	err := missingAuthMethodError(amId, nil)
+	assert.Equal(t, handlers.NotFoundError(), err)
+
+	// The auth method of a managed group which was just read was deleted.
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	err = missingAuthMethodError(amId, mg)
+	require.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got %v", err)
+	var apiErr *handlers.ApiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, `Auth method "amoidc_1234567890" of managed group "mgoidc_1234567890" has been deleted.`, apiErr.Inner.GetMessage())
+}
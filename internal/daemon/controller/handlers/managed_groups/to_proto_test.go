@@ -0,0 +1,836 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_groups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	pbauthmethods "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func testOutputFields(t *testing.T) handlers.Option {
+	t.Helper()
+	return handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{"*"}))
+}
+
+func TestToProto_deterministicAttributes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	oidcMg := oidc.AllocManagedGroup()
+	oidcMg.PublicId = "mgoidc_1234567890"
+	oidcMg.Filter = `"/token/sub" == "alice"`
+
+	ldapMg := ldap.AllocManagedGroup()
+	ldapMg.PublicId = "mgldap_1234567890"
+	ldapMg.GroupNames = `["test","admin"]`
+
+	cases := []struct {
+		name    string
+		in      auth.ManagedGroup
+		attrsOf func(*pb.ManagedGroup) proto.Message
+		attrs   string
+	}{
+		{
+			name:    "oidc",
+			in:      oidcMg,
+			attrsOf: func(mg *pb.ManagedGroup) proto.Message { return mg.GetOidcManagedGroupAttributes() },
+			attrs:   `{"filter":"\"/token/sub\" == \"alice\""}`,
+		},
+		{
+			name:    "ldap",
+			in:      ldapMg,
+			attrsOf: func(mg *pb.ManagedGroup) proto.Message { return mg.GetLdapManagedGroupAttributes() },
+			attrs:   `{"group_names":["test","admin"]}`,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var first []byte
+			for i := 0; i < 20; i++ {
+				item, err := toProto(ctx, tc.in, testOutputFields(t))
+				require.NoError(t, err)
+
+				attrs, err := handlers.ProtoToStruct(tc.attrsOf(item))
+				require.NoError(t, err)
+				js, err := protojson.Marshal(attrs)
+				require.NoError(t, err)
+				var compact bytes.Buffer
+				require.NoError(t, json.Compact(&compact, js))
+				if first == nil {
+					first = compact.Bytes()
+					assert.Equal(t, tc.attrs, compact.String())
+					continue
+				}
+				assert.Equal(t, first, compact.Bytes())
+			}
+		})
+	}
+}
+
+func TestToProto_attributesJson(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	oidcMg := oidc.AllocManagedGroup()
+	oidcMg.PublicId = "mgoidc_1234567890"
+	oidcMg.Filter = `"/token/sub" == "alice"`
+	oidcMg.MatchCaseInsensitive = true
+
+	ldapMg := ldap.AllocManagedGroup()
+	ldapMg.PublicId = "mgldap_1234567890"
+	ldapMg.GroupNames = `["test","admin"]`
+
+	cases := []struct {
+		name  string
+		in    auth.ManagedGroup
+		attrs string
+	}{
+		{
+			name:  "oidc",
+			in:    oidcMg,
+			attrs: `{"filter":"\"/token/sub\" == \"alice\"","match_options":{"case_insensitive":true}}`,
+		},
+		{
+			name:  "ldap",
+			in:    ldapMg,
+			attrs: `{"group_names":["test","admin"]}`,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			item, err := toProto(ctx, tc.in, testOutputFields(t), handlers.WithAttributesJson(true))
+			require.NoError(t, err)
+			assert.Equal(t, tc.attrs, item.GetAttributesJson())
+			assert.Nil(t, item.GetAttrs())
+
+			// The JSON holds the same attributes as the default form.
+			item, err = toProto(ctx, tc.in, testOutputFields(t))
+			require.NoError(t, err)
+			assert.Empty(t, item.GetAttributesJson())
+			var attrs proto.Message = item.GetOidcManagedGroupAttributes()
+			if item.GetLdapManagedGroupAttributes() != nil {
+				attrs = item.GetLdapManagedGroupAttributes()
+			}
+			st, err := handlers.ProtoToStruct(attrs)
+			require.NoError(t, err)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal([]byte(tc.attrs), &got))
+			assert.Equal(t, st.AsMap(), got)
+		})
+	}
+
+	// Attributes the caller isn't allowed to see aren't encoded either.
+	item, err := toProto(ctx, oidcMg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})), handlers.WithAttributesJson(true))
+	require.NoError(t, err)
+	assert.Empty(t, item.GetAttributesJson())
+}
+
+func TestToProto_attributesAlwaysSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	oidcMg := oidc.AllocManagedGroup()
+	oidcMg.PublicId = "mgoidc_1234567890"
+	oidcMg.Filter = `"/token/sub" == "alice"`
+
+	// An LDAP managed group without group names has no attributes set.
+	ldapMg := ldap.AllocManagedGroup()
+	ldapMg.PublicId = "mgldap_1234567890"
+	ldapMg.GroupNames = `[]`
+
+	withoutType := handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.AttributesField, attrFilterField}))
+	cases := []struct {
+		name  string
+		in    auth.ManagedGroup
+		attrs map[string]any
+	}{
+		{
+			name:  "oidc",
+			in:    oidcMg,
+			attrs: map[string]any{"filter": `"/token/sub" == "alice"`},
+		},
+		{
+			name:  "empty ldap",
+			in:    ldapMg,
+			attrs: map[string]any{},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			item, err := toProto(ctx, tc.in, testOutputFields(t))
+			require.NoError(t, err)
+			require.NotNil(t, item.GetAttrs())
+			assert.Nil(t, item.GetAttributes())
+
+			// Without the type the attributes are set as the generic struct
+			// rather than left for the response to drop.
+			item, err = toProto(ctx, tc.in, withoutType)
+			require.NoError(t, err)
+			require.NotNil(t, item.GetAttributes())
+			assert.Equal(t, tc.attrs, item.GetAttributes().AsMap())
+
+			item, err = toProto(ctx, tc.in, withoutType, handlers.WithAttributesJson(true))
+			require.NoError(t, err)
+			assert.Nil(t, item.GetAttrs())
+			var got map[string]any
+			require.NoError(t, json.Unmarshal([]byte(item.GetAttributesJson()), &got))
+			assert.Equal(t, tc.attrs, got)
+		})
+	}
+}
+
+func TestToProto_populatedFields(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	limited := handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.NameField, globals.AttributesField}))
+
+	item, err := toProto(ctx, mg, limited)
+	require.NoError(t, err)
+	assert.Empty(t, item.GetPopulatedFields())
+
+	// The name is allowed but empty, so it is listed without being set.
+	item, err = toProto(ctx, mg, limited, handlers.WithPopulatedFields(true))
+	require.NoError(t, err)
+	assert.Equal(t, []string{globals.IdField, globals.NameField, globals.AttributesField}, item.GetPopulatedFields())
+	assert.Nil(t, item.GetName())
+	assert.Empty(t, item.GetAuthMethodId())
+}
+
+func TestToProto_filterOutputField(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	mg.FilterVersion = 3
+
+	// Without the filter output field the attributes are output without
+	// the filter.
+	metadataOnly := (&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.AttributesField})
+	item, err := toProto(ctx, mg, handlers.WithOutputFields(metadataOnly))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"filter_version": float64(3)}, item.GetAttributes().AsMap())
+
+	// The filter output field alone outputs only the filter.
+	filterOnly := (&perms.OutputFields{}).AddFields([]string{globals.IdField, attrFilterField})
+	item, err = toProto(ctx, mg, handlers.WithOutputFields(filterOnly), handlers.WithPopulatedFields(true))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"filter": mg.Filter}, item.GetAttributes().AsMap())
+	assert.Equal(t, []string{globals.IdField, globals.AttributesField}, item.GetPopulatedFields())
+
+	both := (&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.AttributesField, attrFilterField})
+	item, err = toProto(ctx, mg, handlers.WithOutputFields(both))
+	require.NoError(t, err)
+	assert.Equal(t, mg.Filter, item.GetAttributes().AsMap()["filter"])
+	assert.Equal(t, float64(3), item.GetAttributes().AsMap()["filter_version"])
+
+	// Callers with every output field see the filter.
+	item, err = toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, mg.Filter, item.GetOidcManagedGroupAttributes().GetFilter())
+
+	// Requesting the attributes doesn't drop the filter for callers
+	// authorized to see it.
+	restricted := restrictOutputFields((&perms.OutputFields{}).AddFields([]string{"*"}), []string{globals.AttributesField})
+	assert.True(t, restricted.Has(attrFilterField))
+
+	// The filter is left out of the changes of callers not allowed it.
+	before, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	after := proto.Clone(before).(*pb.ManagedGroup)
+	after.GetOidcManagedGroupAttributes().Filter = `"/token/sub" == "bob"`
+	changes, err := fieldChanges(before, after, metadataOnly, []string{attrFilterField})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	changes, err = fieldChanges(before, after, filterOnly, []string{attrFilterField})
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+}
+
+func TestToProto_fieldUpdatedTimes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	mg.NameUpdateTime = timestamp.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	mg.FilterUpdateTime = timestamp.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	// A field changed before the times were recorded has no time.
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pb.FieldUpdatedTimes{Name: mg.GetNameUpdateTime().GetTimestamp(), Filter: mg.GetFilterUpdateTime().GetTimestamp()}, item.GetFieldUpdatedTimes(), protocmp.Transform()))
+
+	item, err = toProto(ctx, mg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})))
+	require.NoError(t, err)
+	assert.Nil(t, item.GetFieldUpdatedTimes())
+}
+
+func TestToProto_filterSyntaxVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Empty(t, item.GetOidcManagedGroupAttributes().GetFilterSyntaxVersion())
+
+	mg.FilterSyntaxVersion = "v0.1.12"
+	item, err = toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, "v0.1.12", item.GetOidcManagedGroupAttributes().GetFilterSyntaxVersion())
+	assert.False(t, item.GetOidcManagedGroupAttributes().GetFilterHasWarnings())
+
+	mg.FilterHasWarnings = true
+	item, err = toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.True(t, item.GetOidcManagedGroupAttributes().GetFilterHasWarnings())
+	assert.Zero(t, item.GetOidcManagedGroupAttributes().GetFilterComplexity())
+
+	mg.FilterComplexity = 3
+	item, err = toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), item.GetOidcManagedGroupAttributes().GetFilterComplexity())
+}
+
+func TestToProto_timestampsUtc(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	mg.CreateTime = timestamp.New(time.Date(2024, 1, 1, 9, 0, 0, 0, tokyo))
+	mg.UpdateTime = timestamp.New(time.Date(2024, 2, 1, 9, 0, 0, 0, tokyo))
+	mg.NameUpdateTime = timestamp.New(time.Date(2024, 3, 1, 9, 0, 0, 0, tokyo))
+
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, item.GetCreatedTime().AsTime().Location())
+	assert.Equal(t, time.UTC, item.GetUpdatedTime().AsTime().Location())
+	assert.Equal(t, time.UTC, item.GetFieldUpdatedTimes().GetName().AsTime().Location())
+
+	b, err := protojson.Marshal(item)
+	require.NoError(t, err)
+	var got struct {
+		CreatedTime       string `json:"created_time"`
+		UpdatedTime       string `json:"updated_time"`
+		FieldUpdatedTimes struct {
+			Name string `json:"name"`
+		} `json:"field_updated_times"`
+	}
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, "2024-01-01T00:00:00Z", got.CreatedTime)
+	assert.Equal(t, "2024-02-01T00:00:00Z", got.UpdatedTime)
+	assert.Equal(t, "2024-03-01T00:00:00Z", got.FieldUpdatedTimes.Name)
+}
+
+func TestToProto_roleCount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+
+	// Without a count none is output.
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Nil(t, item.GetRoleCount())
+
+	// A count of 0 is still output.
+	item, err = toProto(ctx, mg, testOutputFields(t), handlers.WithRoleCount(0))
+	require.NoError(t, err)
+	require.NotNil(t, item.GetRoleCount())
+	assert.Equal(t, uint32(0), item.GetRoleCount().GetValue())
+
+	item, err = toProto(ctx, mg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})), handlers.WithRoleCount(2))
+	require.NoError(t, err)
+	assert.Nil(t, item.GetRoleCount())
+}
+
+func TestToProto_tags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == "alice"`
+	tags := map[string]string{"team": "eng", "env": "prod"}
+
+	item, err := toProto(ctx, mg, testOutputFields(t), handlers.WithTags(tags), handlers.WithPopulatedFields(true))
+	require.NoError(t, err)
+	assert.Equal(t, tags, item.GetTags())
+	assert.Contains(t, item.GetPopulatedFields(), globals.TagsField)
+
+	// Without tags none are output or reported as populated.
+	item, err = toProto(ctx, mg, testOutputFields(t), handlers.WithPopulatedFields(true))
+	require.NoError(t, err)
+	assert.Empty(t, item.GetTags())
+	assert.NotContains(t, item.GetPopulatedFields(), globals.TagsField)
+
+	item, err = toProto(ctx, mg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})), handlers.WithTags(tags))
+	require.NoError(t, err)
+	assert.Empty(t, item.GetTags())
+}
+
+// fakeManagedGroup is a managed group of a subtype toProto doesn't know.
+type fakeManagedGroup struct {
+	*oidc.ManagedGroup
+}
+
+func TestToProto_unknownSubtype(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := fakeManagedGroup{ManagedGroup: oidc.AllocManagedGroup()}
+	mg.PublicId = "mgfake_1234567890"
+
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.Internal), err))
+	assert.Contains(t, err.Error(), "unknown managed group type managed_groups.fakeManagedGroup")
+	assert.Nil(t, item)
+
+	// The subtype is checked even when neither the type nor the attributes
+	// are output.
+	_, err = toProto(ctx, mg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.IdField})))
+	require.Error(t, err)
+}
+
+func TestToDefinition(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	oidcMg := oidc.AllocManagedGroup()
+	oidcMg.PublicId = "mgoidc_1234567890"
+	oidcMg.Name = "name"
+	oidcMg.Description = "description"
+	oidcMg.Version = 3
+	oidcMg.Filter = `"/token/sub" == "alice"`
+
+	ldapMg := ldap.AllocManagedGroup()
+	ldapMg.PublicId = "mgldap_1234567890"
+	ldapMg.Name = "name"
+	ldapMg.GroupNames = `["test","admin"]`
+
+	badLdapMg := ldap.AllocManagedGroup()
+	badLdapMg.GroupNames = `not json`
+
+	all := (&perms.OutputFields{}).AddFields([]string{"*"})
+	cases := []struct {
+		name         string
+		in           auth.ManagedGroup
+		outputFields *perms.OutputFields
+		want         *pbs.ManagedGroupDefinition
+		wantErr      bool
+	}{
+		{
+			name: "oidc",
+			in:   oidcMg,
+			want: &pbs.ManagedGroupDefinition{Name: "name", Description: "description", Filter: `"/token/sub" == "alice"`},
+		},
+		{
+			name:         "oidc without the filter field",
+			in:           oidcMg,
+			outputFields: (&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.AttributesField}),
+			want:         &pbs.ManagedGroupDefinition{Name: "name", Description: "description"},
+		},
+		{
+			name: "ldap",
+			in:   ldapMg,
+			want: &pbs.ManagedGroupDefinition{Name: "name", GroupNames: []string{"test", "admin"}},
+		},
+		{
+			name:    "bad group names",
+			in:      badLdapMg,
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			outputFields := tc.outputFields
+			if outputFields == nil {
+				outputFields = all
+			}
+			got, err := toDefinition(ctx, tc.in, outputFields)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tc.want, got, protocmp.Transform()))
+		})
+	}
+}
+
+func TestTemplateToProto(t *testing.T) {
+	t.Parallel()
+	tmpl := oidc.AllocManagedGroupTemplate()
+	tmpl.PublicId = "mgt_1234567890"
+	tmpl.Name = "name"
+	tmpl.Filter = `"/token/sub" == "alice"`
+
+	got := templateToProto(tmpl, (&perms.OutputFields{}).AddFields([]string{"*"}))
+	assert.Equal(t, "name", got.GetName())
+	assert.Equal(t, tmpl.Filter, got.GetFilter())
+
+	// As for managed groups, the filter is only output with its field.
+	got = templateToProto(tmpl, (&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.NameField, globals.AttributesField}))
+	assert.Equal(t, "name", got.GetName())
+	assert.Empty(t, got.GetFilter())
+}
+
+func TestToProto_authMethodType(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	oidcMg := oidc.AllocManagedGroup()
+	oidcMg.AuthMethodId = "amoidc_1234567890"
+	ldapMg := ldap.AllocManagedGroup()
+	ldapMg.AuthMethodId = "amldap_1234567890"
+	ldapMg.GroupNames = `["admin"]`
+
+	got, err := toProto(ctx, oidcMg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, oidc.Subtype.String(), got.GetAuthMethodType())
+	got, err = toProto(ctx, ldapMg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Equal(t, ldap.Subtype.String(), got.GetAuthMethodType())
+
+	// The field is only populated when it is an output field
+	got, err = toProto(ctx, ldapMg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.AuthMethodIdField})))
+	require.NoError(t, err)
+	assert.Empty(t, got.GetAuthMethodType())
+	assert.Equal(t, "amldap_1234567890", got.GetAuthMethodId())
+}
+
+func TestToProto_authMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	am := oidc.AllocAuthMethod()
+	am.PublicId = "amoidc_1234567890"
+	am.Name = "corp sso"
+	am.ClientId = "client-id"
+	am.ClientSecret = "client-secret"
+	mg := oidc.AllocManagedGroup()
+	mg.AuthMethodId = am.PublicId
+	mg.Filter = `"/token/sub" == "alice"`
+
+	info := toAuthMethodInfo(&am)
+	assert.Empty(t, cmp.Diff(&pbauthmethods.AuthMethodInfo{Id: am.PublicId, Name: "corp sso", Type: oidc.Subtype.String()}, info, protocmp.Transform()))
+
+	got, err := toProto(ctx, mg, testOutputFields(t), handlers.WithAuthMethod(info))
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(info, got.GetAuthMethod(), protocmp.Transform()))
+
+	// The summary is only included when it is an output field
+	got, err = toProto(ctx, mg, handlers.WithOutputFields((&perms.OutputFields{}).AddFields([]string{globals.AuthMethodIdField})), handlers.WithAuthMethod(info))
+	require.NoError(t, err)
+	assert.Nil(t, got.GetAuthMethod())
+
+	// and it isn't listed as populated unless it was requested.
+	got, err = toProto(ctx, mg, testOutputFields(t), handlers.WithPopulatedFields(true))
+	require.NoError(t, err)
+	assert.NotContains(t, got.GetPopulatedFields(), globals.AuthMethodField)
+}
+
+func TestRestrictOutputFields(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Name = "admins"
+	mg.Description = "desc"
+	mg.Filter = `"/token/sub" == "alice"`
+	authorized := (&perms.OutputFields{}).AddFields([]string{globals.IdField, globals.NameField, globals.AttributesField, attrFilterField})
+
+	// Without requested fields every authorized field is kept.
+	assert.Same(t, authorized, restrictOutputFields(authorized, nil))
+	assert.Same(t, authorized, restrictOutputFields(authorized, []string{globals.NameField, "*"}))
+
+	// Requested fields which aren't authorized are silently dropped.
+	restricted := restrictOutputFields(authorized, []string{globals.NameField, globals.AttributesField, globals.DescriptionField})
+	fields, _ := restricted.Fields()
+	assert.Equal(t, []string{globals.AttributesField, attrFilterField, globals.NameField}, fields)
+
+	item, err := toProto(ctx, mg, handlers.WithOutputFields(restricted))
+	require.NoError(t, err)
+	assert.Empty(t, item.GetId())
+	assert.Nil(t, item.GetDescription())
+	assert.Equal(t, "admins", item.GetName().GetValue())
+	assert.Equal(t, mg.Filter, item.GetAttributes().AsMap()["filter"])
+
+	// Requesting only fields which aren't authorized returns none.
+	item, err = toProto(ctx, mg, handlers.WithOutputFields(restrictOutputFields(authorized, []string{globals.DescriptionField})))
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pb.ManagedGroup{}, item, protocmp.Transform()))
+}
+
+func TestPreviewUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Name = "admins"
+	mg.Description = "desc"
+	mg.Filter = `"/token/sub" == "alice"`
+	mg.Version = 2
+	mg.FilterVersion = 1
+
+	req := &pbs.UpdateManagedGroupRequest{
+		Id: mg.PublicId,
+		Item: &pb.ManagedGroup{
+			Version: 2,
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: `"/token/sub" == "bob"`,
+				},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.DescriptionField, attrFilterField}},
+		Preview:    true,
+	}
+	got, err := previewUpdate(ctx, mg, req)
+	require.NoError(t, err)
+	after := got.(*oidc.ManagedGroup)
+	assert.Equal(t, "admins", after.Name)
+	assert.Empty(t, after.Description)
+	assert.Equal(t, `"/token/sub" == "bob"`, after.Filter)
+	assert.Equal(t, uint32(3), after.Version)
+	assert.Equal(t, uint32(2), after.FilterVersion)
+	assert.Equal(t, oidc.FilterSyntaxVersion, after.FilterSyntaxVersion)
+	assert.False(t, after.FilterHasWarnings)
+	assert.Equal(t, uint32(1), after.FilterComplexity)
+	// The managed group itself is left untouched.
+	assert.Equal(t, "desc", mg.Description)
+	assert.Equal(t, `"/token/sub" == "alice"`, mg.Filter)
+	assert.Equal(t, uint32(1), mg.FilterVersion)
+	assert.Empty(t, mg.FilterSyntaxVersion)
+
+	// Only a change of the filter increments its version.
+	unchanged := proto.Clone(req).(*pbs.UpdateManagedGroupRequest)
+	unchanged.Item.GetOidcManagedGroupAttributes().Filter = mg.Filter
+	got2, err := previewUpdate(ctx, mg, unchanged)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got2.(*oidc.ManagedGroup).FilterVersion)
+
+	// Whether the filter being written has warnings is previewed too.
+	linted := proto.Clone(req).(*pbs.UpdateManagedGroupRequest)
+	linted.Item.GetOidcManagedGroupAttributes().Filter = `"/token/groups" == "admin"`
+	gotLinted, err := previewUpdate(ctx, mg, linted)
+	require.NoError(t, err)
+	assert.True(t, gotLinted.(*oidc.ManagedGroup).FilterHasWarnings)
+
+	// As is the complexity of the filter being written.
+	complexReq := proto.Clone(req).(*pbs.UpdateManagedGroupRequest)
+	complexReq.Item.GetOidcManagedGroupAttributes().Filter = `"/token/sub" == "bob" or not "/token/sub" == "eve"`
+	gotComplex, err := previewUpdate(ctx, mg, complexReq)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), gotComplex.(*oidc.ManagedGroup).FilterComplexity)
+
+	before, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	afterItem, err := toProto(ctx, got, testOutputFields(t))
+	require.NoError(t, err)
+	changes, err := fieldChanges(before, afterItem, (&perms.OutputFields{}).AddFields([]string{"*"}), req.GetUpdateMask().GetPaths())
+	require.NoError(t, err)
+	want := []*pbs.ManagedGroupFieldChange{
+		{Field: globals.DescriptionField, Before: structpb.NewStringValue("desc"), After: structpb.NewNullValue()},
+		{Field: attrFilterField, Before: structpb.NewStringValue(`"/token/sub" == "alice"`), After: structpb.NewStringValue(`"/token/sub" == "bob"`)},
+	}
+	assert.Empty(t, cmp.Diff(want, changes, protocmp.Transform()))
+
+	// Fields the caller can't see aren't reported.
+	changes, err = fieldChanges(before, afterItem, (&perms.OutputFields{}).AddFields([]string{globals.DescriptionField}), req.GetUpdateMask().GetPaths())
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(want[:1], changes, protocmp.Transform()))
+
+	// Tags aren't held by the managed group, so updating only them only
+	// increments the version.
+	tagsOnly := &pbs.UpdateManagedGroupRequest{
+		Id:         mg.PublicId,
+		Item:       &pb.ManagedGroup{Version: 2, Tags: map[string]string{"team": "eng"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.TagsField}},
+		Preview:    true,
+	}
+	got3, err := previewUpdate(ctx, mg, tagsOnly)
+	require.NoError(t, err)
+	assert.Equal(t, "admins", got3.GetName())
+	assert.Equal(t, `"/token/sub" == "alice"`, got3.(*oidc.ManagedGroup).Filter)
+	assert.Equal(t, uint32(3), got3.GetVersion())
+
+	// Without tags an empty mask is still rejected.
+	tagsOnly.UpdateMask.Paths = nil
+	_, err = previewUpdate(ctx, mg, tagsOnly)
+	require.Error(t, err)
+
+	// A stale version fails the preview as it would fail the update.
+	req.Item.Version = 1
+	_, err = previewUpdate(ctx, mg, req)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+}
+
+func TestChangedFieldsProto(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.AuthMethodId = "amoidc_1234567890"
+	mg.Name = "admins"
+	mg.Description = "desc"
+	mg.Filter = `"/token/sub" == "alice"`
+	mg.Version = 2
+
+	req := &pbs.UpdateManagedGroupRequest{
+		Id: mg.PublicId,
+		Item: &pb.ManagedGroup{
+			Version:     2,
+			Name:        wrapperspb.String("operators"),
+			Description: wrapperspb.String("desc"),
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter: `"/token/sub" == "bob"`,
+				},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.NameField, globals.DescriptionField, attrFilterField, attrDisabledField}},
+	}
+	updated, err := previewUpdate(ctx, mg, req)
+	require.NoError(t, err)
+
+	outputFields := (&perms.OutputFields{}).AddFields([]string{"*"})
+	opts := []handlers.Option{handlers.WithOutputFields(outputFields)}
+	before, err := toProto(ctx, mg, opts...)
+	require.NoError(t, err)
+	after, err := toProto(ctx, updated, opts...)
+	require.NoError(t, err)
+	changes, err := fieldChanges(before, after, outputFields, req.GetUpdateMask().GetPaths())
+	require.NoError(t, err)
+
+	// The unchanged description and disabled attribute are left out, as
+	// are the fields which weren't masked.
+	item, err := changedFieldsProto(ctx, updated, changes, outputFields, opts)
+	require.NoError(t, err)
+	want := &pb.ManagedGroup{
+		Id:      mg.PublicId,
+		Version: 3,
+		Name:    wrapperspb.String("operators"),
+		Attrs: &pb.ManagedGroup_Attributes{Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"filter": structpb.NewStringValue(`"/token/sub" == "bob"`),
+		}}},
+	}
+	assert.Empty(t, cmp.Diff(want, item, protocmp.Transform()))
+
+	// Only the id and version are returned when nothing changed.
+	item, err = changedFieldsProto(ctx, mg, nil, outputFields, opts)
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&pb.ManagedGroup{Id: mg.PublicId, Version: 2}, item, protocmp.Transform()))
+
+	// Nested attributes are kept at their path.
+	mg.MatchCaseInsensitive = true
+	matchChanges := []*pbs.ManagedGroupFieldChange{{
+		Field:  attrMatchOptionsField,
+		Before: structpb.NewNullValue(),
+		After:  structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"case_insensitive": structpb.NewBoolValue(true)}}),
+	}}
+	item, err = changedFieldsProto(ctx, mg, matchChanges, outputFields, opts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"match_options": map[string]any{"case_insensitive": true}}, item.GetAttributes().AsMap())
+}
+func TestMatchOptions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId = "mgoidc_1234567890"
+	mg.Filter = `"/token/sub" == token["Sub"]`
+	mg.Version = 1
+
+	// Absent options are the default, and aren't output.
+	item, err := toProto(ctx, mg, testOutputFields(t))
+	require.NoError(t, err)
+	assert.Nil(t, item.GetOidcManagedGroupAttributes().GetMatchOptions())
+
+	req := &pbs.UpdateManagedGroupRequest{
+		Id: mg.PublicId,
+		Item: &pb.ManagedGroup{
+			Version: 1,
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+					Filter:       `"Admins" in "/token/groups"`,
+					MatchOptions: &pb.OidcManagedGroupMatchOptions{CaseInsensitive: true},
+				},
+			},
+		},
+		// The path of the options updates each of them.
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrMatchOptionsField}},
+	}
+	// The stored filter is matched case-insensitively when only the options
+	// are updated.
+	require.NoError(t, validateUpdatedMatchOptions(mg, req))
+
+	req.UpdateMask.Paths = append(req.UpdateMask.Paths, attrFilterField)
+	require.NoError(t, validateUpdatedMatchOptions(mg, req))
+	upd, dbMask, err := oidcUpdate(ctx, mg.PublicId, req.GetUpdateMask().GetPaths(), req.GetItem())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"MatchCaseInsensitive", "Filter"}, dbMask)
+	assert.True(t, upd.MatchCaseInsensitive)
+
+	got, err := previewUpdate(ctx, mg, req)
+	require.NoError(t, err)
+	item, err = toProto(ctx, got, testOutputFields(t))
+	require.NoError(t, err)
+	assert.True(t, item.GetOidcManagedGroupAttributes().GetMatchOptions().GetCaseInsensitive())
+}
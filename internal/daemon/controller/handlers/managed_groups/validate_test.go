@@ -5,19 +5,39 @@ package managed_groups
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"net"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func fieldError(field, details string) string {
@@ -26,10 +46,22 @@ func fieldError(field, details string) string {
 
 func TestValidateCreateRequest(t *testing.T) {
 	t.Parallel()
+	oidcItem := &pb.ManagedGroup{
+		Type:         oidc.Subtype.String(),
+		AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+		Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+			OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+				Filter: oidc.TestFakeManagedGroupFilter,
+			},
+		},
+	}
 	cases := []struct {
-		name        string
-		item        *pb.ManagedGroup
-		errContains string
+		name           string
+		item           *pb.ManagedGroup
+		initialMembers []string
+		roleIds        []string
+		scopeId        string
+		errContains    string
 	}{
 		{
 			name: "unrecognized authmethod prefix",
@@ -38,6 +70,163 @@ func TestValidateCreateRequest(t *testing.T) {
 			},
 			errContains: fieldError(globals.AuthMethodIdField, "Unknown auth method type from ID."),
 		},
+		{
+			name: "invalid tags",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.Tags = map[string]string{"": "eng"}
+				return item
+			}(),
+			errContains: fieldError(globals.TagsField, "Tag keys cannot be empty."),
+		},
+		{
+			name: "synced kind",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.Kind = "synced"
+				return item
+			}(),
+		},
+		{
+			name: "unknown kind",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.Kind = "scim"
+				return item
+			}(),
+			errContains: fieldError(globals.KindField, `Unsupported kind, must be "login" or "synced".`),
+		},
+		{
+			name: "user owner",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String(globals.UserPrefix + "_1234567890")
+				return item
+			}(),
+		},
+		{
+			name: "principal owner",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String("team:platform-security")
+				return item
+			}(),
+		},
+		{
+			name: "malformed user owner",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String(globals.UserPrefix + "_not-an-id")
+				return item
+			}(),
+			errContains: fieldError(globals.OwnerIdField, "Improperly formatted user id."),
+		},
+		{
+			name: "empty owner",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String("")
+				return item
+			}(),
+			errContains: fieldError(globals.OwnerIdField, "Cannot be empty; leave it unset instead."),
+		},
+		{
+			name: "owner with surrounding whitespace",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String(" platform ")
+				return item
+			}(),
+			errContains: fieldError(globals.OwnerIdField, "Cannot have leading or trailing whitespace."),
+		},
+		{
+			name: "long owner",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.OwnerId = wrapperspb.String(strings.Repeat("a", 257))
+				return item
+			}(),
+			errContains: fieldError(globals.OwnerIdField, "Cannot be longer than 256 characters."),
+		},
+		{
+			name: "filter syntax version",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.GetOidcManagedGroupAttributes().FilterSyntaxVersion = "v0.1.12"
+				return item
+			}(),
+			errContains: fieldError(attrFilterSyntaxVersionField, "This is a read only field."),
+		},
+		{
+			name: "filter has warnings",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.GetOidcManagedGroupAttributes().FilterHasWarnings = true
+				return item
+			}(),
+			errContains: fieldError(attrFilterHasWarningsField, "This is a read only field."),
+		},
+		{
+			name: "filter complexity",
+			item: func() *pb.ManagedGroup {
+				item := proto.Clone(oidcItem).(*pb.ManagedGroup)
+				item.GetOidcManagedGroupAttributes().FilterComplexity = 3
+				return item
+			}(),
+			errContains: fieldError(attrFilterComplexityField, "This is a read only field."),
+		},
+		{
+			name: "unrecognized authmethod prefix without type",
+			item: &pb.ManagedGroup{
+				AuthMethodId: "anything_1234567890",
+			},
+			errContains: fieldError(globals.TypeField, "This field is required when it can't be derived from the auth method id."),
+		},
+		{
+			name: "password authmethod without type",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.TypeField, "This field is required when it can't be derived from the auth method id."),
+		},
+		{
+			name: "password authmethod with password type",
+			item: &pb.ManagedGroup{
+				Type:         password.Subtype.String(),
+				AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.TypeField, `Unknown type, must be "ldap" or "oidc".`),
+		},
+		{
+			name: "unknown type for oidc authmethod",
+			item: &pb.ManagedGroup{
+				Type:         "unknown",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.TypeField, `Unknown type, must be "ldap" or "oidc".`),
+		},
+		{
+			name: "oidc without type",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: oidc.TestFakeManagedGroupFilter,
+					},
+				},
+			},
+		},
+		{
+			name: "ldap without type",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin"},
+					},
+				},
+			},
+		},
 		{
 			name: "mismatched oidc authmethod pw type",
 			item: &pb.ManagedGroup{
@@ -55,6 +244,44 @@ func TestValidateCreateRequest(t *testing.T) {
 			},
 			errContains: fieldError(globals.AttributesField, "Attribute fields is required."),
 		},
+		{
+			name: "ldap attributes for oidc",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin"},
+					},
+				},
+			},
+			errContains: fieldError(globals.AttributesField, "Attributes don't match the auth method's type."),
+		},
+		{
+			name: "oidc case insensitive",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter:       `"Admins" in "/token/groups"`,
+						MatchOptions: &pb.OidcManagedGroupMatchOptions{CaseInsensitive: true},
+					},
+				},
+			},
+		},
+		{
+			// bexpr compares a selector value as its text, which is folded
+			// like any other value.
+			name: "oidc filter with a selector value can be case insensitive",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter:       `"/token/sub" == token["Sub"]`,
+						MatchOptions: &pb.OidcManagedGroupMatchOptions{CaseInsensitive: true},
+					},
+				},
+			},
+		},
 		{
 			name: "bad oidc attributes",
 			item: &pb.ManagedGroup{
@@ -68,6 +295,20 @@ func TestValidateCreateRequest(t *testing.T) {
 			},
 			errContains: "Error evaluating submitted filter",
 		},
+		{
+			name: "oidc frozen",
+			item: &pb.ManagedGroup{
+				Type:         oidc.Subtype.String(),
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: `"/foo/bar" == "zipzap"`,
+						Frozen: true,
+					},
+				},
+			},
+			errContains: fieldError(attrFrozenField, "Cannot specify this field in a create request."),
+		},
 		{
 			name: "no oidc errors",
 			item: &pb.ManagedGroup{
@@ -122,13 +363,77 @@ func TestValidateCreateRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:           "initial members",
+			item:           oidcItem,
+			initialMembers: []string{globals.OidcAccountPrefix + "_1234567890", globals.OidcAccountPrefix + "_0987654321"},
+		},
+		{
+			name:           "initial members of ldap managed group",
+			initialMembers: []string{globals.LdapAccountPrefix + "_1234567890"},
+			item: &pb.ManagedGroup{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin"},
+					},
+				},
+			},
+			errContains: fieldError("initial_members", "Only supported for OIDC managed groups."),
+		},
+		{
+			name:           "invalid initial member",
+			item:           oidcItem,
+			initialMembers: []string{globals.LdapAccountPrefix + "_1234567890"},
+			errContains:    fieldError("initial_members", `Invalid formatted identifier "acctldap_1234567890".`),
+		},
+		{
+			name:           "repeated initial member",
+			item:           oidcItem,
+			initialMembers: []string{globals.OidcAccountPrefix + "_1234567890", globals.OidcAccountPrefix + "_1234567890"},
+			errContains:    fieldError("initial_members", `Account id "acctoidc_1234567890" is provided more than once.`),
+		},
+		{
+			name:    "role ids",
+			item:    oidcItem,
+			roleIds: []string{globals.RolePrefix + "_1234567890", globals.RolePrefix + "_0987654321"},
+		},
+		{
+			name:        "invalid role id",
+			item:        oidcItem,
+			roleIds:     []string{globals.UserPrefix + "_1234567890"},
+			errContains: fieldError(roleIdsField, `Invalid formatted role id "u_1234567890".`),
+		},
+		{
+			name:        "repeated role id",
+			item:        oidcItem,
+			roleIds:     []string{globals.RolePrefix + "_1234567890", globals.RolePrefix + "_1234567890"},
+			errContains: fieldError(roleIdsField, `Role id "r_1234567890" is provided more than once.`),
+		},
+		{
+			name:    "global scope id",
+			item:    oidcItem,
+			scopeId: scope.Global.String(),
+		},
+		{
+			name:    "org scope id",
+			item:    oidcItem,
+			scopeId: scope.Org.Prefix() + "_1234567890",
+		},
+		{
+			name:        "project scope id",
+			item:        oidcItem,
+			scopeId:     scope.Project.Prefix() + "_1234567890",
+			errContains: fieldError(globals.ScopeIdField, "This field must be 'global' or a valid org scope id."),
+		},
 	}
 	for _, tc := range cases {
 		tc := tc // capture range variable
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			req := &pbs.CreateManagedGroupRequest{Item: tc.item}
-			err := validateCreateRequest(context.Background(), req)
+			req := &pbs.CreateManagedGroupRequest{Item: tc.item, InitialMembers: tc.initialMembers, RoleIds: tc.roleIds, ScopeId: tc.scopeId}
+			err := validateCreateRequest(context.Background(), req, false)
 			if tc.errContains == "" {
 				require.NoError(t, err)
 				return
@@ -139,6 +444,89 @@ func TestValidateCreateRequest(t *testing.T) {
 	}
 }
 
+func TestValidateCreateRequest_rejectMatchAll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	req := func(filter string, allow bool) *pbs.CreateManagedGroupRequest {
+		return &pbs.CreateManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+				},
+			},
+			AllowMatchAll: allow,
+		}
+	}
+	const matchAll = `"/token/sub" != ""`
+
+	// By default filters matching everyone are allowed.
+	require.NoError(t, validateCreateRequest(ctx, req(matchAll, false), false))
+
+	err := validateCreateRequest(ctx, req(matchAll, false), true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(attrFilterField, "Appears to match every authenticated user. Set allow_match_all to write it anyway."))
+
+	require.NoError(t, validateCreateRequest(ctx, req(matchAll, true), true))
+	require.NoError(t, validateCreateRequest(ctx, req(`"/token/sub" == "alice"`, false), true))
+}
+
+func TestValidateUpsertRequest_rejectMatchAll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	req := func(filter string, allow bool) *pbs.UpsertManagedGroupRequest {
+		return &pbs.UpsertManagedGroupRequest{
+			Item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("everyone"),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+				},
+			},
+			AllowMatchAll: allow,
+		}
+	}
+	const matchAll = `"/token/sub" != ""`
+
+	require.NoError(t, validateUpsertRequest(ctx, req(matchAll, false), false))
+
+	err := validateUpsertRequest(ctx, req(matchAll, false), true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(attrFilterField, "Appears to match every authenticated user. Set allow_match_all to write it anyway."))
+
+	require.NoError(t, validateUpsertRequest(ctx, req(matchAll, true), true))
+}
+
+func TestValidateFilterUpdate_rejectMatchAll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	req := func(filter string, paths []string, allow bool) *pbs.UpdateManagedGroupRequest {
+		return &pbs.UpdateManagedGroupRequest{
+			Id: globals.OidcManagedGroupPrefix + "_1234567890",
+			Item: &pb.ManagedGroup{
+				Version: 1,
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+				},
+			},
+			UpdateMask:    &fieldmaskpb.FieldMask{Paths: paths},
+			AllowMatchAll: allow,
+		}
+	}
+	const matchAll = `"/token/sub" != ""`
+	filterMask := []string{attrFilterField}
+
+	require.NoError(t, validateFilterUpdate(ctx, nil, nil, req(matchAll, filterMask, false), false))
+
+	err := validateFilterUpdate(ctx, nil, nil, req(matchAll, filterMask, false), true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(attrFilterField, "Appears to match every authenticated user. Set allow_match_all to write it anyway."))
+
+	require.NoError(t, validateFilterUpdate(ctx, nil, nil, req(matchAll, filterMask, true), true))
+	// The filter isn't written unless it's in the mask.
+	require.NoError(t, validateFilterUpdate(ctx, nil, nil, req(matchAll, []string{globals.NameField}, false), true))
+}
+
 func TestValidateUpdateRequest(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
@@ -171,6 +559,74 @@ func TestValidateUpdateRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid tags",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.TagsField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Tags:    map[string]string{"team": strings.Repeat("v", maxTagValueLength+1)},
+				},
+			},
+			errContains: fieldError(globals.TagsField, `Value of tag "team" is longer than 256 characters.`),
+		},
+		{
+			name: "kind in mask",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.KindField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Kind:    "synced",
+				},
+			},
+			errContains: fieldError(globals.KindField, "Cannot modify the managed group kind."),
+		},
+		{
+			name: "owner in mask",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.OwnerIdField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					OwnerId: wrapperspb.String("platform-team"),
+				},
+			},
+		},
+		{
+			name: "owner cleared",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.OwnerIdField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+				},
+			},
+		},
+		{
+			name: "invalid owner in mask",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.OwnerIdField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					OwnerId: wrapperspb.String("owner\x00"),
+				},
+			},
+			errContains: fieldError(globals.OwnerIdField, "Cannot contain non-printable characters."),
+		},
+		{
+			name: "invalid tags not in mask",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Tags:    map[string]string{"": "eng"},
+				},
+			},
+		},
 		{
 			name: "ldap to password change type",
 			req: &pbs.UpdateManagedGroupRequest{
@@ -196,18 +652,1518 @@ func TestValidateUpdateRequest(t *testing.T) {
 				},
 			},
 		},
-	}
-	for _, tc := range cases {
-		tc := tc // capture range variable
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-			err := validateUpdateRequest(context.Background(), tc.req)
-			if tc.errContains == "" {
-				require.NoError(t, err)
-				return
-			}
-			assert.True(t, strings.Contains(err.Error(), tc.errContains),
-				"%q wasn't contained in %q", tc.errContains, err.Error())
+		{
+			name: "oidc name only with malformed attributes",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.NameField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Name:    wrapperspb.String("renamed"),
+					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+							Filter: "foobar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "oidc filter with ldap attributes",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrFilterField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+						LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+							GroupNames: []string{"admin"},
+						},
+					},
+				},
+			},
+			errContains: fieldError(globals.AttributesField, "Attributes don't match the auth method's type."),
+		},
+		{
+			name: "ldap group names without attributes",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrGroupNamesField}},
+				Item:       &pb.ManagedGroup{Version: 1},
+			},
+			errContains: fieldError(attrGroupNamesField, "Present in mask but no value provided."),
+		},
+		{
+			name: "ldap empty group names",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrGroupNamesField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+					Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+						LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{},
+					},
+				},
+			},
+			errContains: fieldError(attrGroupNamesField, "Field cannot be empty."),
+		},
+		{
+			name: "oidc refresh when previewing",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.OidcManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrFrozenField}},
+				Item:       &pb.ManagedGroup{Version: 1},
+				Preview:    true,
+				Refresh:    true,
+			},
+			errContains: fieldError("refresh", "Cannot be set when previewing an update."),
+		},
+		{
+			name: "ldap force",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.NameField}},
+				Item:       &pb.ManagedGroup{Version: 1, Name: wrapperspb.String("renamed")},
+				Force:      true,
+			},
+			errContains: fieldError("force", "Only supported for OIDC managed groups."),
+		},
+		{
+			name: "ldap refresh",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.NameField}},
+				Item:       &pb.ManagedGroup{Version: 1, Name: wrapperspb.String("renamed")},
+				Refresh:    true,
+			},
+			errContains: fieldError("refresh", "Only supported for OIDC managed groups."),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateUpdateRequest(context.Background(), tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateUpdateRequest_maskValues(t *testing.T) {
+	t.Parallel()
+	oidcId := globals.OidcManagedGroupPrefix + "_1234567890"
+	oidcAttrs := &pb.ManagedGroup_OidcManagedGroupAttributes{
+		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "alice"`},
+	}
+	cases := []struct {
+		name    string
+		paths   []string
+		item    *pb.ManagedGroup
+		missing []string
+	}{
+		{
+			// Unset name and description wrappers clear them.
+			name:  "name and description cleared",
+			paths: []string{globals.NameField, globals.DescriptionField},
+			item:  &pb.ManagedGroup{Version: 1},
+		},
+		{
+			name:  "name set and description cleared",
+			paths: []string{globals.NameField, globals.DescriptionField},
+			item:  &pb.ManagedGroup{Version: 1, Name: wrapperspb.String("admins")},
+		},
+		{
+			name:  "description set and name cleared",
+			paths: []string{globals.NameField, globals.DescriptionField},
+			item:  &pb.ManagedGroup{Version: 1, Description: wrapperspb.String("admins")},
+		},
+		{
+			name:  "tags cleared",
+			paths: []string{globals.TagsField},
+			item:  &pb.ManagedGroup{Version: 1},
+		},
+		{
+			// Attributes left unset in the supplied attributes are cleared.
+			name:  "attributes cleared",
+			paths: []string{attrDisabledField, attrFrozenField, attrMatchOptionsField},
+			item: &pb.ManagedGroup{Version: 1, Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{},
+			}},
+		},
+		{
+			name:    "filter without attributes",
+			paths:   []string{globals.NameField, attrFilterField},
+			item:    &pb.ManagedGroup{Version: 1, Name: wrapperspb.String("admins")},
+			missing: []string{attrFilterField},
+		},
+		{
+			name:    "attributes without attributes",
+			paths:   []string{attrDisabledField, attrFrozenField, attrMatchOptionsField, attrMatchCaseInsensitiveField},
+			item:    &pb.ManagedGroup{Version: 1},
+			missing: []string{attrDisabledField, attrFrozenField, attrMatchOptionsField, attrMatchCaseInsensitiveField},
+		},
+		{
+			name:  "filter with attributes",
+			paths: []string{globals.NameField, attrFilterField},
+			item:  &pb.ManagedGroup{Version: 1, Attrs: oidcAttrs},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateUpdateRequest(context.Background(), &pbs.UpdateManagedGroupRequest{
+				Id:         oidcId,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tc.paths},
+				Item:       tc.item,
+			})
+			if len(tc.missing) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, field := range tc.missing {
+				assert.Contains(t, err.Error(), fieldError(field, "Present in mask but no value provided."))
+			}
+			assert.NotContains(t, err.Error(), fieldError(globals.NameField, "Present in mask but no value provided."))
+		})
+	}
+}
+
+func TestValidateUpdateRequest_unknownMaskPaths(t *testing.T) {
+	t.Parallel()
+	oidcId := globals.OidcManagedGroupPrefix + "_1234567890"
+	ldapId := globals.LdapManagedGroupPrefix + "_1234567890"
+	oidcItem := &pb.ManagedGroup{Version: 1, Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "alice"`},
+	}}
+	ldapItem := &pb.ManagedGroup{Version: 1, Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+		LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{GroupNames: []string{"admin"}},
+	}}
+	cases := []struct {
+		name        string
+		id          string
+		paths       []string
+		item        *pb.ManagedGroup
+		errContains string
+	}{
+		{
+			name:  "oidc known paths",
+			id:    oidcId,
+			paths: []string{globals.NameField, globals.DescriptionField, globals.TagsField, attrFilterField, attrMatchOptionsField},
+			item:  oidcItem,
+		},
+		{
+			name:  "ldap known paths",
+			id:    ldapId,
+			paths: []string{"name, description", attrGroupNamesField},
+			item:  ldapItem,
+		},
+		{
+			name:        "typo",
+			id:          oidcId,
+			paths:       []string{"nmae"},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "nmae".`),
+		},
+		{
+			name:        "casing variant",
+			id:          oidcId,
+			paths:       []string{"Name"},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "Name" (did you mean "name"?).`),
+		},
+		{
+			name:        "casing variant of attribute",
+			id:          oidcId,
+			paths:       []string{"Attributes.Filter"},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "Attributes.Filter" (did you mean "attributes.filter"?).`),
+		},
+		{
+			name:        "typo in comma separated paths",
+			id:          oidcId,
+			paths:       []string{"name,descripton"},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "descripton".`),
+		},
+		{
+			name:        "several unknown paths",
+			id:          oidcId,
+			paths:       []string{"nmae", globals.DescriptionField, "DESCRIPTION"},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "nmae", "DESCRIPTION" (did you mean "description"?).`),
+		},
+		{
+			name:        "ldap attribute on oidc",
+			id:          oidcId,
+			paths:       []string{attrGroupNamesField},
+			item:        oidcItem,
+			errContains: fieldError("update_mask", `Unrecognized field "attributes.group_names".`),
+		},
+		{
+			name:        "oidc attribute on ldap",
+			id:          ldapId,
+			paths:       []string{attrMatchOptionsField},
+			item:        ldapItem,
+			errContains: fieldError("update_mask", `Unrecognized field "attributes.match_options".`),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateUpdateRequest(context.Background(), &pbs.UpdateManagedGroupRequest{
+				Id:         tc.id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tc.paths},
+				Item:       tc.item,
+			})
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestValidateFrozenFilterUpdate(t *testing.T) {
+	t.Parallel()
+	frozen := oidc.AllocManagedGroup()
+	frozen.PublicId = globals.OidcManagedGroupPrefix + "_1234567890"
+	frozen.Filter = `"/token/sub" == "alice"`
+	frozen.Frozen = true
+	thawed := oidc.AllocManagedGroup()
+	thawed.PublicId = frozen.PublicId
+	thawed.Filter = frozen.Filter
+
+	update := func(frozenAttr bool, paths ...string) *pbs.UpdateManagedGroupRequest {
+		return &pbs.UpdateManagedGroupRequest{
+			Id:         frozen.PublicId,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+			Item: &pb.ManagedGroup{
+				Version: 1,
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: `"/token/sub" == "bob"`,
+						Frozen: frozenAttr,
+					},
+				},
+			},
+		}
+	}
+	forced := update(false, attrFilterField)
+	forced.Force = true
+
+	cases := []struct {
+		name    string
+		grp     auth.ManagedGroup
+		req     *pbs.UpdateManagedGroupRequest
+		wantErr bool
+	}{
+		{name: "frozen filter", grp: frozen, req: update(false, attrFilterField), wantErr: true},
+		{name: "frozen filter forced", grp: frozen, req: forced},
+		{name: "frozen name", grp: frozen, req: update(false, globals.NameField)},
+		{name: "unfrozen with filter", grp: frozen, req: update(false, attrFilterField, attrFrozenField)},
+		{name: "frozen with filter", grp: thawed, req: update(true, attrFilterField, attrFrozenField), wantErr: true},
+		{name: "not frozen filter", grp: thawed, req: update(false, attrFilterField)},
+		{name: "ldap", grp: ldap.AllocManagedGroup(), req: update(false, attrFilterField)},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateFrozenFilterUpdate(tc.grp, tc.req)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+			assert.Contains(t, err.Error(), "frozen")
+		})
+	}
+}
+
+func TestVerifyUpdateScope(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	am := oidc.AllocAuthMethod()
+	am.PublicId, am.ScopeId = "amoidc_1234567890", "o_1234567890"
+	mg := oidc.AllocManagedGroup()
+	mg.PublicId, mg.AuthMethodId = "mgoidc_1234567890", am.PublicId
+
+	require.NoError(t, verifyUpdateScope(ctx, am.ScopeId, &am, mg, mg.PublicId))
+
+	otherMg := mg.Clone()
+	otherMg.AuthMethodId = "amoidc_0987654321"
+	for name, tc := range map[string]struct {
+		scopeId string
+		grp     *oidc.ManagedGroup
+		id      string
+	}{
+		"other-scope":       {scopeId: "o_0987654321", grp: mg, id: mg.PublicId},
+		"other-auth-method": {scopeId: am.ScopeId, grp: otherMg, id: mg.PublicId},
+		"other-group":       {scopeId: am.ScopeId, grp: mg, id: "mgoidc_0987654321"},
+	} {
+		err := verifyUpdateScope(ctx, tc.scopeId, &am, tc.grp, tc.id)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)), "%s: got %v", name, err)
+	}
+
+	// The repository is never asked to update a managed group of another
+	// scope.
+	s := Service{
+		oidcRepoFn: func() (*oidc.Repository, error) {
+			t.Error("the repository was used")
+			return nil, stderrors.New("unexpected")
+		},
+	}
+	_, err := s.updateInRepo(ctx, "o_0987654321", &am, mg, "u_1234567890", &pbs.UpdateManagedGroupRequest{
+		Id:         mg.PublicId,
+		Item:       &pb.ManagedGroup{Name: wrapperspb.String("name"), Version: 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.NameField}},
+	}, false)
+	require.Error(t, err)
+	var apiErr *handlers.ApiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, codes.PermissionDenied.String(), apiErr.Inner.GetKind())
+	assert.Equal(t, `Managed group "mgoidc_1234567890" is not in the scope the update was authorized in.`, apiErr.Inner.GetMessage())
+}
+
+func TestValidateUpsertRequest(t *testing.T) {
+	t.Parallel()
+	oidcAttrs := &pb.ManagedGroup_OidcManagedGroupAttributes{
+		OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+			Filter: `"/foo/bar" == "zipzap"`,
+		},
+	}
+	cases := []struct {
+		name        string
+		item        *pb.ManagedGroup
+		errContains string
+	}{
+		{
+			name: "missing name",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Attrs:        oidcAttrs,
+			},
+			errContains: fieldError(globals.NameField, "This field is required."),
+		},
+		{
+			name: "version provided",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Version:      1,
+				Attrs:        oidcAttrs,
+			},
+			errContains: fieldError(globals.VersionField, "Cannot specify this field in an upsert request."),
+		},
+		{
+			name: "tags provided",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Tags:         map[string]string{"team": "eng"},
+				Attrs:        oidcAttrs,
+			},
+			errContains: fieldError(globals.TagsField, "Cannot specify this field in an upsert request."),
+		},
+		{
+			name: "kind provided",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Kind:         "login",
+				Attrs:        oidcAttrs,
+			},
+			errContains: fieldError(globals.KindField, "Cannot specify this field in an upsert request."),
+		},
+		{
+			name: "owner provided",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				OwnerId:      wrapperspb.String("platform-team"),
+				Attrs:        oidcAttrs,
+			},
+			errContains: fieldError(globals.OwnerIdField, "Cannot specify this field in an upsert request."),
+		},
+		{
+			name: "bad oidc attributes",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: "foobar",
+					},
+				},
+			},
+			errContains: "Error evaluating submitted filter",
+		},
+		{
+			name: "no oidc errors",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Attrs:        oidcAttrs,
+			},
+		},
+		{
+			name: "no ldap errors",
+			item: &pb.ManagedGroup{
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+				Name:         wrapperspb.String("name"),
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin"},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := &pbs.UpsertManagedGroupRequest{Item: tc.item}
+			err := validateUpsertRequest(context.Background(), req, false)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidatePreviewMatchesRequest(t *testing.T) {
+	t.Parallel()
+	claims, err := structpb.NewStruct(map[string]any{"token": map[string]any{"sub": "alice"}})
+	require.NoError(t, err)
+	cases := []struct {
+		name        string
+		req         *pbs.PreviewManagedGroupMatchesRequest
+		errContains string
+	}{
+		{
+			name: "ldap auth method",
+			req: &pbs.PreviewManagedGroupMatchesRequest{
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+				Claims:       claims,
+			},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods are supported."),
+		},
+		{
+			name: "missing claims",
+			req: &pbs.PreviewManagedGroupMatchesRequest{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError("claims", "This field is required."),
+		},
+		{
+			name: "no errors",
+			req: &pbs.PreviewManagedGroupMatchesRequest{
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Claims:       claims,
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validatePreviewMatchesRequest(context.Background(), tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateMembershipReportRequest(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateMembershipReportRequest(context.Background(), &pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890"}))
+	require.NoError(t, validateMembershipReportRequest(context.Background(), &pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890"}))
+	for _, id := range []string{"", globals.OidcManagedGroupPrefix + "_1234567890", "bad_id"} {
+		err := validateMembershipReportRequest(context.Background(), &pbs.GenerateManagedGroupMembershipReportRequest{AuthMethodId: id})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."))
+	}
+}
+
+func TestValidateTouchRequest(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateTouchRequest(context.Background(), &pbs.TouchManagedGroupRequest{Id: globals.OidcManagedGroupPrefix + "_1234567890", Version: 1}))
+	require.NoError(t, validateTouchRequest(context.Background(), &pbs.TouchManagedGroupRequest{Id: globals.LdapManagedGroupPrefix + "_1234567890", Version: 1}))
+
+	err := validateTouchRequest(context.Background(), &pbs.TouchManagedGroupRequest{Id: "bad_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.IdField, "Incorrectly formatted identifier."))
+	assert.Contains(t, err.Error(), fieldError(globals.VersionField, "Existing resource version is required for a touch."))
+}
+
+func TestValidateGetRequest(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		req         *pbs.GetManagedGroupRequest
+		errContains string
+	}{
+		{
+			name: "by id",
+			req:  &pbs.GetManagedGroupRequest{Id: globals.OidcManagedGroupPrefix + "_1234567890"},
+		},
+		{
+			name: "by auth method and name",
+			req: &pbs.GetManagedGroupRequest{
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+				Name:         "name",
+			},
+		},
+		{
+			name:        "missing everything",
+			req:         &pbs.GetManagedGroupRequest{},
+			errContains: fieldError(globals.IdField, "Invalid formatted identifier."),
+		},
+		{
+			name: "id and name",
+			req: &pbs.GetManagedGroupRequest{
+				Id:   globals.OidcManagedGroupPrefix + "_1234567890",
+				Name: "name",
+			},
+			errContains: fieldError(globals.NameField, "Cannot be combined with id."),
+		},
+		{
+			name: "id and auth method",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.OidcManagedGroupPrefix + "_1234567890",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+		},
+		{
+			name: "id prefix",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.OidcManagedGroupPrefix + "_12",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+		},
+		{
+			name: "id prefix without id",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.OidcManagedGroupPrefix + "_",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.IdField, "Invalid formatted identifier. Must be mgoidc_ followed by at least the start of the id."),
+		},
+		{
+			name: "id prefix of ldap managed group",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.LdapManagedGroupPrefix + "_12",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.IdField, "Invalid formatted identifier. Must be mgoidc_ followed by at least the start of the id."),
+		},
+		{
+			name: "id prefix in ldap auth method",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.OidcManagedGroupPrefix + "_12",
+				AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods support looking up an id prefix."),
+		},
+		{
+			name: "id prefix and name",
+			req: &pbs.GetManagedGroupRequest{
+				Id:           globals.OidcManagedGroupPrefix + "_12",
+				AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+				Name:         "name",
+			},
+			errContains: fieldError(globals.NameField, "Cannot be combined with id."),
+		},
+		{
+			name:        "name without auth method",
+			req:         &pbs.GetManagedGroupRequest{Name: "name"},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."),
+		},
+		{
+			name:        "auth method without name",
+			req:         &pbs.GetManagedGroupRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890"},
+			errContains: fieldError(globals.NameField, "This field is required when looking up by auth method."),
+		},
+		{
+			name: "unsupported auth method",
+			req: &pbs.GetManagedGroupRequest{
+				AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890",
+				Name:         "name",
+			},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateGetRequest(context.Background(), tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateListRequest(t *testing.T) {
+	t.Parallel()
+	amId := globals.OidcAuthMethodPrefix + "_1234567890"
+	for _, sortBy := range []string{"", "id", "name", "created_time", "updated_time", "member_count"} {
+		require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, SortBy: sortBy}), sortBy)
+	}
+	err := validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, SortBy: "filter"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError("sort_by", `Unsupported sort field, must be one of "id", "name", "created_time", "updated_time" or "member_count".`))
+
+	for _, association := range []string{"", "any", "none"} {
+		require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, RoleAssociation: association}), association)
+	}
+	err = validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, RoleAssociation: "all"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError("role_association", `Unsupported role association, must be "any" or "none".`))
+
+	require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, Tags: []string{"team=eng", "env="}}))
+	err = validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, Tags: []string{"team"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.TagsField, `Tag "team" must be given as "key=value".`))
+
+	for _, kind := range []string{"", "login", "synced"} {
+		require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, Kind: kind}), kind)
+	}
+	err = validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, Kind: "scim"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.KindField, `Unsupported kind, must be "login" or "synced".`))
+
+	require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, OwnerId: "platform-team"}))
+	err = validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, OwnerId: globals.UserPrefix + "_not-an-id"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.OwnerIdField, "Improperly formatted user id."))
+
+	token := listPageToken{AuthMethodId: amId, SortBy: sortById, Subtype: "oidc", Id: globals.OidcManagedGroupPrefix + "_1234567890"}.encode()
+	require.NoError(t, validateListRequest(context.Background(), &pbs.ListManagedGroupsRequest{AuthMethodId: amId, PageToken: token}))
+	for name, req := range map[string]*pbs.ListManagedGroupsRequest{
+		"not-a-token":       {AuthMethodId: amId, PageToken: "not a token"},
+		"other-auth-method": {AuthMethodId: globals.OidcAuthMethodPrefix + "_0987654321", PageToken: token},
+	} {
+		err := validateListRequest(context.Background(), req)
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), fieldError(pageTokenField, "Invalid page token; list from the first page again."), name)
+	}
+}
+
+func TestTagsError(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		tags map[string]string
+		want string
+	}{
+		{name: "none"},
+		{name: "valid", tags: map[string]string{"team": "eng", "env": "", strings.Repeat("k", maxTagKeyLength): strings.Repeat("v", maxTagValueLength)}},
+		{name: "multibyte", tags: map[string]string{strings.Repeat("é", maxTagKeyLength): strings.Repeat("é", maxTagValueLength)}},
+		{name: "empty key", tags: map[string]string{"": "eng"}, want: "Tag keys cannot be empty."},
+		{name: "long key", tags: map[string]string{strings.Repeat("k", maxTagKeyLength+1): "eng"}, want: fmt.Sprintf("Tag key %q is longer than 128 characters.", strings.Repeat("k", maxTagKeyLength+1))},
+		{name: "key with equals", tags: map[string]string{"team=x": "eng"}, want: `Tag key "team=x" cannot contain "=".`},
+		{name: "long value", tags: map[string]string{"team": strings.Repeat("v", maxTagValueLength+1)}, want: `Value of tag "team" is longer than 256 characters.`},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tagsError(tc.tags))
+		})
+	}
+}
+
+func TestParseTagFilter(t *testing.T) {
+	t.Parallel()
+	got, msg := parseTagFilter(nil)
+	assert.Empty(t, msg)
+	assert.Nil(t, got)
+
+	got, msg = parseTagFilter([]string{"team=eng", "env=", "expr=a=b"})
+	assert.Empty(t, msg)
+	assert.Equal(t, map[string]string{"team": "eng", "env": "", "expr": "a=b"}, got)
+
+	_, msg = parseTagFilter([]string{"=eng"})
+	assert.Equal(t, `Tag "=eng" must be given as "key=value".`, msg)
+	_, msg = parseTagFilter([]string{"team=eng", "team=ops"})
+	assert.Equal(t, `Tag key "team" is given more than once.`, msg)
+}
+
+func TestValidateExportRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	require.NoError(t, validateExportRequest(ctx, &pbs.ExportManagedGroupsRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890"}))
+	require.NoError(t, validateExportRequest(ctx, &pbs.ExportManagedGroupsRequest{AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890"}))
+
+	err := validateExportRequest(ctx, &pbs.ExportManagedGroupsRequest{AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."))
+}
+
+func TestValidateFilterCapabilitiesRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	require.NoError(t, validateFilterCapabilitiesRequest(ctx, &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890"}))
+	require.NoError(t, validateFilterCapabilitiesRequest(ctx, &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890"}))
+
+	err := validateFilterCapabilitiesRequest(ctx, &pbs.GetManagedGroupFilterCapabilitiesRequest{AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."))
+}
+
+func TestValidateImportRequest(t *testing.T) {
+	t.Parallel()
+	oidcAmId := globals.OidcAuthMethodPrefix + "_1234567890"
+	cases := []struct {
+		name        string
+		req         *pbs.ImportManagedGroupsRequest
+		errContains string
+	}{
+		{
+			name: "valid",
+			req: &pbs.ImportManagedGroupsRequest{
+				AuthMethodId: oidcAmId,
+				Document:     &pbs.ManagedGroupsDocument{Version: exportDocumentVersion, Type: oidc.Subtype.String()},
+			},
+		},
+		{
+			name: "bad auth method",
+			req: &pbs.ImportManagedGroupsRequest{
+				AuthMethodId: globals.PasswordAuthMethodPrefix + "_1234567890",
+				Document:     &pbs.ManagedGroupsDocument{Version: exportDocumentVersion},
+			},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."),
+		},
+		{
+			name:        "missing document",
+			req:         &pbs.ImportManagedGroupsRequest{AuthMethodId: oidcAmId},
+			errContains: fieldError("document", "This field is required."),
+		},
+		{
+			name: "unsupported version",
+			req: &pbs.ImportManagedGroupsRequest{
+				AuthMethodId: oidcAmId,
+				Document:     &pbs.ManagedGroupsDocument{Version: exportDocumentVersion + 1, Type: oidc.Subtype.String()},
+			},
+			errContains: fieldError("document.version", "Unsupported document version, only version 1 is supported."),
+		},
+		{
+			name: "mismatched type",
+			req: &pbs.ImportManagedGroupsRequest{
+				AuthMethodId: oidcAmId,
+				Document:     &pbs.ManagedGroupsDocument{Version: exportDocumentVersion, Type: ldap.Subtype.String()},
+			},
+			errContains: fieldError("document.type", "Doesn't match the type of the auth method."),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateImportRequest(context.Background(), tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateAuthorizeRequest(t *testing.T) {
+	t.Parallel()
+	oidcMgId := globals.OidcManagedGroupPrefix + "_1234567890"
+	cases := []struct {
+		name        string
+		req         *pbs.AuthorizeManagedGroupRequest
+		errContains string
+	}{
+		{
+			name: "valid oidc",
+			req:  &pbs.AuthorizeManagedGroupRequest{Id: oidcMgId, Action: action.Update.String()},
+		},
+		{
+			name: "valid ldap",
+			req:  &pbs.AuthorizeManagedGroupRequest{Id: globals.LdapManagedGroupPrefix + "_1234567890", Action: action.Delete.String()},
+		},
+		{
+			name:        "bad id",
+			req:         &pbs.AuthorizeManagedGroupRequest{Id: globals.OidcAuthMethodPrefix + "_1234567890", Action: action.Read.String()},
+			errContains: fieldError(globals.IdField, "Invalid formatted identifier."),
+		},
+		{
+			name:        "missing action",
+			req:         &pbs.AuthorizeManagedGroupRequest{Id: oidcMgId},
+			errContains: fieldError("action", "This field is required."),
+		},
+		{
+			name:        "unknown action",
+			req:         &pbs.AuthorizeManagedGroupRequest{Id: oidcMgId, Action: "fly"},
+			errContains: fieldError("action", `Unknown managed group action "fly".`),
+		},
+		{
+			name:        "action not on managed groups",
+			req:         &pbs.AuthorizeManagedGroupRequest{Id: oidcMgId, Action: action.SetPassword.String()},
+			errContains: fieldError("action", `Unknown managed group action "set-password".`),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateAuthorizeRequest(context.Background(), tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateGetGrantsRequest(t *testing.T) {
+	t.Parallel()
+	for _, id := range []string{globals.OidcManagedGroupPrefix + "_1234567890", globals.LdapManagedGroupPrefix + "_1234567890"} {
+		require.NoError(t, validateGetGrantsRequest(context.Background(), &pbs.GetManagedGroupGrantsRequest{Id: id}), id)
+	}
+	err := validateGetGrantsRequest(context.Background(), &pbs.GetManagedGroupGrantsRequest{Id: globals.OidcAuthMethodPrefix + "_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.IdField, "Invalid formatted identifier."))
+}
+
+func TestValidateGetHistoryRequest(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateGetHistoryRequest(context.Background(),
+		&pbs.GetManagedGroupHistoryRequest{Id: globals.OidcManagedGroupPrefix + "_1234567890"}))
+
+	for _, id := range []string{"", globals.LdapManagedGroupPrefix + "_1234567890", globals.OidcAuthMethodPrefix + "_1234567890"} {
+		err := validateGetHistoryRequest(context.Background(), &pbs.GetManagedGroupHistoryRequest{Id: id})
+		require.Error(t, err, id)
+		assert.Contains(t, err.Error(), fieldError(globals.IdField, "Invalid formatted identifier. Only OIDC managed groups have history."), id)
+	}
+}
+
+func TestValidateDiffVersionsRequest(t *testing.T) {
+	t.Parallel()
+	id := globals.OidcManagedGroupPrefix + "_1234567890"
+	require.NoError(t, validateDiffVersionsRequest(context.Background(),
+		&pbs.DiffManagedGroupVersionsRequest{Id: id, FromVersion: 1, ToVersion: 2}))
+
+	cases := []struct {
+		name  string
+		req   *pbs.DiffManagedGroupVersionsRequest
+		field string
+		msg   string
+	}{
+		{
+			name:  "ldap id",
+			req:   &pbs.DiffManagedGroupVersionsRequest{Id: globals.LdapManagedGroupPrefix + "_1234567890", FromVersion: 1, ToVersion: 2},
+			field: globals.IdField,
+			msg:   "Invalid formatted identifier. Only OIDC managed groups have history.",
+		},
+		{
+			name:  "missing from version",
+			req:   &pbs.DiffManagedGroupVersionsRequest{Id: id, ToVersion: 2},
+			field: "from_version",
+			msg:   "This field is required.",
+		},
+		{
+			name:  "missing to version",
+			req:   &pbs.DiffManagedGroupVersionsRequest{Id: id, FromVersion: 1},
+			field: "to_version",
+			msg:   "This field is required.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDiffVersionsRequest(context.Background(), tc.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fieldError(tc.field, tc.msg))
+		})
+	}
+}
+
+func TestValidateCreateTemplateRequest(t *testing.T) {
+	t.Parallel()
+	valid := func() *pbs.ManagedGroupTemplate {
+		return &pbs.ManagedGroupTemplate{
+			ScopeId: "o_1234567890",
+			Name:    "admins",
+			Filter:  `"{{auth_method_id}}-admin" in "/token/groups"`,
+		}
+	}
+	require.NoError(t, validateCreateTemplateRequest(context.Background(), &pbs.CreateManagedGroupTemplateRequest{Item: valid()}))
+	global := valid()
+	global.ScopeId = "global"
+	require.NoError(t, validateCreateTemplateRequest(context.Background(), &pbs.CreateManagedGroupTemplateRequest{Item: global}))
+
+	cases := []struct {
+		name   string
+		modify func(*pbs.ManagedGroupTemplate)
+		field  string
+		msg    string
+	}{
+		{
+			name:   "project scope",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.ScopeId = "p_1234567890" },
+			field:  globals.ScopeIdField,
+			msg:    "This field must be 'global' or a valid org scope id.",
+		},
+		{
+			name:   "id set",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.Id = globals.OidcManagedGroupTemplatePrefix + "_1234567890" },
+			field:  globals.IdField,
+			msg:    "This is a read only field.",
+		},
+		{
+			name:   "version set",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.Version = 1 },
+			field:  globals.VersionField,
+			msg:    "This is a read only field.",
+		},
+		{
+			name:   "missing name",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.Name = "" },
+			field:  globals.NameField,
+			msg:    "This field is required.",
+		},
+		{
+			name:   "missing filter",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.Filter = "" },
+			field:  "filter",
+			msg:    "This field is required.",
+		},
+		{
+			name:   "invalid filter",
+			modify: func(t *pbs.ManagedGroupTemplate) { t.Filter = `"admin" in "/token/groups" and` },
+			field:  "filter",
+			msg:    "Error evaluating submitted filter expression",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := valid()
+			tc.modify(item)
+			err := validateCreateTemplateRequest(context.Background(), &pbs.CreateManagedGroupTemplateRequest{Item: item})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.field)
+			assert.Contains(t, err.Error(), tc.msg)
+		})
+	}
+}
+
+func TestValidateTemplateIdRequests(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tmplId := globals.OidcManagedGroupTemplatePrefix + "_1234567890"
+	amId := globals.OidcAuthMethodPrefix + "_1234567890"
+
+	require.NoError(t, validateListTemplatesRequest(ctx, &pbs.ListManagedGroupTemplatesRequest{ScopeId: "o_1234567890"}))
+	err := validateListTemplatesRequest(ctx, &pbs.ListManagedGroupTemplatesRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.ScopeIdField, "This field must be 'global' or a valid org scope id."))
+
+	require.NoError(t, validateDeleteTemplateRequest(ctx, &pbs.DeleteManagedGroupTemplateRequest{Id: tmplId}))
+	err = validateDeleteTemplateRequest(ctx, &pbs.DeleteManagedGroupTemplateRequest{Id: globals.OidcManagedGroupPrefix + "_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.IdField, "Invalid formatted identifier."))
+
+	require.NoError(t, validateCreateFromTemplateRequest(ctx, &pbs.CreateManagedGroupFromTemplateRequest{TemplateId: tmplId, AuthMethodId: amId}))
+	err = validateCreateFromTemplateRequest(ctx, &pbs.CreateManagedGroupFromTemplateRequest{
+		TemplateId:   amId,
+		AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError("template_id", "Invalid formatted identifier."))
+	assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods are supported."))
+}
+
+func TestValidateListByMemberRequest(t *testing.T) {
+	t.Parallel()
+	acctId := globals.LdapAccountPrefix + "_1234567890"
+	token := memberOfPageToken{AccountId: acctId, ManagedGroupId: globals.LdapManagedGroupPrefix + "_1234567890"}.encode()
+	for _, req := range []*pbs.ListManagedGroupsByMemberRequest{
+		{AccountId: globals.OidcAccountPrefix + "_1234567890"},
+		{AccountId: acctId, PageToken: token},
+	} {
+		require.NoError(t, validateListByMemberRequest(context.Background(), req), req.String())
+	}
+
+	for _, id := range []string{"", globals.OidcManagedGroupPrefix + "_1234567890", globals.OidcAuthMethodPrefix + "_1234567890"} {
+		err := validateListByMemberRequest(context.Background(), &pbs.ListManagedGroupsByMemberRequest{AccountId: id})
+		require.Error(t, err, id)
+		assert.Contains(t, err.Error(), fieldError(globals.AccountIdField, "Invalid formatted identifier."), id)
+	}
+
+	for name, req := range map[string]*pbs.ListManagedGroupsByMemberRequest{
+		"not-a-token":   {AccountId: acctId, PageToken: "not a token"},
+		"other-account": {AccountId: globals.LdapAccountPrefix + "_0987654321", PageToken: token},
+	} {
+		err := validateListByMemberRequest(context.Background(), req)
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), fieldError(pageTokenField, "Invalid page token; list from the first page again."), name)
+	}
+}
+
+func TestValidateListMembersRequest(t *testing.T) {
+	t.Parallel()
+	mgId := globals.LdapManagedGroupPrefix + "_1234567890"
+	token := memberPageToken{ManagedGroupId: mgId, AccountId: globals.LdapAccountPrefix + "_1234567890", CreatedTime: time.Now()}.encode()
+	for _, req := range []*pbs.ListManagedGroupMembersRequest{
+		{Id: globals.OidcManagedGroupPrefix + "_1234567890"},
+		{Id: mgId, PageSize: 10, PageToken: token},
+	} {
+		require.NoError(t, validateListMembersRequest(context.Background(), req), req.String())
+	}
+
+	for _, id := range []string{"", globals.OidcAccountPrefix + "_1234567890", globals.OidcAuthMethodPrefix + "_1234567890"} {
+		err := validateListMembersRequest(context.Background(), &pbs.ListManagedGroupMembersRequest{Id: id})
+		require.Error(t, err, id)
+		assert.Contains(t, err.Error(), fieldError(globals.IdField, "Invalid formatted identifier."), id)
+	}
+
+	for name, req := range map[string]*pbs.ListManagedGroupMembersRequest{
+		"not-a-token":         {Id: mgId, PageToken: "not a token"},
+		"other-managed-group": {Id: globals.LdapManagedGroupPrefix + "_0987654321", PageToken: token},
+	} {
+		err := validateListMembersRequest(context.Background(), req)
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), fieldError(pageTokenField, "Invalid page token; list from the first page again."), name)
+	}
+}
+
+func TestValidateRefreshRequest(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateRefreshRequest(context.Background(),
+		&pbs.RefreshAuthMethodManagedGroupsRequest{AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890"}))
+
+	for _, id := range []string{"", globals.LdapAuthMethodPrefix + "_1234567890", globals.OidcManagedGroupPrefix + "_1234567890"} {
+		err := validateRefreshRequest(context.Background(), &pbs.RefreshAuthMethodManagedGroupsRequest{AuthMethodId: id})
+		require.Error(t, err, id)
+		assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods are supported."), id)
+	}
+}
+
+func TestValidateReplaceInFiltersRequest(t *testing.T) {
+	t.Parallel()
+	amId := globals.OidcAuthMethodPrefix + "_1234567890"
+	require.NoError(t, validateReplaceInFiltersRequest(context.Background(),
+		&pbs.ReplaceInFiltersRequest{AuthMethodId: amId, OldPrefix: "/token/groups", NewPrefix: "/userinfo/groups"}))
+
+	err := validateReplaceInFiltersRequest(context.Background(),
+		&pbs.ReplaceInFiltersRequest{AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890", OldPrefix: "/token/groups", NewPrefix: "/userinfo/groups"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods are supported."))
+
+	err = validateReplaceInFiltersRequest(context.Background(),
+		&pbs.ReplaceInFiltersRequest{AuthMethodId: amId, OldPrefix: "token.groups", NewPrefix: ""})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError("old_prefix", `Must be a JSON pointer such as "/token/groups".`))
+	assert.Contains(t, err.Error(), fieldError("new_prefix", `Must be a JSON pointer such as "/token/groups".`))
+}
+
+func TestValidateValidateStoredFiltersRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	amId := globals.OidcAuthMethodPrefix + "_1234567890"
+	require.NoError(t, validateValidateStoredFiltersRequest(ctx, &pbs.ValidateStoredFiltersRequest{AuthMethodId: amId}))
+	require.NoError(t, validateValidateStoredFiltersRequest(ctx, &pbs.ValidateStoredFiltersRequest{ScopeId: "global"}))
+	require.NoError(t, validateValidateStoredFiltersRequest(ctx, &pbs.ValidateStoredFiltersRequest{ScopeId: "o_1234567890"}))
+	require.NoError(t, validateValidateStoredFiltersRequest(ctx, &pbs.ValidateStoredFiltersRequest{ScopeId: "global", AfterAuthMethodId: amId}))
+
+	cases := []struct {
+		name        string
+		req         *pbs.ValidateStoredFiltersRequest
+		errContains string
+	}{
+		{
+			name:        "neither",
+			req:         &pbs.ValidateStoredFiltersRequest{},
+			errContains: fieldError(globals.AuthMethodIdField, "An auth_method_id or a scope_id is required."),
+		},
+		{
+			name:        "both",
+			req:         &pbs.ValidateStoredFiltersRequest{AuthMethodId: amId, ScopeId: "global"},
+			errContains: fieldError(globals.ScopeIdField, "Cannot be combined with auth_method_id."),
+		},
+		{
+			name:        "ldap auth method",
+			req:         &pbs.ValidateStoredFiltersRequest{AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890"},
+			errContains: fieldError(globals.AuthMethodIdField, "Invalid formatted identifier. Only OIDC auth methods are supported."),
+		},
+		{
+			name:        "project scope",
+			req:         &pbs.ValidateStoredFiltersRequest{ScopeId: "p_1234567890"},
+			errContains: fieldError(globals.ScopeIdField, "This field must be 'global' or a valid org scope id."),
+		},
+		{
+			name:        "after without scope",
+			req:         &pbs.ValidateStoredFiltersRequest{AuthMethodId: amId, AfterAuthMethodId: amId},
+			errContains: fieldError(afterAuthMethodIdField, "Can only be provided with a scope_id."),
+		},
+		{
+			name:        "after ldap auth method",
+			req:         &pbs.ValidateStoredFiltersRequest{ScopeId: "global", AfterAuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890"},
+			errContains: fieldError(afterAuthMethodIdField, "Invalid formatted identifier."),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateValidateStoredFiltersRequest(ctx, tc.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestValidateCountRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	require.NoError(t, validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{ScopeId: "global", Recursive: true}))
+	require.NoError(t, validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{ScopeId: "o_1234567890"}))
+
+	err := validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.ScopeIdField, "This field is required."))
+
+	err = validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{ScopeId: "p_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.ScopeIdField, "This field must be 'global' or a valid org scope id."))
+
+	require.NoError(t, validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{ScopeId: "o_1234567890", AfterAuthMethodId: "amldap_1234567890"}))
+	err = validateCountRequest(ctx, &pbs.CountManagedGroupsRequest{ScopeId: "o_1234567890", AfterAuthMethodId: "ampw_1234567890"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(afterAuthMethodIdField, "Invalid formatted identifier."))
+}
+
+func TestValidateBatchGetRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	oidcId := globals.OidcManagedGroupPrefix + "_1234567890"
+	ldapId := globals.LdapManagedGroupPrefix + "_1234567890"
+	require.NoError(t, validateBatchGetRequest(ctx, &pbs.BatchGetManagedGroupsRequest{Ids: []string{oidcId, ldapId}}))
+
+	tooMany := make([]string, maxBatchGetIds+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%s_%010d", globals.OidcManagedGroupPrefix, i)
+	}
+	cases := []struct {
+		name string
+		ids  []string
+		msg  string
+	}{
+		{
+			name: "no ids",
+			msg:  "This field is required.",
+		},
+		{
+			name: "too many ids",
+			ids:  tooMany,
+			msg:  fmt.Sprintf("Must contain at most %d managed group ids.", maxBatchGetIds),
+		},
+		{
+			name: "bad id",
+			ids:  []string{oidcId, globals.OidcAuthMethodPrefix + "_1234567890"},
+			msg:  fmt.Sprintf("Invalid formatted identifier %q.", globals.OidcAuthMethodPrefix+"_1234567890"),
+		},
+		{
+			name: "duplicate id",
+			ids:  []string{oidcId, ldapId, oidcId},
+			msg:  fmt.Sprintf("Managed group id %q is provided more than once.", oidcId),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateBatchGetRequest(ctx, &pbs.BatchGetManagedGroupsRequest{Ids: tc.ids})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fieldError(globals.IdsField, tc.msg))
+		})
+	}
+}
+
+func TestValidateSetEnabledRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	oidcId := globals.OidcManagedGroupPrefix + "_1234567890"
+	ldapId := globals.LdapManagedGroupPrefix + "_1234567890"
+	require.NoError(t, validateSetEnabledRequest(ctx, &pbs.SetManagedGroupsEnabledRequest{
+		Items:   []*pbs.ManagedGroupVersion{{Id: oidcId, Version: 1}},
+		Enabled: true,
+	}))
+
+	tooMany := make([]*pbs.ManagedGroupVersion, maxSetEnabledItems+1)
+	for i := range tooMany {
+		tooMany[i] = &pbs.ManagedGroupVersion{Id: fmt.Sprintf("%s_%010d", globals.OidcManagedGroupPrefix, i), Version: 1}
+	}
+	cases := []struct {
+		name  string
+		items []*pbs.ManagedGroupVersion
+		msg   string
+	}{
+		{
+			name: "no items",
+			msg:  "This field is required.",
+		},
+		{
+			name:  "too many items",
+			items: tooMany,
+			msg:   fmt.Sprintf("Must contain at most %d managed groups.", maxSetEnabledItems),
+		},
+		{
+			name:  "ldap managed group",
+			items: []*pbs.ManagedGroupVersion{{Id: oidcId, Version: 1}, {Id: ldapId, Version: 1}},
+			msg:   fmt.Sprintf("Invalid formatted identifier %q. Only OIDC managed groups can be enabled or disabled.", ldapId),
+		},
+		{
+			name:  "duplicate id",
+			items: []*pbs.ManagedGroupVersion{{Id: oidcId, Version: 1}, {Id: oidcId, Version: 2}},
+			msg:   fmt.Sprintf("Managed group id %q is provided more than once.", oidcId),
+		},
+		{
+			name:  "no version",
+			items: []*pbs.ManagedGroupVersion{{Id: oidcId}},
+			msg:   fmt.Sprintf("The version of managed group %q is required.", oidcId),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateSetEnabledRequest(ctx, &pbs.SetManagedGroupsEnabledRequest{Items: tc.items})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fieldError(itemsField, tc.msg))
+		})
+	}
+}
+
+func TestValidateAddToRolesRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mgId := globals.OidcManagedGroupPrefix + "_1234567890"
+	roleId := globals.RolePrefix + "_1234567890"
+	require.NoError(t, validateAddToRolesRequest(ctx, &pbs.AddManagedGroupToRolesRequest{Id: mgId, RoleIds: []string{roleId}}))
+
+	tooMany := make([]string, maxAddToRoles+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%s_%010d", globals.RolePrefix, i)
+	}
+	cases := []struct {
+		name  string
+		req   *pbs.AddManagedGroupToRolesRequest
+		field string
+		msg   string
+	}{
+		{
+			name:  "bad id",
+			req:   &pbs.AddManagedGroupToRolesRequest{Id: globals.OidcAuthMethodPrefix + "_1234567890", RoleIds: []string{roleId}},
+			field: globals.IdField,
+			msg:   "Invalid formatted identifier.",
+		},
+		{
+			name:  "no roles",
+			req:   &pbs.AddManagedGroupToRolesRequest{Id: mgId},
+			field: "role_ids",
+			msg:   "Must contain at least one role id.",
+		},
+		{
+			name:  "too many roles",
+			req:   &pbs.AddManagedGroupToRolesRequest{Id: mgId, RoleIds: tooMany},
+			field: "role_ids",
+			msg:   fmt.Sprintf("Must contain at most %d role ids.", maxAddToRoles),
+		},
+		{
+			name:  "bad role id",
+			req:   &pbs.AddManagedGroupToRolesRequest{Id: mgId, RoleIds: []string{roleId, "g_1234567890"}},
+			field: "role_ids",
+			msg:   `Invalid formatted role id "g_1234567890".`,
+		},
+		{
+			name:  "duplicate role id",
+			req:   &pbs.AddManagedGroupToRolesRequest{Id: mgId, RoleIds: []string{roleId, roleId}},
+			field: "role_ids",
+			msg:   fmt.Sprintf("Role id %q is provided more than once.", roleId),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateAddToRolesRequest(ctx, tc.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fieldError(tc.field, tc.msg))
+		})
+	}
+}
+
+func TestFilterWarnings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	oidcItem := func(filter string) *pb.ManagedGroup {
+		return &pb.ManagedGroup{
+			AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+			Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+				OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: filter},
+			},
+		}
+	}
+	assert.Empty(t, filterWarnings(ctx, oidcItem(`"/token/sub" == "alice"`)))
+	assert.Len(t, filterWarnings(ctx, oidcItem(`"/token/groups" == "admin"`)), 1)
+	assert.Empty(t, filterWarnings(ctx, &pb.ManagedGroup{
+		AuthMethodId: globals.LdapAuthMethodPrefix + "_1234567890",
+		Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+			LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{GroupNames: []string{"admin"}},
+		},
+	}))
+}
+
+func TestValidateStreamRequest(t *testing.T) {
+	t.Parallel()
+	amId := globals.OidcAuthMethodPrefix + "_1234567890"
+	for _, association := range []string{"", "any", "none"} {
+		require.NoError(t, validateStreamRequest(context.Background(), &pbs.StreamManagedGroupsRequest{AuthMethodId: amId, RoleAssociation: association}), association)
+	}
+	err := validateStreamRequest(context.Background(), &pbs.StreamManagedGroupsRequest{AuthMethodId: amId, RoleAssociation: "all"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError("role_association", `Unsupported role association, must be "any" or "none".`))
+
+	err = validateStreamRequest(context.Background(), &pbs.StreamManagedGroupsRequest{AuthMethodId: "bad", Filter: `"foo`})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fieldError(globals.AuthMethodIdField, "Invalid formatted identifier."))
+	assert.Contains(t, err.Error(), globals.FilterField)
+}
+
+func TestValidationErrorStatus(t *testing.T) {
+	ctx := context.Background()
+	factoryErr := stderrors.New("no repository for you")
+	s, err := NewService(ctx,
+		func() (*oidc.Repository, error) { return nil, factoryErr },
+		func() (*ldap.Repository, error) { return nil, factoryErr },
+		func() (*iam.Repository, error) { return nil, factoryErr },
+	)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pbs.RegisterManagedGroupServiceServer(srv, s)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := pbs.NewManagedGroupServiceClient(conn)
+
+	cases := []struct {
+		name string
+		call func() error
+		want []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name: "create",
+			call: func() error {
+				_, err := client.CreateManagedGroup(ctx, &pbs.CreateManagedGroupRequest{Item: &pb.ManagedGroup{
+					AuthMethodId: globals.OidcAuthMethodPrefix + "_1234567890",
+					Id:           "mgoidc_1234567890",
+					Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+						OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{Filter: `"/token/sub" == "alice"`},
+					},
+				}})
+				return err
+			},
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: globals.IdField, Description: "This is a read only field."},
+			},
+		},
+		{
+			name: "update",
+			call: func() error {
+				_, err := client.UpdateManagedGroup(ctx, &pbs.UpdateManagedGroupRequest{Id: "mgoidc_1234567890"})
+				return err
+			},
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: "update_mask", Description: "UpdateMask not provided but is required to update this resource."},
+				{Field: globals.VersionField, Description: "Existing resource version is required for an update."},
+			},
+		},
+		{
+			name: "list",
+			call: func() error {
+				_, err := client.ListManagedGroups(ctx, &pbs.ListManagedGroupsRequest{AuthMethodId: "bad", Filter: `"foo`})
+				return err
+			},
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: globals.AuthMethodIdField, Description: "Invalid formatted identifier."},
+				{Field: globals.FilterField},
+			},
+		},
+		{
+			name: "stream",
+			call: func() error {
+				stream, err := client.StreamManagedGroups(ctx, &pbs.StreamManagedGroupsRequest{AuthMethodId: "bad", Filter: `"foo`})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: globals.AuthMethodIdField, Description: "Invalid formatted identifier."},
+				{Field: globals.FilterField},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			st, ok := status.FromError(tc.call())
+			require.True(ok)
+			assert.Equal(codes.InvalidArgument, st.Code())
+			assert.Contains(st.Message(), "Error in provided request.")
+			require.Len(st.Details(), 1)
+			br, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(ok, "got details %v", st.Details())
+			got := br.GetFieldViolations()
+			require.Len(got, len(tc.want))
+			for i, want := range tc.want {
+				if want.GetDescription() == "" {
+					// The description comes from the underlying parser.
+					want.Description = got[i].GetDescription()
+				}
+			}
+			assert.Empty(cmp.Diff(tc.want, got, protocmp.Transform()))
 		})
 	}
 }
@@ -113,10 +113,14 @@ func (m MaskManager) Translate(paths []string, passedThroughPrefix ...string) []
 	return result
 }
 
+// MaskContains reports whether the paths contain s. Like Translate, each path
+// may hold several comma separated paths.
 func MaskContains(paths []string, s string) bool {
 	for _, p := range paths {
-		if p == s {
-			return true
+		for _, v := range strings.Split(p, ",") {
+			if strings.TrimSpace(v) == s {
+				return true
+			}
 		}
 	}
 	return false
@@ -39,6 +39,16 @@ func TestMaskManager_Split(t *testing.T) {
 	assert.Equal(t, []string{"other_second_field", "other_field_3"}, mm.Translate([]string{"strangly_formatted_field", "field3"}))
 }
 
+func TestMaskContains(t *testing.T) {
+	assert.True(t, MaskContains([]string{"name", "description"}, "description"))
+	assert.False(t, MaskContains([]string{"name", "description"}, "attributes"))
+	assert.False(t, MaskContains(nil, "name"))
+	// Paths may be comma separated, as they are in the update_mask query parameter.
+	assert.True(t, MaskContains([]string{"name,description"}, "description"))
+	assert.True(t, MaskContains([]string{"name, attributes.filter"}, "attributes.filter"))
+	assert.False(t, MaskContains([]string{"name,description"}, "name,description"))
+}
+
 func TestMaskManager_errors(t *testing.T) {
 	ctx := context.Background()
 	_, err := NewMaskManager(ctx, MaskDestination{&pb.TestBase{}}, MaskSource{&pb.TestManyToOneMappings{}})
@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -31,11 +32,17 @@ type options struct {
 	WithOutputFields                *perms.OutputFields
 	WithScope                       *scopes.ScopeInfo
 	WithPlugin                      *plugins.PluginInfo
+	WithAuthMethod                  *authmethods.AuthMethodInfo
 	WithAuthorizedActions           []string
 	WithAuthorizedCollectionActions map[string]*structpb.ListValue
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithPopulatedFields             bool
+	WithAttributesJson              bool
+	WithRoleCount                   *uint32
+	WithTags                        map[string]string
+	WithUri                         string
 }
 
 func getDefaultOptions() options {
@@ -83,6 +90,14 @@ func WithPlugin(plg *plugins.PluginInfo) Option {
 	}
 }
 
+// WithAuthMethod provides an option when creating responses to include the
+// given auth method if allowed
+func WithAuthMethod(am *authmethods.AuthMethodInfo) Option {
+	return func(o *options) {
+		o.WithAuthMethod = am
+	}
+}
+
 // WithAuthorizedActions provides an option when creating responses to include the given
 // authorized actions if allowed
 func WithAuthorizedActions(acts []string) Option {
@@ -122,3 +137,43 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithPopulatedFields provides an option when creating responses to include
+// the list of output fields which were populated
+func WithPopulatedFields(populated bool) Option {
+	return func(o *options) {
+		o.WithPopulatedFields = populated
+	}
+}
+
+// WithAttributesJson provides an option when creating responses to encode the
+// subtype attributes as a JSON string rather than as a structured field
+func WithAttributesJson(asJson bool) Option {
+	return func(o *options) {
+		o.WithAttributesJson = asJson
+	}
+}
+
+// WithRoleCount provides an option when creating responses to include the
+// given number of roles the resource is a principal in if allowed
+func WithRoleCount(count uint32) Option {
+	return func(o *options) {
+		o.WithRoleCount = &count
+	}
+}
+
+// WithTags provides an option when creating responses to include the given
+// tags if allowed
+func WithTags(tags map[string]string) Option {
+	return func(o *options) {
+		o.WithTags = tags
+	}
+}
+
+// WithUri provides an option when creating responses to include the given
+// location of the resource if allowed
+func WithUri(uri string) Option {
+	return func(o *options) {
+		o.WithUri = uri
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	commonSrv "github.com/hashicorp/boundary/internal/daemon/common"
@@ -290,44 +291,78 @@ func errorInterceptor(
 		h, handlerErr := handler(interceptorCtx, req)
 
 		// if there's an error and it's a domain err, then encode it into the
+		// headers for the http proxy
 		if handlerErr != nil {
-			var domainErr *errors.Err
-			isDomainErr := errors.As(handlerErr, &domainErr)
-			if isDomainErr {
-				pbErr := errors.ToPbErrors(domainErr)
-				var buf []byte
-				var err error
-				if buf, err = proto.Marshal(pbErr); err != nil {
-					return h, status.Errorf(codes.Internal, "unable to marshal domain error: %s", err)
-				}
-				if err := grpc.SetHeader(interceptorCtx, metadata.Pairs(domainErrHeader, base58.FastBase58Encoding(buf))); err != nil {
-					return h, status.Errorf(codes.Internal, "unable to set domain error header: %s", err)
-				}
-				return h, handlerErr
+			md, err := errorHeader(handlerErr)
+			if err != nil {
+				return h, err
 			}
-
-			var apiErr *handlers.ApiError
-			isApiError := errors.As(handlerErr, &apiErr)
-			if isApiError {
-				pbErr := &pberrors.ApiError{
-					ApiError: apiErr.Inner,
-					Status:   apiErr.Status,
-				}
-				var buf []byte
-				var err error
-				if buf, err = proto.Marshal(pbErr); err != nil {
-					return h, status.Errorf(codes.Internal, "unable to marshal api error: %s", err)
-				}
-				if err := grpc.SetHeader(interceptorCtx, metadata.Pairs(apiErrHeader, base58.FastBase58Encoding(buf))); err != nil {
-					return h, status.Errorf(codes.Internal, "unable to set api error header: %s", err)
+			if md != nil {
+				if err := grpc.SetHeader(interceptorCtx, md); err != nil {
+					return h, status.Errorf(codes.Internal, "unable to set error header: %s", err)
 				}
-				return h, handlerErr
 			}
 		}
 		return h, handlerErr
 	}
 }
 
+// errorStreamInterceptor is the stream server interceptor equivalent of
+// errorInterceptor. The headers of a stream are sent with its first message,
+// so the error of a stream which failed after sending messages is only
+// returned as its status.
+func errorStreamInterceptor(
+	_ context.Context,
+) grpc.StreamServerInterceptor {
+	const op = "controller.errorStreamInterceptor"
+	return func(srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// call the handler...
+		handlerErr := handler(srv, ss)
+		if handlerErr != nil {
+			md, err := errorHeader(handlerErr)
+			if err != nil {
+				return err
+			}
+			if md != nil {
+				// Setting the header fails once it has been sent.
+				_ = ss.SetHeader(md)
+			}
+		}
+		return handlerErr
+	}
+}
+
+// errorHeader returns the header encoding err for the http proxy if it's a
+// domain or an api error, and nil otherwise.
+func errorHeader(handlerErr error) (metadata.MD, error) {
+	var domainErr *errors.Err
+	if errors.As(handlerErr, &domainErr) {
+		buf, err := proto.Marshal(errors.ToPbErrors(domainErr))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to marshal domain error: %s", err)
+		}
+		return metadata.Pairs(domainErrHeader, base58.FastBase58Encoding(buf)), nil
+	}
+
+	var apiErr *handlers.ApiError
+	if errors.As(handlerErr, &apiErr) {
+		pbErr := &pberrors.ApiError{
+			ApiError: apiErr.Inner,
+			Status:   apiErr.Status,
+		}
+		buf, err := proto.Marshal(pbErr)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to marshal api error: %s", err)
+		}
+		return metadata.Pairs(apiErrHeader, base58.FastBase58Encoding(buf)), nil
+	}
+	return nil, nil
+}
+
 func statusCodeInterceptor(
 	_ context.Context,
 ) grpc.UnaryServerInterceptor {
@@ -353,6 +388,35 @@ func statusCodeInterceptor(
 	}
 }
 
+// statusCodeStreamInterceptor is the stream server interceptor equivalent of
+// statusCodeInterceptor: a nil message sent by the handler is sent as an empty
+// response with a 204 status code for the http proxy.
+func statusCodeStreamInterceptor(
+	_ context.Context,
+) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &statusCodeServerStream{ServerStream: ss})
+	}
+}
+
+type statusCodeServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *statusCodeServerStream) SendMsg(m any) error {
+	if isNil(m) {
+		if err := s.SetHeader(metadata.Pairs(handlers.StatusCodeHeader, strconv.Itoa(http.StatusNoContent))); err != nil {
+			return status.Errorf(codes.Internal, "unable to set status code header: %s", err)
+		}
+		m = &pb.EmptyResponse{}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
 func isNil(i any) bool {
 	if i == nil {
 		return true
@@ -411,6 +475,71 @@ func auditResponseInterceptor(
 	}
 }
 
+// auditRequestStreamInterceptor is the stream server interceptor equivalent
+// of auditRequestInterceptor, auditing each message the stream receives.
+func auditRequestStreamInterceptor(
+	_ context.Context,
+) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &auditRequestServerStream{ServerStream: ss})
+	}
+}
+
+type auditRequestServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *auditRequestServerStream) RecvMsg(m any) error {
+	const op = "controller.auditRequestStreamInterceptor"
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		// Clone the request before writing it to the audit log,
+		// in case downstream interceptors modify it.
+		clonedMsg := proto.Clone(msg)
+		if err := event.WriteAudit(s.Context(), op, event.WithRequest(&event.Request{Details: clonedMsg})); err != nil {
+			return status.Errorf(codes.Internal, "unable to write request msg audit: %s", err)
+		}
+	}
+	return nil
+}
+
+// auditResponseStreamInterceptor is the stream server interceptor equivalent
+// of auditResponseInterceptor, auditing each message the stream sends.
+func auditResponseStreamInterceptor(
+	_ context.Context,
+) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &auditResponseServerStream{ServerStream: ss})
+	}
+}
+
+type auditResponseServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *auditResponseServerStream) SendMsg(m any) error {
+	const op = "controller.auditResponseStreamInterceptor"
+	if msg, ok := m.(proto.Message); ok && !isNil(msg) {
+		// Clone the response before writing it to the audit log,
+		// in case upstream interceptors modify it.
+		clonedMsg := proto.Clone(msg)
+		if err := event.WriteAudit(s.Context(), op, event.WithResponse(&event.Response{Details: clonedMsg})); err != nil {
+			return status.Errorf(codes.Internal, "unable to write response msg audit: %s", err)
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
 func workerRequestInfoInterceptor(ctx context.Context, eventer *event.Eventer) (grpc.UnaryServerInterceptor, error) {
 	const op = "worker.requestInfoInterceptor"
 	if eventer == nil {
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 )
@@ -772,6 +773,102 @@ func Test_statusCodeInterceptor(t *testing.T) {
 	}
 }
 
+// headerStreamMock is a server stream which records the headers set and the
+// messages sent on it.
+type headerStreamMock struct {
+	grpc.ServerStream
+	header metadata.MD
+	sent   []any
+}
+
+func (m *headerStreamMock) Context() context.Context {
+	return context.Background()
+}
+
+func (m *headerStreamMock) SetHeader(md metadata.MD) error {
+	m.header = metadata.Join(m.header, md)
+	return nil
+}
+
+func (m *headerStreamMock) SendMsg(msg any) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func Test_errorStreamInterceptor(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		handlerErr    error
+		wantDomainErr bool
+		wantApiErr    bool
+	}{
+		{
+			name:          "domain-error",
+			handlerErr:    errors.New(ctx, errors.Internal, "Test_errorStreamInterceptor", "domain error msg"),
+			wantDomainErr: true,
+		},
+		{
+			name:       "api-error",
+			handlerErr: handlers.ApiErrorWithCodeAndMessage(codes.Internal, "api error msg"),
+			wantApiErr: true,
+		},
+		{
+			name:       "other-error",
+			handlerErr: fmt.Errorf("other error"),
+		},
+		{
+			name: "success",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ss := &headerStreamMock{}
+			err := errorStreamInterceptor(ctx)(nil, ss, &grpc.StreamServerInfo{}, func(any, grpc.ServerStream) error {
+				return tt.handlerErr
+			})
+			assert.Equal(tt.handlerErr, err)
+			domainHdr := ss.header.Get(domainErrHeader)
+			apiErrHdr := ss.header.Get(apiErrHeader)
+			switch {
+			case tt.wantDomainErr:
+				require.Len(domainHdr, 1)
+				assert.Len(apiErrHdr, 0)
+				decoded, err := base58.FastBase58Decoding(domainHdr[0])
+				require.NoError(err)
+				var pbErr pberrors.Err
+				require.NoError(proto.Unmarshal(decoded, &pbErr))
+			case tt.wantApiErr:
+				require.Len(apiErrHdr, 1)
+				assert.Len(domainHdr, 0)
+				decoded, err := base58.FastBase58Decoding(apiErrHdr[0])
+				require.NoError(err)
+				var pbErr pberrors.ApiError
+				require.NoError(proto.Unmarshal(decoded, &pbErr))
+			default:
+				assert.Len(ss.header, 0)
+			}
+		})
+	}
+}
+
+func Test_statusCodeStreamInterceptor(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ss := &headerStreamMock{}
+	err := statusCodeStreamInterceptor(context.Background())(nil, ss, &grpc.StreamServerInfo{}, func(_ any, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(&interceptor.SayHelloResponse{Message: "hello"}); err != nil {
+			return err
+		}
+		return ss.SendMsg((*interceptor.SayHelloResponse)(nil))
+	})
+	require.NoError(err)
+	require.Len(ss.sent, 2)
+	assert.Equal("hello", ss.sent[0].(*interceptor.SayHelloResponse).GetMessage())
+	assert.IsType(&pb_api.EmptyResponse{}, ss.sent[1])
+	assert.Equal([]string{strconv.Itoa(http.StatusNoContent)}, ss.header.Get(handlers.StatusCodeHeader))
+}
+
 func Test_workerRequestInfoInterceptor(t *testing.T) {
 	factoryCtx := context.Background()
 	requestCtx := context.Background()
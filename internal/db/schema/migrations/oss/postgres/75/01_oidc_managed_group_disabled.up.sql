@@ -0,0 +1,11 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column disabled bool not null default false;
+  comment on column auth_oidc_managed_group.disabled is
+    'disabled managed groups keep their definition but are skipped when evaluating membership at login.';
+
+commit;
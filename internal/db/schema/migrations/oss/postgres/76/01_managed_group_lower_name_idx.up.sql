@@ -0,0 +1,15 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Managed groups are looked up by name case-insensitively. Names are still
+  -- stored as provided and their uniqueness within an auth method remains
+  -- case-sensitive.
+  create index auth_oidc_managed_group_auth_method_id_lower_name_ix
+    on auth_oidc_managed_group (auth_method_id, lower(name));
+
+  create index auth_ldap_managed_group_auth_method_id_lower_name_ix
+    on auth_ldap_managed_group (auth_method_id, lower(name));
+
+commit;
@@ -0,0 +1,81 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_oidc_claim_alias entries are the optional named aliases for selectors
+  -- which the managed group filters of an oidc auth method can reference as
+  -- @alias. There can be 0 or more for each parent oidc auth method.
+  create table auth_oidc_claim_alias (
+    create_time wt_timestamp,
+    oidc_method_id wt_public_id
+      constraint auth_oidc_method_fkey
+      references auth_oidc_method(public_id)
+      on delete cascade
+      on update cascade,
+    alias text not null
+      constraint alias_must_be_an_identifier
+        check(alias ~ '^[A-Za-z_][A-Za-z0-9_]{0,127}$'),
+    selector text not null
+      constraint selector_must_be_a_json_pointer
+        check(selector ~ '^(/[^/|]+)+$')
+      constraint selector_must_be_less_than_1024_chars
+        check(length(selector) < 1024),
+    primary key(oidc_method_id, alias)
+  );
+  comment on table auth_oidc_claim_alias is
+    'auth_oidc_claim_alias entries are the optional named aliases for selectors which the managed group filters of an oidc auth method can reference. There can be 0 or more for each parent oidc auth method.';
+
+  create trigger default_create_time_column before insert on auth_oidc_claim_alias
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_oidc_claim_alias
+    for each row execute procedure immutable_columns('oidc_method_id', 'alias', 'selector', 'create_time');
+
+  -- Recreate the view to add the claim aliases, previously created in
+  -- 56/02_add_data_key_foreign_key_references.up.sql
+  drop view oidc_auth_method_with_value_obj;
+  -- Replaced in 88/01_oidc_claim_type.up.sql
+  create view oidc_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.disable_discovered_config_validation,
+    am.issuer,
+    am.client_id,
+    am.client_secret,
+    am.client_secret_hmac,
+    am.key_id,
+    am.max_age,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct alg.signing_alg_name, '|') as algs,
+    string_agg(distinct aud.aud_claim, '|') as auds,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct cs.scope, '|') as claims_scopes,
+    string_agg(distinct concat_ws('=', acm.from_claim, acm.to_claim), '|') as account_claim_maps,
+    string_agg(distinct concat_ws('=', ca.alias, ca.selector), '|') as claim_aliases
+  from
+    auth_oidc_method am
+    left outer join iam_scope                   s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_oidc_signing_alg       alg   on am.public_id = alg.oidc_method_id
+    left outer join auth_oidc_aud_claim         aud   on am.public_id = aud.oidc_method_id
+    left outer join auth_oidc_certificate       cert  on am.public_id = cert.oidc_method_id
+    left outer join auth_oidc_scope             cs    on am.public_id = cs.oidc_method_id
+    left outer join auth_oidc_account_claim_map acm   on am.public_id = acm.oidc_method_id
+    left outer join auth_oidc_claim_alias       ca    on am.public_id = ca.oidc_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view oidc_auth_method_with_value_obj is
+    'oidc auth method with its associated value objects (algs, auds, certs, scopes, account claim maps, claim aliases) as columns with | delimited values';
+
+commit;
@@ -0,0 +1,11 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column match_case_insensitive bool not null default false;
+  comment on column auth_oidc_managed_group.match_case_insensitive is
+    'managed groups which match case insensitively compare the claims and the values of their filter without regard to case.';
+
+commit;
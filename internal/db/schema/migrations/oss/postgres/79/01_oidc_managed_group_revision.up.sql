@@ -0,0 +1,36 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_oidc_managed_group_revision entries hold the versions of an oidc
+  -- managed group which were replaced by an update, along with when they were
+  -- valid and who replaced them. Entries are only ever appended; they are
+  -- deleted along with their managed group.
+  create table auth_oidc_managed_group_revision (
+    managed_group_id wt_public_id not null
+      constraint auth_oidc_managed_group_fkey
+        references auth_oidc_managed_group (public_id)
+        on delete cascade
+        on update cascade,
+    version wt_version,
+    name wt_name,
+    description wt_description,
+    filter wt_bexprfilter not null,
+    disabled boolean not null default false,
+    match_case_insensitive boolean not null default false,
+    valid_from_time timestamp with time zone not null,
+    replaced_time wt_timestamp,
+    replaced_by text null,
+    constraint valid_from_time_must_not_be_after_replaced_time
+      check(valid_from_time <= replaced_time),
+    primary key(managed_group_id, version)
+  );
+  comment on table auth_oidc_managed_group_revision is
+    'auth_oidc_managed_group_revision entries hold the versions of an oidc managed group which were replaced by an update. Entries are append only.';
+
+  create trigger immutable_columns before update on auth_oidc_managed_group_revision
+    for each row execute procedure immutable_columns('managed_group_id', 'version', 'name', 'description', 'filter',
+      'disabled', 'match_case_insensitive', 'valid_from_time', 'replaced_time', 'replaced_by');
+
+commit;
@@ -0,0 +1,41 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column filter_version wt_version;
+  comment on column auth_oidc_managed_group.filter_version is
+    'filter_version is incremented whenever the filter of the managed group changes, unlike version it is not incremented by changes to any other column.';
+
+  -- update_filter_version_column increments the filter_version of an oidc
+  -- managed group when its filter changes. Any other update to the
+  -- filter_version is discarded.
+  create function update_filter_version_column() returns trigger
+  as $$
+  begin
+    if new.filter is distinct from old.filter then
+      new.filter_version = old.filter_version + 1;
+    else
+      new.filter_version = old.filter_version;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function update_filter_version_column() is
+    'function used in before update triggers to increment the filter_version column when the filter column changes';
+
+  create trigger update_filter_version_column before update on auth_oidc_managed_group
+    for each row execute procedure update_filter_version_column();
+
+  -- Revisions record the filter_version of the version they hold. Revisions
+  -- written before the column existed hold the first filter version.
+  alter table auth_oidc_managed_group_revision
+    add column filter_version wt_version;
+
+  drop trigger immutable_columns on auth_oidc_managed_group_revision;
+  create trigger immutable_columns before update on auth_oidc_managed_group_revision
+    for each row execute procedure immutable_columns('managed_group_id', 'version', 'name', 'description', 'filter',
+      'disabled', 'match_case_insensitive', 'valid_from_time', 'replaced_time', 'replaced_by', 'filter_version');
+
+commit;
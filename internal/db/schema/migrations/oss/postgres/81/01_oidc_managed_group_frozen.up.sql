@@ -0,0 +1,11 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column frozen bool not null default false;
+  comment on column auth_oidc_managed_group.frozen is
+    'frozen managed groups keep the members they had when they were frozen rather than being matched at login.';
+
+commit;
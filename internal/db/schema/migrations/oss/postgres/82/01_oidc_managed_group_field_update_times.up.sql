@@ -0,0 +1,52 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- The columns are added without a default so that existing managed groups,
+  -- whose field changes weren't recorded, are left null rather than all
+  -- appearing to have changed when this migration ran. Managed groups created
+  -- from now on start with their create time.
+  alter table auth_oidc_managed_group
+    add column name_update_time timestamp with time zone,
+    add column description_update_time timestamp with time zone,
+    add column filter_update_time timestamp with time zone;
+  alter table auth_oidc_managed_group
+    alter column name_update_time set default current_timestamp,
+    alter column description_update_time set default current_timestamp,
+    alter column filter_update_time set default current_timestamp;
+  comment on column auth_oidc_managed_group.name_update_time is
+    'name_update_time is the time the name of the managed group last changed, or null if it has not changed since before the time was recorded.';
+  comment on column auth_oidc_managed_group.description_update_time is
+    'description_update_time is the time the description of the managed group last changed, or null if it has not changed since before the time was recorded.';
+  comment on column auth_oidc_managed_group.filter_update_time is
+    'filter_update_time is the time the filter of the managed group last changed, or null if it has not changed since before the time was recorded.';
+
+  -- update_oidc_managed_group_field_update_times sets the update time of each
+  -- of the name, description and filter of an oidc managed group which
+  -- changes. Any other update to the times is discarded.
+  create function update_oidc_managed_group_field_update_times() returns trigger
+  as $$
+  begin
+    new.name_update_time = old.name_update_time;
+    if new.name is distinct from old.name then
+      new.name_update_time = now();
+    end if;
+    new.description_update_time = old.description_update_time;
+    if new.description is distinct from old.description then
+      new.description_update_time = now();
+    end if;
+    new.filter_update_time = old.filter_update_time;
+    if new.filter is distinct from old.filter then
+      new.filter_update_time = now();
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function update_oidc_managed_group_field_update_times() is
+    'function used in before update triggers to set the update time of each field of an oidc managed group which changes';
+
+  create trigger update_field_update_times before update on auth_oidc_managed_group
+    for each row execute procedure update_oidc_managed_group_field_update_times();
+
+commit;
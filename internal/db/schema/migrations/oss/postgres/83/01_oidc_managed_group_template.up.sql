@@ -0,0 +1,47 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_oidc_managed_group_template entries are reusable definitions of oidc
+  -- managed groups. A template is defined in a scope and oidc managed groups
+  -- of the auth methods of that scope can be created from it. The filter can
+  -- reference the id of the auth method the managed group is created in as
+  -- {{auth_method_id}}.
+  create table auth_oidc_managed_group_template (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_fkey
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    name wt_name not null,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    filter wt_bexprfilter not null,
+    constraint auth_oidc_managed_group_template_scope_id_name_uq
+      unique(scope_id, name)
+  );
+  comment on table auth_oidc_managed_group_template is
+    'auth_oidc_managed_group_template entries are reusable definitions of oidc managed groups which oidc managed groups of the auth methods of their scope can be created from.';
+
+  create trigger immutable_columns before update on auth_oidc_managed_group_template
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_oidc_managed_group_template
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_oidc_managed_group_template
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_oidc_managed_group_template
+    for each row execute procedure update_version_column();
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_oidc_managed_group_template', 1);
+
+commit;
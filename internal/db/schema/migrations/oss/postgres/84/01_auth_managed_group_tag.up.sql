@@ -0,0 +1,33 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_managed_group_tag entries are free-form key/value tags used to
+  -- organize managed groups of any subtype. A managed group has at most one
+  -- value for each key.
+  create table auth_managed_group_tag (
+    managed_group_id wt_public_id not null
+      constraint auth_managed_group_fkey
+      references auth_managed_group(public_id)
+      on delete cascade
+      on update cascade,
+    key text not null
+      constraint key_length_must_be_between_1_and_128
+      check(length(key) between 1 and 128),
+    value text not null
+      constraint value_length_must_be_at_most_256
+      check(length(value) <= 256),
+    create_time wt_timestamp,
+    primary key (managed_group_id, key)
+  );
+  comment on table auth_managed_group_tag is
+    'auth_managed_group_tag entries are free-form key/value tags used to organize managed groups.';
+
+  create trigger immutable_columns before update on auth_managed_group_tag
+    for each row execute procedure immutable_columns('managed_group_id', 'key', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_managed_group_tag
+    for each row execute procedure default_create_time();
+
+commit;
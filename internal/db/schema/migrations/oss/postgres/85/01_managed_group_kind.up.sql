@@ -0,0 +1,20 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column kind text not null default 'login'
+      constraint kind_must_be_login_or_synced
+      check(kind in ('login', 'synced'));
+  comment on column auth_oidc_managed_group.kind is
+    'kind is login for managed groups evaluated at login and synced for managed groups whose members are synced from an external directory.';
+
+  alter table auth_ldap_managed_group
+    add column kind text not null default 'login'
+      constraint kind_must_be_login_or_synced
+      check(kind in ('login', 'synced'));
+  comment on column auth_ldap_managed_group.kind is
+    'kind is login for managed groups evaluated at login and synced for managed groups whose members are synced from an external directory.';
+
+commit;
@@ -0,0 +1,15 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Managed groups written before this migration are left null, since the
+  -- version their filter was validated under wasn't recorded.
+  alter table auth_oidc_managed_group
+    add column filter_syntax_version text
+      constraint filter_syntax_version_must_not_be_empty
+      check(length(trim(filter_syntax_version)) > 0);
+  comment on column auth_oidc_managed_group.filter_syntax_version is
+    'filter_syntax_version is the version of the filter syntax the filter was validated under when it was last written.';
+
+commit;
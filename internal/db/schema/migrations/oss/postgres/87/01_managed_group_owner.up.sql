@@ -0,0 +1,20 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_managed_group
+    add column owner_id text
+      constraint owner_id_must_not_be_empty
+      check(length(trim(owner_id)) > 0);
+  comment on column auth_oidc_managed_group.owner_id is
+    'owner_id is the id of a user or a free-form principal who owns the managed group. It is only informational.';
+
+  alter table auth_ldap_managed_group
+    add column owner_id text
+      constraint owner_id_must_not_be_empty
+      check(length(trim(owner_id)) > 0);
+  comment on column auth_ldap_managed_group.owner_id is
+    'owner_id is the id of a user or a free-form principal who owns the managed group. It is only informational.';
+
+commit;
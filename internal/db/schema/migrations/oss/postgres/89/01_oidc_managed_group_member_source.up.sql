@@ -0,0 +1,15 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Memberships which already exist were added before their source was
+  -- recorded, so they are all recorded as login memberships.
+  alter table auth_oidc_managed_group_member_account
+    add column source text not null default 'login'
+      constraint source_must_be_login_or_sync
+      check(source in ('login', 'sync'));
+  comment on column auth_oidc_managed_group_member_account.source is
+    'source is login for memberships added because the account matched the managed group and sync for memberships added from outside Boundary.';
+
+commit;
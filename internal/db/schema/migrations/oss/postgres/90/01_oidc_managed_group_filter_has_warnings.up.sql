@@ -0,0 +1,13 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Filters are linted by the controller, so managed groups written before
+  -- this migration are left false until their filter is next written.
+  alter table auth_oidc_managed_group
+    add column filter_has_warnings boolean not null default false;
+  comment on column auth_oidc_managed_group.filter_has_warnings is
+    'filter_has_warnings is true if linting the filter when it was last written returned warnings.';
+
+commit;
@@ -0,0 +1,15 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Filters are parsed by the controller, so managed groups written before
+  -- this migration are left 0 until their filter is next written.
+  alter table auth_oidc_managed_group
+    add column filter_complexity integer not null default 0
+      constraint filter_complexity_must_not_be_negative
+      check(filter_complexity >= 0);
+  comment on column auth_oidc_managed_group.filter_complexity is
+    'filter_complexity is the number of nodes of the filter when it was last written, an estimate of how expensive it is to evaluate.';
+
+commit;
@@ -0,0 +1,38 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- replaces view defined in postgres/65/01_ldap.up.sql so the members of
+  -- synced ldap managed groups aren't found from the groups of accounts when
+  -- they log in; they are synced from an external directory instead.
+  create or replace view auth_ldap_managed_group_member_account as
+  with
+  account(id, group_name) as (
+    select
+      a.public_id, ag.group_name
+    from
+      auth_ldap_account a
+    left join jsonb_array_elements(a.member_of_groups) as ag(group_name) on true
+  ),
+  groups (create_time, id, group_name) as (
+    select
+      g.create_time,
+      g.public_id,
+      mg.group_name
+    from
+      auth_ldap_managed_group g
+    left join jsonb_array_elements(g.group_names) as mg(group_name) on true
+    where g.kind = 'login'
+  )
+  select distinct
+    groups.create_time,
+    account.id as member_id,
+    groups.id as managed_group_id
+  from account, groups
+  where account.group_name = groups.group_name;
+  comment on view auth_ldap_managed_group_member_account is
+    'auth_ldap_managed_group_member_account is the join view for '
+    'managed ldap groups of the login kind and accounts';
+
+commit;
@@ -19,14 +19,38 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ManagedGroupServiceClient interface {
 	// GetManagedGroup returns a stored ManagedGroup if present. The provided request must
-	// include the id for the ManagedGroup be retrieved. If missing, malformed or
-	// referencing a non existing ManagedGroup an error is returned.
+	// include either the id for the ManagedGroup be retrieved, or the Auth Method
+	// id and the name of the ManagedGroup. If missing, malformed or
+	// referencing a non existing ManagedGroup an error is returned. With an OIDC
+	// Auth Method id the id may be a prefix of the ManagedGroup's id, e.g. one
+	// that was truncated, as long as no other ManagedGroup in the Auth Method
+	// has an id with that prefix.
 	GetManagedGroup(ctx context.Context, in *GetManagedGroupRequest, opts ...grpc.CallOption) (*GetManagedGroupResponse, error)
+	// BatchGetManagedGroups returns the stored ManagedGroups with the provided
+	// ids, which may belong to different Auth Methods, looking them up together
+	// rather than one at a time. Each ManagedGroup is only returned when the
+	// caller is granted the read action on it. The ids of the others, whether
+	// they don't exist or the caller can't read them, are returned as
+	// missing_ids so the two can't be told apart.
+	BatchGetManagedGroups(ctx context.Context, in *BatchGetManagedGroupsRequest, opts ...grpc.CallOption) (*BatchGetManagedGroupsResponse, error)
 	// ListManagedGroups returns a list of stored ManagedGroups which exist inside the
 	// provided Auth Method. The request must include the Auth Method id which
 	// contains the ManagedGroups being listed. If missing or malformed an error
 	// is returned.
 	ListManagedGroups(ctx context.Context, in *ListManagedGroupsRequest, opts ...grpc.CallOption) (*ListManagedGroupsResponse, error)
+	// StreamManagedGroups sends the ManagedGroups of an Auth Method one at a
+	// time, ordered by id, as each is authorized and matched against the
+	// filter, so exporting a large Auth Method doesn't build one large
+	// response. The list action is authorized and ManagedGroups are pruned
+	// the same way ListManagedGroups does.
+	StreamManagedGroups(ctx context.Context, in *StreamManagedGroupsRequest, opts ...grpc.CallOption) (ManagedGroupService_StreamManagedGroupsClient, error)
+	// ListManagedGroupsByMember returns the ManagedGroups the provided Account
+	// currently is a member of, ordered by id. The list action is authorized in
+	// the Auth Method of the Account, and only the ManagedGroups the caller is
+	// authorized to see are returned. If more ManagedGroups remain than the
+	// page_size the response's next_page_token is set, which is provided as
+	// page_token to list the next page.
+	ListManagedGroupsByMember(ctx context.Context, in *ListManagedGroupsByMemberRequest, opts ...grpc.CallOption) (*ListManagedGroupsByMemberResponse, error)
 	// CreateManagedGroup creates and stores a ManagedGroup. The provided request
 	// must include the Auth Method ID in which the ManagedGroup will be created.
 	// If the Auth Method ID is missing, malformed, or references a non existing
@@ -41,11 +65,179 @@ type ManagedGroupServiceClient interface {
 	// provided ManagedGroup. An error is returned if the ManagedGroup id is
 	// missing or references a non-existing resource. An error is also returned if
 	// the request attempts to update the name to one that is already in use in
-	// the containing Auth Method.
+	// the containing Auth Method. If preview is set the update is computed but
+	// not applied, and the response also holds the changes to the masked fields.
 	UpdateManagedGroup(ctx context.Context, in *UpdateManagedGroupRequest, opts ...grpc.CallOption) (*UpdateManagedGroupResponse, error)
+	// PreviewUpdateManagedGroup computes an update of an existing ManagedGroup
+	// as UpdateManagedGroup does, without applying it. The response holds the
+	// ManagedGroup as it would be after the update and the changes to the masked
+	// fields. Previewing requires the update action on the ManagedGroup, which
+	// must be at the provided version, and fails as the update would, for
+	// example if the name is already in use in the containing Auth Method.
+	PreviewUpdateManagedGroup(ctx context.Context, in *PreviewUpdateManagedGroupRequest, opts ...grpc.CallOption) (*PreviewUpdateManagedGroupResponse, error)
+	// TouchManagedGroup increments the version and sets the updated_time of a
+	// ManagedGroup without changing any of its other fields, so that clients
+	// holding a copy of it know to fetch it again. The request must include the
+	// current version of the ManagedGroup. Touching requires the update action
+	// on the ManagedGroup.
+	TouchManagedGroup(ctx context.Context, in *TouchManagedGroupRequest, opts ...grpc.CallOption) (*TouchManagedGroupResponse, error)
 	// DeleteManagedGroup removes a ManagedGroup. If the provided ManagedGroup Id
-	// is malformed or not provided an error is returned.
+	// is malformed or not provided an error is returned. If the ManagedGroup is
+	// a principal in any roles an error listing those roles is returned unless
+	// force is set, in which case it is also removed from those roles.
 	DeleteManagedGroup(ctx context.Context, in *DeleteManagedGroupRequest, opts ...grpc.CallOption) (*DeleteManagedGroupResponse, error)
+	// UpsertManagedGroup creates a ManagedGroup with the provided name in the
+	// provided Auth Method, or updates the attributes, and the description if
+	// one is provided, of the ManagedGroup with that name if one already
+	// exists. Creating requires the create action on the Auth Method and
+	// updating the update action on the ManagedGroup. The ManagedGroup is
+	// updated at the version it was authorized at, so the request must not
+	// include a version and fails if it is changed concurrently. The name and
+	// Auth Method ID are required. The response reports whether the
+	// ManagedGroup was created.
+	UpsertManagedGroup(ctx context.Context, in *UpsertManagedGroupRequest, opts ...grpc.CallOption) (*UpsertManagedGroupResponse, error)
+	// PreviewManagedGroupMatches evaluates the filter of every ManagedGroup in
+	// the provided OIDC Auth Method against the provided claims and returns the
+	// IDs of the ManagedGroups an account with those claims would be a member
+	// of. The claims are evaluated the same way as at login, so ID Token claims
+	// belong under "token" and UserInfo claims under "userinfo". Nothing is
+	// persisted. If the Auth Method has more ManagedGroups than can be
+	// evaluated in a single request only the first ones are evaluated and
+	// truncated is set.
+	PreviewManagedGroupMatches(ctx context.Context, in *PreviewManagedGroupMatchesRequest, opts ...grpc.CallOption) (*PreviewManagedGroupMatchesResponse, error)
+	// GetManagedGroupFilterCapabilities returns what the filters of the
+	// ManagedGroups in the provided Auth Method can be written with: the filter
+	// operators, the prefixes of the selectors which reference claims at login
+	// and the claim aliases configured on the Auth Method. Only OIDC
+	// ManagedGroups have filters, so for other Auth Methods filters_supported
+	// is false and nothing else is set.
+	GetManagedGroupFilterCapabilities(ctx context.Context, in *GetManagedGroupFilterCapabilitiesRequest, opts ...grpc.CallOption) (*GetManagedGroupFilterCapabilitiesResponse, error)
+	// ExportManagedGroups returns every ManagedGroup in the provided Auth Method
+	// as a portable document which can be imported into another Auth Method of
+	// the same type. The document only holds the name, description and
+	// attributes of each ManagedGroup, not its id, version or timestamps, and
+	// its ManagedGroups are ordered by name.
+	ExportManagedGroups(ctx context.Context, in *ExportManagedGroupsRequest, opts ...grpc.CallOption) (*ExportManagedGroupsResponse, error)
+	// ImportManagedGroups creates the ManagedGroups of a document returned by
+	// ExportManagedGroups in the provided Auth Method, which must be of the same
+	// type as the one the document was exported from. Each ManagedGroup is
+	// validated and created on its own and the outcome for each is reported, so
+	// a ManagedGroup which fails doesn't prevent the others from being created.
+	// With skip_existing, ManagedGroups whose name is already in use in the Auth
+	// Method are skipped. With dry_run nothing is created and the outcome which
+	// an import would have is reported.
+	ImportManagedGroups(ctx context.Context, in *ImportManagedGroupsRequest, opts ...grpc.CallOption) (*ImportManagedGroupsResponse, error)
+	// RefreshAuthMethodManagedGroups re-evaluates the filter of every
+	// ManagedGroup in the provided OIDC Auth Method against the claims each of
+	// its Accounts presented when they last logged in, and updates the
+	// memberships of the ManagedGroups to match in a single transaction. Only
+	// the ManagedGroups whose memberships change have their version
+	// incremented. It returns how many memberships were added to and removed
+	// from each of them. Refreshing requires the list action on the Auth
+	// Method's ManagedGroups, and refreshes only the ManagedGroups the caller
+	// can update; if it can't update any of them an error is returned.
+	RefreshAuthMethodManagedGroups(ctx context.Context, in *RefreshAuthMethodManagedGroupsRequest, opts ...grpc.CallOption) (*RefreshAuthMethodManagedGroupsResponse, error)
+	// GetManagedGroupGrants returns the grants of every Role the ManagedGroup
+	// is a principal in, which flow to the Users whose Accounts are members of
+	// the ManagedGroup. Each grant is returned with the Role it comes from and
+	// the scope that Role grants in.
+	GetManagedGroupGrants(ctx context.Context, in *GetManagedGroupGrantsRequest, opts ...grpc.CallOption) (*GetManagedGroupGrantsResponse, error)
+	// GetManagedGroupHistory returns the versions of an OIDC ManagedGroup which
+	// were replaced by updates, newest first, each with when it was replaced
+	// and by whom. The current version is returned by GetManagedGroup.
+	GetManagedGroupHistory(ctx context.Context, in *GetManagedGroupHistoryRequest, opts ...grpc.CallOption) (*GetManagedGroupHistoryResponse, error)
+	// DiffManagedGroupVersions compares two versions of an OIDC ManagedGroup,
+	// each either one returned by GetManagedGroupHistory or the current
+	// version. It returns the name, description and attribute fields which
+	// were added, removed or changed going from from_version to to_version,
+	// and the difference between the two filters as a sequence of token
+	// segments. Comparing requires the read action on the ManagedGroup.
+	DiffManagedGroupVersions(ctx context.Context, in *DiffManagedGroupVersionsRequest, opts ...grpc.CallOption) (*DiffManagedGroupVersionsResponse, error)
+	// CreateManagedGroupTemplate stores a reusable definition of an OIDC
+	// ManagedGroup in a scope. Its name, description and filter can reference
+	// the id of the Auth Method a ManagedGroup is created in as
+	// {{auth_method_id}}. The filter is validated with an example Auth Method
+	// id. Creating requires the create action on ManagedGroups in the scope.
+	CreateManagedGroupTemplate(ctx context.Context, in *CreateManagedGroupTemplateRequest, opts ...grpc.CallOption) (*CreateManagedGroupTemplateResponse, error)
+	// ListManagedGroupTemplates returns the ManagedGroupTemplates of a scope
+	// ordered by name. Listing requires the list action on ManagedGroups in the
+	// scope.
+	ListManagedGroupTemplates(ctx context.Context, in *ListManagedGroupTemplatesRequest, opts ...grpc.CallOption) (*ListManagedGroupTemplatesResponse, error)
+	// DeleteManagedGroupTemplate removes a ManagedGroupTemplate. ManagedGroups
+	// created from it are not changed. Deleting requires the delete action on
+	// ManagedGroups in the template's scope.
+	DeleteManagedGroupTemplate(ctx context.Context, in *DeleteManagedGroupTemplateRequest, opts ...grpc.CallOption) (*DeleteManagedGroupTemplateResponse, error)
+	// CreateManagedGroupFromTemplate creates an OIDC ManagedGroup in the
+	// provided Auth Method from a ManagedGroupTemplate of the Auth Method's
+	// scope, with {{auth_method_id}} replaced by the Auth Method's id. The
+	// resulting ManagedGroup is validated and created as by
+	// CreateManagedGroup, which authorizes it the same way.
+	CreateManagedGroupFromTemplate(ctx context.Context, in *CreateManagedGroupFromTemplateRequest, opts ...grpc.CallOption) (*CreateManagedGroupFromTemplateResponse, error)
+	// ReplaceInFilters replaces a selector prefix, such as "/token/groups", in
+	// the filter of every ManagedGroup in the provided OIDC Auth Method. Only
+	// selectors written as JSON pointers whose path starts with whole segments
+	// of the prefix are rewritten. Each rewritten filter is validated before it
+	// is saved, and a ManagedGroup whose filter can't be rewritten safely or
+	// becomes invalid fails on its own without preventing the others from
+	// being updated. Unless apply is set nothing is updated and the changes
+	// which would be made are reported. Replacing requires the update action on
+	// all ManagedGroups of the Auth Method.
+	ReplaceInFilters(ctx context.Context, in *ReplaceInFiltersRequest, opts ...grpc.CallOption) (*ReplaceInFiltersResponse, error)
+	// ValidateStoredFilters compiles the stored filter of every ManagedGroup in
+	// the provided OIDC Auth Method, or in every OIDC Auth Method of the
+	// provided scope, the way it is compiled at login, and reports the
+	// ManagedGroups whose filter fails to compile. It is meant to find filters
+	// which were valid when they were written but no longer are, e.g. after an
+	// upgrade changed the filter grammar. Nothing is modified. Validating
+	// requires the read action on all ManagedGroups of the Auth Method or scope.
+	// A scope with more Auth Methods than the controller is configured to cover
+	// in one request is validated in parts: the response is truncated and its
+	// next_after_auth_method_id is provided as after_auth_method_id to validate
+	// the next part.
+	ValidateStoredFilters(ctx context.Context, in *ValidateStoredFiltersRequest, opts ...grpc.CallOption) (*ValidateStoredFiltersResponse, error)
+	// AddManagedGroupToRoles adds a ManagedGroup as a principal to each of the
+	// provided Roles. A Role which doesn't exist or on which the caller isn't
+	// granted the add-principals action fails on its own, and a Role the
+	// ManagedGroup already is a principal in is left unchanged. The ManagedGroup
+	// is added to all of the other Roles in a single transaction, so either
+	// every one of them is updated or, if any of them can't be, none are.
+	// Adding requires the update action on the ManagedGroup.
+	AddManagedGroupToRoles(ctx context.Context, in *AddManagedGroupToRolesRequest, opts ...grpc.CallOption) (*AddManagedGroupToRolesResponse, error)
+	// AuthorizeManagedGroup reports whether the caller is authorized to perform
+	// the provided action on the ManagedGroup without performing it and without
+	// returning the ManagedGroup. Answering only requires the no-op action to be
+	// granted on the ManagedGroup.
+	AuthorizeManagedGroup(ctx context.Context, in *AuthorizeManagedGroupRequest, opts ...grpc.CallOption) (*AuthorizeManagedGroupResponse, error)
+	// CountManagedGroups returns the number of ManagedGroups in each Auth
+	// Method of the provided scope, and of its child scopes when recursive is
+	// set, without listing them. Each Auth Method is only counted when the
+	// caller is granted the list action on its ManagedGroups; the others are
+	// left out of the response.
+	CountManagedGroups(ctx context.Context, in *CountManagedGroupsRequest, opts ...grpc.CallOption) (*CountManagedGroupsResponse, error)
+	// GenerateManagedGroupMembershipReport sends the members of each
+	// ManagedGroup of an Auth Method, one ManagedGroup at a time ordered by id,
+	// for access reviews. Memberships are read as currently recorded; filters
+	// aren't evaluated again. ManagedGroups without members aren't sent.
+	// The report is for scope admins: it requires read on every ManagedGroup
+	// of the Auth Method, e.g. id=*;type=managed-group;actions=read, and update
+	// on the Auth Method itself, e.g. id=*;type=auth-method;actions=update.
+	GenerateManagedGroupMembershipReport(ctx context.Context, in *GenerateManagedGroupMembershipReportRequest, opts ...grpc.CallOption) (ManagedGroupService_GenerateManagedGroupMembershipReportClient, error)
+	// ListManagedGroupMembers returns the members of a ManagedGroup ordered by
+	// the time they became members, each with the source of its membership.
+	// Listing requires the read action on the ManagedGroup. If more members
+	// remain than the page_size the response's next_page_token is set, which is
+	// provided as page_token to list the next page.
+	ListManagedGroupMembers(ctx context.Context, in *ListManagedGroupMembersRequest, opts ...grpc.CallOption) (*ListManagedGroupMembersResponse, error)
+	// SetManagedGroupsEnabled enables or disables each of the provided OIDC
+	// ManagedGroups, which may belong to different Auth Methods. Each
+	// ManagedGroup must be at the provided version. A ManagedGroup which
+	// doesn't exist, isn't at its version or on which the caller isn't granted
+	// the update action fails on its own, and one which already is in the
+	// requested state is left unchanged. The other ManagedGroups are updated in
+	// a single transaction, so either every one of them is updated or, if any
+	// of them can't be, none are. It is the bulk form of updating
+	// attributes.disabled.
+	SetManagedGroupsEnabled(ctx context.Context, in *SetManagedGroupsEnabledRequest, opts ...grpc.CallOption) (*SetManagedGroupsEnabledResponse, error)
 }
 
 type managedGroupServiceClient struct {
@@ -65,6 +257,15 @@ func (c *managedGroupServiceClient) GetManagedGroup(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *managedGroupServiceClient) BatchGetManagedGroups(ctx context.Context, in *BatchGetManagedGroupsRequest, opts ...grpc.CallOption) (*BatchGetManagedGroupsResponse, error) {
+	out := new(BatchGetManagedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/BatchGetManagedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managedGroupServiceClient) ListManagedGroups(ctx context.Context, in *ListManagedGroupsRequest, opts ...grpc.CallOption) (*ListManagedGroupsResponse, error) {
 	out := new(ListManagedGroupsResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ListManagedGroups", in, out, opts...)
@@ -74,6 +275,47 @@ func (c *managedGroupServiceClient) ListManagedGroups(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *managedGroupServiceClient) StreamManagedGroups(ctx context.Context, in *StreamManagedGroupsRequest, opts ...grpc.CallOption) (ManagedGroupService_StreamManagedGroupsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagedGroupService_ServiceDesc.Streams[0], "/controller.api.services.v1.ManagedGroupService/StreamManagedGroups", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managedGroupServiceStreamManagedGroupsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagedGroupService_StreamManagedGroupsClient interface {
+	Recv() (*StreamManagedGroupsResponse, error)
+	grpc.ClientStream
+}
+
+type managedGroupServiceStreamManagedGroupsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managedGroupServiceStreamManagedGroupsClient) Recv() (*StreamManagedGroupsResponse, error) {
+	m := new(StreamManagedGroupsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managedGroupServiceClient) ListManagedGroupsByMember(ctx context.Context, in *ListManagedGroupsByMemberRequest, opts ...grpc.CallOption) (*ListManagedGroupsByMemberResponse, error) {
+	out := new(ListManagedGroupsByMemberResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ListManagedGroupsByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managedGroupServiceClient) CreateManagedGroup(ctx context.Context, in *CreateManagedGroupRequest, opts ...grpc.CallOption) (*CreateManagedGroupResponse, error) {
 	out := new(CreateManagedGroupResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/CreateManagedGroup", in, out, opts...)
@@ -92,6 +334,24 @@ func (c *managedGroupServiceClient) UpdateManagedGroup(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *managedGroupServiceClient) PreviewUpdateManagedGroup(ctx context.Context, in *PreviewUpdateManagedGroupRequest, opts ...grpc.CallOption) (*PreviewUpdateManagedGroupResponse, error) {
+	out := new(PreviewUpdateManagedGroupResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/PreviewUpdateManagedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) TouchManagedGroup(ctx context.Context, in *TouchManagedGroupRequest, opts ...grpc.CallOption) (*TouchManagedGroupResponse, error) {
+	out := new(TouchManagedGroupResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/TouchManagedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managedGroupServiceClient) DeleteManagedGroup(ctx context.Context, in *DeleteManagedGroupRequest, opts ...grpc.CallOption) (*DeleteManagedGroupResponse, error) {
 	out := new(DeleteManagedGroupResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/DeleteManagedGroup", in, out, opts...)
@@ -101,19 +361,255 @@ func (c *managedGroupServiceClient) DeleteManagedGroup(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *managedGroupServiceClient) UpsertManagedGroup(ctx context.Context, in *UpsertManagedGroupRequest, opts ...grpc.CallOption) (*UpsertManagedGroupResponse, error) {
+	out := new(UpsertManagedGroupResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/UpsertManagedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) PreviewManagedGroupMatches(ctx context.Context, in *PreviewManagedGroupMatchesRequest, opts ...grpc.CallOption) (*PreviewManagedGroupMatchesResponse, error) {
+	out := new(PreviewManagedGroupMatchesResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/PreviewManagedGroupMatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) GetManagedGroupFilterCapabilities(ctx context.Context, in *GetManagedGroupFilterCapabilitiesRequest, opts ...grpc.CallOption) (*GetManagedGroupFilterCapabilitiesResponse, error) {
+	out := new(GetManagedGroupFilterCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/GetManagedGroupFilterCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) ExportManagedGroups(ctx context.Context, in *ExportManagedGroupsRequest, opts ...grpc.CallOption) (*ExportManagedGroupsResponse, error) {
+	out := new(ExportManagedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ExportManagedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) ImportManagedGroups(ctx context.Context, in *ImportManagedGroupsRequest, opts ...grpc.CallOption) (*ImportManagedGroupsResponse, error) {
+	out := new(ImportManagedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ImportManagedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) RefreshAuthMethodManagedGroups(ctx context.Context, in *RefreshAuthMethodManagedGroupsRequest, opts ...grpc.CallOption) (*RefreshAuthMethodManagedGroupsResponse, error) {
+	out := new(RefreshAuthMethodManagedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/RefreshAuthMethodManagedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) GetManagedGroupGrants(ctx context.Context, in *GetManagedGroupGrantsRequest, opts ...grpc.CallOption) (*GetManagedGroupGrantsResponse, error) {
+	out := new(GetManagedGroupGrantsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/GetManagedGroupGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) GetManagedGroupHistory(ctx context.Context, in *GetManagedGroupHistoryRequest, opts ...grpc.CallOption) (*GetManagedGroupHistoryResponse, error) {
+	out := new(GetManagedGroupHistoryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/GetManagedGroupHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) DiffManagedGroupVersions(ctx context.Context, in *DiffManagedGroupVersionsRequest, opts ...grpc.CallOption) (*DiffManagedGroupVersionsResponse, error) {
+	out := new(DiffManagedGroupVersionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/DiffManagedGroupVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) CreateManagedGroupTemplate(ctx context.Context, in *CreateManagedGroupTemplateRequest, opts ...grpc.CallOption) (*CreateManagedGroupTemplateResponse, error) {
+	out := new(CreateManagedGroupTemplateResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/CreateManagedGroupTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) ListManagedGroupTemplates(ctx context.Context, in *ListManagedGroupTemplatesRequest, opts ...grpc.CallOption) (*ListManagedGroupTemplatesResponse, error) {
+	out := new(ListManagedGroupTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ListManagedGroupTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) DeleteManagedGroupTemplate(ctx context.Context, in *DeleteManagedGroupTemplateRequest, opts ...grpc.CallOption) (*DeleteManagedGroupTemplateResponse, error) {
+	out := new(DeleteManagedGroupTemplateResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/DeleteManagedGroupTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) CreateManagedGroupFromTemplate(ctx context.Context, in *CreateManagedGroupFromTemplateRequest, opts ...grpc.CallOption) (*CreateManagedGroupFromTemplateResponse, error) {
+	out := new(CreateManagedGroupFromTemplateResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/CreateManagedGroupFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) ReplaceInFilters(ctx context.Context, in *ReplaceInFiltersRequest, opts ...grpc.CallOption) (*ReplaceInFiltersResponse, error) {
+	out := new(ReplaceInFiltersResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ReplaceInFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) ValidateStoredFilters(ctx context.Context, in *ValidateStoredFiltersRequest, opts ...grpc.CallOption) (*ValidateStoredFiltersResponse, error) {
+	out := new(ValidateStoredFiltersResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ValidateStoredFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) AddManagedGroupToRoles(ctx context.Context, in *AddManagedGroupToRolesRequest, opts ...grpc.CallOption) (*AddManagedGroupToRolesResponse, error) {
+	out := new(AddManagedGroupToRolesResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/AddManagedGroupToRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) AuthorizeManagedGroup(ctx context.Context, in *AuthorizeManagedGroupRequest, opts ...grpc.CallOption) (*AuthorizeManagedGroupResponse, error) {
+	out := new(AuthorizeManagedGroupResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/AuthorizeManagedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) CountManagedGroups(ctx context.Context, in *CountManagedGroupsRequest, opts ...grpc.CallOption) (*CountManagedGroupsResponse, error) {
+	out := new(CountManagedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/CountManagedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) GenerateManagedGroupMembershipReport(ctx context.Context, in *GenerateManagedGroupMembershipReportRequest, opts ...grpc.CallOption) (ManagedGroupService_GenerateManagedGroupMembershipReportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagedGroupService_ServiceDesc.Streams[1], "/controller.api.services.v1.ManagedGroupService/GenerateManagedGroupMembershipReport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managedGroupServiceGenerateManagedGroupMembershipReportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagedGroupService_GenerateManagedGroupMembershipReportClient interface {
+	Recv() (*GenerateManagedGroupMembershipReportResponse, error)
+	grpc.ClientStream
+}
+
+type managedGroupServiceGenerateManagedGroupMembershipReportClient struct {
+	grpc.ClientStream
+}
+
+func (x *managedGroupServiceGenerateManagedGroupMembershipReportClient) Recv() (*GenerateManagedGroupMembershipReportResponse, error) {
+	m := new(GenerateManagedGroupMembershipReportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managedGroupServiceClient) ListManagedGroupMembers(ctx context.Context, in *ListManagedGroupMembersRequest, opts ...grpc.CallOption) (*ListManagedGroupMembersResponse, error) {
+	out := new(ListManagedGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/ListManagedGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managedGroupServiceClient) SetManagedGroupsEnabled(ctx context.Context, in *SetManagedGroupsEnabledRequest, opts ...grpc.CallOption) (*SetManagedGroupsEnabledResponse, error) {
+	out := new(SetManagedGroupsEnabledResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ManagedGroupService/SetManagedGroupsEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagedGroupServiceServer is the server API for ManagedGroupService service.
 // All implementations must embed UnimplementedManagedGroupServiceServer
 // for forward compatibility
 type ManagedGroupServiceServer interface {
 	// GetManagedGroup returns a stored ManagedGroup if present. The provided request must
-	// include the id for the ManagedGroup be retrieved. If missing, malformed or
-	// referencing a non existing ManagedGroup an error is returned.
+	// include either the id for the ManagedGroup be retrieved, or the Auth Method
+	// id and the name of the ManagedGroup. If missing, malformed or
+	// referencing a non existing ManagedGroup an error is returned. With an OIDC
+	// Auth Method id the id may be a prefix of the ManagedGroup's id, e.g. one
+	// that was truncated, as long as no other ManagedGroup in the Auth Method
+	// has an id with that prefix.
 	GetManagedGroup(context.Context, *GetManagedGroupRequest) (*GetManagedGroupResponse, error)
+	// BatchGetManagedGroups returns the stored ManagedGroups with the provided
+	// ids, which may belong to different Auth Methods, looking them up together
+	// rather than one at a time. Each ManagedGroup is only returned when the
+	// caller is granted the read action on it. The ids of the others, whether
+	// they don't exist or the caller can't read them, are returned as
+	// missing_ids so the two can't be told apart.
+	BatchGetManagedGroups(context.Context, *BatchGetManagedGroupsRequest) (*BatchGetManagedGroupsResponse, error)
 	// ListManagedGroups returns a list of stored ManagedGroups which exist inside the
 	// provided Auth Method. The request must include the Auth Method id which
 	// contains the ManagedGroups being listed. If missing or malformed an error
 	// is returned.
 	ListManagedGroups(context.Context, *ListManagedGroupsRequest) (*ListManagedGroupsResponse, error)
+	// StreamManagedGroups sends the ManagedGroups of an Auth Method one at a
+	// time, ordered by id, as each is authorized and matched against the
+	// filter, so exporting a large Auth Method doesn't build one large
+	// response. The list action is authorized and ManagedGroups are pruned
+	// the same way ListManagedGroups does.
+	StreamManagedGroups(*StreamManagedGroupsRequest, ManagedGroupService_StreamManagedGroupsServer) error
+	// ListManagedGroupsByMember returns the ManagedGroups the provided Account
+	// currently is a member of, ordered by id. The list action is authorized in
+	// the Auth Method of the Account, and only the ManagedGroups the caller is
+	// authorized to see are returned. If more ManagedGroups remain than the
+	// page_size the response's next_page_token is set, which is provided as
+	// page_token to list the next page.
+	ListManagedGroupsByMember(context.Context, *ListManagedGroupsByMemberRequest) (*ListManagedGroupsByMemberResponse, error)
 	// CreateManagedGroup creates and stores a ManagedGroup. The provided request
 	// must include the Auth Method ID in which the ManagedGroup will be created.
 	// If the Auth Method ID is missing, malformed, or references a non existing
@@ -128,11 +624,179 @@ type ManagedGroupServiceServer interface {
 	// provided ManagedGroup. An error is returned if the ManagedGroup id is
 	// missing or references a non-existing resource. An error is also returned if
 	// the request attempts to update the name to one that is already in use in
-	// the containing Auth Method.
+	// the containing Auth Method. If preview is set the update is computed but
+	// not applied, and the response also holds the changes to the masked fields.
 	UpdateManagedGroup(context.Context, *UpdateManagedGroupRequest) (*UpdateManagedGroupResponse, error)
+	// PreviewUpdateManagedGroup computes an update of an existing ManagedGroup
+	// as UpdateManagedGroup does, without applying it. The response holds the
+	// ManagedGroup as it would be after the update and the changes to the masked
+	// fields. Previewing requires the update action on the ManagedGroup, which
+	// must be at the provided version, and fails as the update would, for
+	// example if the name is already in use in the containing Auth Method.
+	PreviewUpdateManagedGroup(context.Context, *PreviewUpdateManagedGroupRequest) (*PreviewUpdateManagedGroupResponse, error)
+	// TouchManagedGroup increments the version and sets the updated_time of a
+	// ManagedGroup without changing any of its other fields, so that clients
+	// holding a copy of it know to fetch it again. The request must include the
+	// current version of the ManagedGroup. Touching requires the update action
+	// on the ManagedGroup.
+	TouchManagedGroup(context.Context, *TouchManagedGroupRequest) (*TouchManagedGroupResponse, error)
 	// DeleteManagedGroup removes a ManagedGroup. If the provided ManagedGroup Id
-	// is malformed or not provided an error is returned.
+	// is malformed or not provided an error is returned. If the ManagedGroup is
+	// a principal in any roles an error listing those roles is returned unless
+	// force is set, in which case it is also removed from those roles.
 	DeleteManagedGroup(context.Context, *DeleteManagedGroupRequest) (*DeleteManagedGroupResponse, error)
+	// UpsertManagedGroup creates a ManagedGroup with the provided name in the
+	// provided Auth Method, or updates the attributes, and the description if
+	// one is provided, of the ManagedGroup with that name if one already
+	// exists. Creating requires the create action on the Auth Method and
+	// updating the update action on the ManagedGroup. The ManagedGroup is
+	// updated at the version it was authorized at, so the request must not
+	// include a version and fails if it is changed concurrently. The name and
+	// Auth Method ID are required. The response reports whether the
+	// ManagedGroup was created.
+	UpsertManagedGroup(context.Context, *UpsertManagedGroupRequest) (*UpsertManagedGroupResponse, error)
+	// PreviewManagedGroupMatches evaluates the filter of every ManagedGroup in
+	// the provided OIDC Auth Method against the provided claims and returns the
+	// IDs of the ManagedGroups an account with those claims would be a member
+	// of. The claims are evaluated the same way as at login, so ID Token claims
+	// belong under "token" and UserInfo claims under "userinfo". Nothing is
+	// persisted. If the Auth Method has more ManagedGroups than can be
+	// evaluated in a single request only the first ones are evaluated and
+	// truncated is set.
+	PreviewManagedGroupMatches(context.Context, *PreviewManagedGroupMatchesRequest) (*PreviewManagedGroupMatchesResponse, error)
+	// GetManagedGroupFilterCapabilities returns what the filters of the
+	// ManagedGroups in the provided Auth Method can be written with: the filter
+	// operators, the prefixes of the selectors which reference claims at login
+	// and the claim aliases configured on the Auth Method. Only OIDC
+	// ManagedGroups have filters, so for other Auth Methods filters_supported
+	// is false and nothing else is set.
+	GetManagedGroupFilterCapabilities(context.Context, *GetManagedGroupFilterCapabilitiesRequest) (*GetManagedGroupFilterCapabilitiesResponse, error)
+	// ExportManagedGroups returns every ManagedGroup in the provided Auth Method
+	// as a portable document which can be imported into another Auth Method of
+	// the same type. The document only holds the name, description and
+	// attributes of each ManagedGroup, not its id, version or timestamps, and
+	// its ManagedGroups are ordered by name.
+	ExportManagedGroups(context.Context, *ExportManagedGroupsRequest) (*ExportManagedGroupsResponse, error)
+	// ImportManagedGroups creates the ManagedGroups of a document returned by
+	// ExportManagedGroups in the provided Auth Method, which must be of the same
+	// type as the one the document was exported from. Each ManagedGroup is
+	// validated and created on its own and the outcome for each is reported, so
+	// a ManagedGroup which fails doesn't prevent the others from being created.
+	// With skip_existing, ManagedGroups whose name is already in use in the Auth
+	// Method are skipped. With dry_run nothing is created and the outcome which
+	// an import would have is reported.
+	ImportManagedGroups(context.Context, *ImportManagedGroupsRequest) (*ImportManagedGroupsResponse, error)
+	// RefreshAuthMethodManagedGroups re-evaluates the filter of every
+	// ManagedGroup in the provided OIDC Auth Method against the claims each of
+	// its Accounts presented when they last logged in, and updates the
+	// memberships of the ManagedGroups to match in a single transaction. Only
+	// the ManagedGroups whose memberships change have their version
+	// incremented. It returns how many memberships were added to and removed
+	// from each of them. Refreshing requires the list action on the Auth
+	// Method's ManagedGroups, and refreshes only the ManagedGroups the caller
+	// can update; if it can't update any of them an error is returned.
+	RefreshAuthMethodManagedGroups(context.Context, *RefreshAuthMethodManagedGroupsRequest) (*RefreshAuthMethodManagedGroupsResponse, error)
+	// GetManagedGroupGrants returns the grants of every Role the ManagedGroup
+	// is a principal in, which flow to the Users whose Accounts are members of
+	// the ManagedGroup. Each grant is returned with the Role it comes from and
+	// the scope that Role grants in.
+	GetManagedGroupGrants(context.Context, *GetManagedGroupGrantsRequest) (*GetManagedGroupGrantsResponse, error)
+	// GetManagedGroupHistory returns the versions of an OIDC ManagedGroup which
+	// were replaced by updates, newest first, each with when it was replaced
+	// and by whom. The current version is returned by GetManagedGroup.
+	GetManagedGroupHistory(context.Context, *GetManagedGroupHistoryRequest) (*GetManagedGroupHistoryResponse, error)
+	// DiffManagedGroupVersions compares two versions of an OIDC ManagedGroup,
+	// each either one returned by GetManagedGroupHistory or the current
+	// version. It returns the name, description and attribute fields which
+	// were added, removed or changed going from from_version to to_version,
+	// and the difference between the two filters as a sequence of token
+	// segments. Comparing requires the read action on the ManagedGroup.
+	DiffManagedGroupVersions(context.Context, *DiffManagedGroupVersionsRequest) (*DiffManagedGroupVersionsResponse, error)
+	// CreateManagedGroupTemplate stores a reusable definition of an OIDC
+	// ManagedGroup in a scope. Its name, description and filter can reference
+	// the id of the Auth Method a ManagedGroup is created in as
+	// {{auth_method_id}}. The filter is validated with an example Auth Method
+	// id. Creating requires the create action on ManagedGroups in the scope.
+	CreateManagedGroupTemplate(context.Context, *CreateManagedGroupTemplateRequest) (*CreateManagedGroupTemplateResponse, error)
+	// ListManagedGroupTemplates returns the ManagedGroupTemplates of a scope
+	// ordered by name. Listing requires the list action on ManagedGroups in the
+	// scope.
+	ListManagedGroupTemplates(context.Context, *ListManagedGroupTemplatesRequest) (*ListManagedGroupTemplatesResponse, error)
+	// DeleteManagedGroupTemplate removes a ManagedGroupTemplate. ManagedGroups
+	// created from it are not changed. Deleting requires the delete action on
+	// ManagedGroups in the template's scope.
+	DeleteManagedGroupTemplate(context.Context, *DeleteManagedGroupTemplateRequest) (*DeleteManagedGroupTemplateResponse, error)
+	// CreateManagedGroupFromTemplate creates an OIDC ManagedGroup in the
+	// provided Auth Method from a ManagedGroupTemplate of the Auth Method's
+	// scope, with {{auth_method_id}} replaced by the Auth Method's id. The
+	// resulting ManagedGroup is validated and created as by
+	// CreateManagedGroup, which authorizes it the same way.
+	CreateManagedGroupFromTemplate(context.Context, *CreateManagedGroupFromTemplateRequest) (*CreateManagedGroupFromTemplateResponse, error)
+	// ReplaceInFilters replaces a selector prefix, such as "/token/groups", in
+	// the filter of every ManagedGroup in the provided OIDC Auth Method. Only
+	// selectors written as JSON pointers whose path starts with whole segments
+	// of the prefix are rewritten. Each rewritten filter is validated before it
+	// is saved, and a ManagedGroup whose filter can't be rewritten safely or
+	// becomes invalid fails on its own without preventing the others from
+	// being updated. Unless apply is set nothing is updated and the changes
+	// which would be made are reported. Replacing requires the update action on
+	// all ManagedGroups of the Auth Method.
+	ReplaceInFilters(context.Context, *ReplaceInFiltersRequest) (*ReplaceInFiltersResponse, error)
+	// ValidateStoredFilters compiles the stored filter of every ManagedGroup in
+	// the provided OIDC Auth Method, or in every OIDC Auth Method of the
+	// provided scope, the way it is compiled at login, and reports the
+	// ManagedGroups whose filter fails to compile. It is meant to find filters
+	// which were valid when they were written but no longer are, e.g. after an
+	// upgrade changed the filter grammar. Nothing is modified. Validating
+	// requires the read action on all ManagedGroups of the Auth Method or scope.
+	// A scope with more Auth Methods than the controller is configured to cover
+	// in one request is validated in parts: the response is truncated and its
+	// next_after_auth_method_id is provided as after_auth_method_id to validate
+	// the next part.
+	ValidateStoredFilters(context.Context, *ValidateStoredFiltersRequest) (*ValidateStoredFiltersResponse, error)
+	// AddManagedGroupToRoles adds a ManagedGroup as a principal to each of the
+	// provided Roles. A Role which doesn't exist or on which the caller isn't
+	// granted the add-principals action fails on its own, and a Role the
+	// ManagedGroup already is a principal in is left unchanged. The ManagedGroup
+	// is added to all of the other Roles in a single transaction, so either
+	// every one of them is updated or, if any of them can't be, none are.
+	// Adding requires the update action on the ManagedGroup.
+	AddManagedGroupToRoles(context.Context, *AddManagedGroupToRolesRequest) (*AddManagedGroupToRolesResponse, error)
+	// AuthorizeManagedGroup reports whether the caller is authorized to perform
+	// the provided action on the ManagedGroup without performing it and without
+	// returning the ManagedGroup. Answering only requires the no-op action to be
+	// granted on the ManagedGroup.
+	AuthorizeManagedGroup(context.Context, *AuthorizeManagedGroupRequest) (*AuthorizeManagedGroupResponse, error)
+	// CountManagedGroups returns the number of ManagedGroups in each Auth
+	// Method of the provided scope, and of its child scopes when recursive is
+	// set, without listing them. Each Auth Method is only counted when the
+	// caller is granted the list action on its ManagedGroups; the others are
+	// left out of the response.
+	CountManagedGroups(context.Context, *CountManagedGroupsRequest) (*CountManagedGroupsResponse, error)
+	// GenerateManagedGroupMembershipReport sends the members of each
+	// ManagedGroup of an Auth Method, one ManagedGroup at a time ordered by id,
+	// for access reviews. Memberships are read as currently recorded; filters
+	// aren't evaluated again. ManagedGroups without members aren't sent.
+	// The report is for scope admins: it requires read on every ManagedGroup
+	// of the Auth Method, e.g. id=*;type=managed-group;actions=read, and update
+	// on the Auth Method itself, e.g. id=*;type=auth-method;actions=update.
+	GenerateManagedGroupMembershipReport(*GenerateManagedGroupMembershipReportRequest, ManagedGroupService_GenerateManagedGroupMembershipReportServer) error
+	// ListManagedGroupMembers returns the members of a ManagedGroup ordered by
+	// the time they became members, each with the source of its membership.
+	// Listing requires the read action on the ManagedGroup. If more members
+	// remain than the page_size the response's next_page_token is set, which is
+	// provided as page_token to list the next page.
+	ListManagedGroupMembers(context.Context, *ListManagedGroupMembersRequest) (*ListManagedGroupMembersResponse, error)
+	// SetManagedGroupsEnabled enables or disables each of the provided OIDC
+	// ManagedGroups, which may belong to different Auth Methods. Each
+	// ManagedGroup must be at the provided version. A ManagedGroup which
+	// doesn't exist, isn't at its version or on which the caller isn't granted
+	// the update action fails on its own, and one which already is in the
+	// requested state is left unchanged. The other ManagedGroups are updated in
+	// a single transaction, so either every one of them is updated or, if any
+	// of them can't be, none are. It is the bulk form of updating
+	// attributes.disabled.
+	SetManagedGroupsEnabled(context.Context, *SetManagedGroupsEnabledRequest) (*SetManagedGroupsEnabledResponse, error)
 	mustEmbedUnimplementedManagedGroupServiceServer()
 }
 
@@ -143,18 +807,96 @@ type UnimplementedManagedGroupServiceServer struct {
 func (UnimplementedManagedGroupServiceServer) GetManagedGroup(context.Context, *GetManagedGroupRequest) (*GetManagedGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetManagedGroup not implemented")
 }
+func (UnimplementedManagedGroupServiceServer) BatchGetManagedGroups(context.Context, *BatchGetManagedGroupsRequest) (*BatchGetManagedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetManagedGroups not implemented")
+}
 func (UnimplementedManagedGroupServiceServer) ListManagedGroups(context.Context, *ListManagedGroupsRequest) (*ListManagedGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListManagedGroups not implemented")
 }
+func (UnimplementedManagedGroupServiceServer) StreamManagedGroups(*StreamManagedGroupsRequest, ManagedGroupService_StreamManagedGroupsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamManagedGroups not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ListManagedGroupsByMember(context.Context, *ListManagedGroupsByMemberRequest) (*ListManagedGroupsByMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManagedGroupsByMember not implemented")
+}
 func (UnimplementedManagedGroupServiceServer) CreateManagedGroup(context.Context, *CreateManagedGroupRequest) (*CreateManagedGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateManagedGroup not implemented")
 }
 func (UnimplementedManagedGroupServiceServer) UpdateManagedGroup(context.Context, *UpdateManagedGroupRequest) (*UpdateManagedGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateManagedGroup not implemented")
 }
+func (UnimplementedManagedGroupServiceServer) PreviewUpdateManagedGroup(context.Context, *PreviewUpdateManagedGroupRequest) (*PreviewUpdateManagedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewUpdateManagedGroup not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) TouchManagedGroup(context.Context, *TouchManagedGroupRequest) (*TouchManagedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchManagedGroup not implemented")
+}
 func (UnimplementedManagedGroupServiceServer) DeleteManagedGroup(context.Context, *DeleteManagedGroupRequest) (*DeleteManagedGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteManagedGroup not implemented")
 }
+func (UnimplementedManagedGroupServiceServer) UpsertManagedGroup(context.Context, *UpsertManagedGroupRequest) (*UpsertManagedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertManagedGroup not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) PreviewManagedGroupMatches(context.Context, *PreviewManagedGroupMatchesRequest) (*PreviewManagedGroupMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewManagedGroupMatches not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) GetManagedGroupFilterCapabilities(context.Context, *GetManagedGroupFilterCapabilitiesRequest) (*GetManagedGroupFilterCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedGroupFilterCapabilities not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ExportManagedGroups(context.Context, *ExportManagedGroupsRequest) (*ExportManagedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportManagedGroups not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ImportManagedGroups(context.Context, *ImportManagedGroupsRequest) (*ImportManagedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportManagedGroups not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) RefreshAuthMethodManagedGroups(context.Context, *RefreshAuthMethodManagedGroupsRequest) (*RefreshAuthMethodManagedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAuthMethodManagedGroups not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) GetManagedGroupGrants(context.Context, *GetManagedGroupGrantsRequest) (*GetManagedGroupGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedGroupGrants not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) GetManagedGroupHistory(context.Context, *GetManagedGroupHistoryRequest) (*GetManagedGroupHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedGroupHistory not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) DiffManagedGroupVersions(context.Context, *DiffManagedGroupVersionsRequest) (*DiffManagedGroupVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffManagedGroupVersions not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) CreateManagedGroupTemplate(context.Context, *CreateManagedGroupTemplateRequest) (*CreateManagedGroupTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManagedGroupTemplate not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ListManagedGroupTemplates(context.Context, *ListManagedGroupTemplatesRequest) (*ListManagedGroupTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManagedGroupTemplates not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) DeleteManagedGroupTemplate(context.Context, *DeleteManagedGroupTemplateRequest) (*DeleteManagedGroupTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteManagedGroupTemplate not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) CreateManagedGroupFromTemplate(context.Context, *CreateManagedGroupFromTemplateRequest) (*CreateManagedGroupFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManagedGroupFromTemplate not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ReplaceInFilters(context.Context, *ReplaceInFiltersRequest) (*ReplaceInFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceInFilters not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ValidateStoredFilters(context.Context, *ValidateStoredFiltersRequest) (*ValidateStoredFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateStoredFilters not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) AddManagedGroupToRoles(context.Context, *AddManagedGroupToRolesRequest) (*AddManagedGroupToRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddManagedGroupToRoles not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) AuthorizeManagedGroup(context.Context, *AuthorizeManagedGroupRequest) (*AuthorizeManagedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeManagedGroup not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) CountManagedGroups(context.Context, *CountManagedGroupsRequest) (*CountManagedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountManagedGroups not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) GenerateManagedGroupMembershipReport(*GenerateManagedGroupMembershipReportRequest, ManagedGroupService_GenerateManagedGroupMembershipReportServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManagedGroupMembershipReport not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) ListManagedGroupMembers(context.Context, *ListManagedGroupMembersRequest) (*ListManagedGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManagedGroupMembers not implemented")
+}
+func (UnimplementedManagedGroupServiceServer) SetManagedGroupsEnabled(context.Context, *SetManagedGroupsEnabledRequest) (*SetManagedGroupsEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetManagedGroupsEnabled not implemented")
+}
 func (UnimplementedManagedGroupServiceServer) mustEmbedUnimplementedManagedGroupServiceServer() {}
 
 // UnsafeManagedGroupServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -186,6 +928,24 @@ func _ManagedGroupService_GetManagedGroup_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagedGroupService_BatchGetManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetManagedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).BatchGetManagedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/BatchGetManagedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).BatchGetManagedGroups(ctx, req.(*BatchGetManagedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagedGroupService_ListManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListManagedGroupsRequest)
 	if err := dec(in); err != nil {
@@ -204,6 +964,45 @@ func _ManagedGroupService_ListManagedGroups_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagedGroupService_StreamManagedGroups_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamManagedGroupsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagedGroupServiceServer).StreamManagedGroups(m, &managedGroupServiceStreamManagedGroupsServer{stream})
+}
+
+type ManagedGroupService_StreamManagedGroupsServer interface {
+	Send(*StreamManagedGroupsResponse) error
+	grpc.ServerStream
+}
+
+type managedGroupServiceStreamManagedGroupsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managedGroupServiceStreamManagedGroupsServer) Send(m *StreamManagedGroupsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagedGroupService_ListManagedGroupsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManagedGroupsByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupsByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ListManagedGroupsByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupsByMember(ctx, req.(*ListManagedGroupsByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagedGroupService_CreateManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateManagedGroupRequest)
 	if err := dec(in); err != nil {
@@ -240,39 +1039,464 @@ func _ManagedGroupService_UpdateManagedGroup_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagedGroupService_DeleteManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteManagedGroupRequest)
+func _ManagedGroupService_PreviewUpdateManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewUpdateManagedGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagedGroupServiceServer).DeleteManagedGroup(ctx, in)
+		return srv.(ManagedGroupServiceServer).PreviewUpdateManagedGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/controller.api.services.v1.ManagedGroupService/DeleteManagedGroup",
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/PreviewUpdateManagedGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagedGroupServiceServer).DeleteManagedGroup(ctx, req.(*DeleteManagedGroupRequest))
+		return srv.(ManagedGroupServiceServer).PreviewUpdateManagedGroup(ctx, req.(*PreviewUpdateManagedGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ManagedGroupService_ServiceDesc is the grpc.ServiceDesc for ManagedGroupService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ManagedGroupService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "controller.api.services.v1.ManagedGroupService",
-	HandlerType: (*ManagedGroupServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetManagedGroup",
-			Handler:    _ManagedGroupService_GetManagedGroup_Handler,
-		},
-		{
+func _ManagedGroupService_TouchManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchManagedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).TouchManagedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/TouchManagedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).TouchManagedGroup(ctx, req.(*TouchManagedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_DeleteManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManagedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).DeleteManagedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/DeleteManagedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).DeleteManagedGroup(ctx, req.(*DeleteManagedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_UpsertManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertManagedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).UpsertManagedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/UpsertManagedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).UpsertManagedGroup(ctx, req.(*UpsertManagedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_PreviewManagedGroupMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewManagedGroupMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).PreviewManagedGroupMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/PreviewManagedGroupMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).PreviewManagedGroupMatches(ctx, req.(*PreviewManagedGroupMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_GetManagedGroupFilterCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedGroupFilterCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupFilterCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/GetManagedGroupFilterCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupFilterCapabilities(ctx, req.(*GetManagedGroupFilterCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_ExportManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportManagedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ExportManagedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ExportManagedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ExportManagedGroups(ctx, req.(*ExportManagedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_ImportManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportManagedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ImportManagedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ImportManagedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ImportManagedGroups(ctx, req.(*ImportManagedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_RefreshAuthMethodManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAuthMethodManagedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).RefreshAuthMethodManagedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/RefreshAuthMethodManagedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).RefreshAuthMethodManagedGroups(ctx, req.(*RefreshAuthMethodManagedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_GetManagedGroupGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedGroupGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/GetManagedGroupGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupGrants(ctx, req.(*GetManagedGroupGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_GetManagedGroupHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedGroupHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/GetManagedGroupHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).GetManagedGroupHistory(ctx, req.(*GetManagedGroupHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_DiffManagedGroupVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffManagedGroupVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).DiffManagedGroupVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/DiffManagedGroupVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).DiffManagedGroupVersions(ctx, req.(*DiffManagedGroupVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_CreateManagedGroupTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManagedGroupTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).CreateManagedGroupTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/CreateManagedGroupTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).CreateManagedGroupTemplate(ctx, req.(*CreateManagedGroupTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_ListManagedGroupTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManagedGroupTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ListManagedGroupTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupTemplates(ctx, req.(*ListManagedGroupTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_DeleteManagedGroupTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManagedGroupTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).DeleteManagedGroupTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/DeleteManagedGroupTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).DeleteManagedGroupTemplate(ctx, req.(*DeleteManagedGroupTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_CreateManagedGroupFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManagedGroupFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).CreateManagedGroupFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/CreateManagedGroupFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).CreateManagedGroupFromTemplate(ctx, req.(*CreateManagedGroupFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_ReplaceInFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceInFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ReplaceInFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ReplaceInFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ReplaceInFilters(ctx, req.(*ReplaceInFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_ValidateStoredFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateStoredFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ValidateStoredFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ValidateStoredFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ValidateStoredFilters(ctx, req.(*ValidateStoredFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_AddManagedGroupToRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddManagedGroupToRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).AddManagedGroupToRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/AddManagedGroupToRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).AddManagedGroupToRoles(ctx, req.(*AddManagedGroupToRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_AuthorizeManagedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeManagedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).AuthorizeManagedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/AuthorizeManagedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).AuthorizeManagedGroup(ctx, req.(*AuthorizeManagedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_CountManagedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountManagedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).CountManagedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/CountManagedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).CountManagedGroups(ctx, req.(*CountManagedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_GenerateManagedGroupMembershipReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateManagedGroupMembershipReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagedGroupServiceServer).GenerateManagedGroupMembershipReport(m, &managedGroupServiceGenerateManagedGroupMembershipReportServer{stream})
+}
+
+type ManagedGroupService_GenerateManagedGroupMembershipReportServer interface {
+	Send(*GenerateManagedGroupMembershipReportResponse) error
+	grpc.ServerStream
+}
+
+type managedGroupServiceGenerateManagedGroupMembershipReportServer struct {
+	grpc.ServerStream
+}
+
+func (x *managedGroupServiceGenerateManagedGroupMembershipReportServer) Send(m *GenerateManagedGroupMembershipReportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagedGroupService_ListManagedGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManagedGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/ListManagedGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).ListManagedGroupMembers(ctx, req.(*ListManagedGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagedGroupService_SetManagedGroupsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetManagedGroupsEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagedGroupServiceServer).SetManagedGroupsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ManagedGroupService/SetManagedGroupsEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagedGroupServiceServer).SetManagedGroupsEnabled(ctx, req.(*SetManagedGroupsEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ManagedGroupService_ServiceDesc is the grpc.ServiceDesc for ManagedGroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ManagedGroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.ManagedGroupService",
+	HandlerType: (*ManagedGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetManagedGroup",
+			Handler:    _ManagedGroupService_GetManagedGroup_Handler,
+		},
+		{
+			MethodName: "BatchGetManagedGroups",
+			Handler:    _ManagedGroupService_BatchGetManagedGroups_Handler,
+		},
+		{
 			MethodName: "ListManagedGroups",
 			Handler:    _ManagedGroupService_ListManagedGroups_Handler,
 		},
+		{
+			MethodName: "ListManagedGroupsByMember",
+			Handler:    _ManagedGroupService_ListManagedGroupsByMember_Handler,
+		},
 		{
 			MethodName: "CreateManagedGroup",
 			Handler:    _ManagedGroupService_CreateManagedGroup_Handler,
@@ -281,11 +1505,110 @@ var ManagedGroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateManagedGroup",
 			Handler:    _ManagedGroupService_UpdateManagedGroup_Handler,
 		},
+		{
+			MethodName: "PreviewUpdateManagedGroup",
+			Handler:    _ManagedGroupService_PreviewUpdateManagedGroup_Handler,
+		},
+		{
+			MethodName: "TouchManagedGroup",
+			Handler:    _ManagedGroupService_TouchManagedGroup_Handler,
+		},
 		{
 			MethodName: "DeleteManagedGroup",
 			Handler:    _ManagedGroupService_DeleteManagedGroup_Handler,
 		},
+		{
+			MethodName: "UpsertManagedGroup",
+			Handler:    _ManagedGroupService_UpsertManagedGroup_Handler,
+		},
+		{
+			MethodName: "PreviewManagedGroupMatches",
+			Handler:    _ManagedGroupService_PreviewManagedGroupMatches_Handler,
+		},
+		{
+			MethodName: "GetManagedGroupFilterCapabilities",
+			Handler:    _ManagedGroupService_GetManagedGroupFilterCapabilities_Handler,
+		},
+		{
+			MethodName: "ExportManagedGroups",
+			Handler:    _ManagedGroupService_ExportManagedGroups_Handler,
+		},
+		{
+			MethodName: "ImportManagedGroups",
+			Handler:    _ManagedGroupService_ImportManagedGroups_Handler,
+		},
+		{
+			MethodName: "RefreshAuthMethodManagedGroups",
+			Handler:    _ManagedGroupService_RefreshAuthMethodManagedGroups_Handler,
+		},
+		{
+			MethodName: "GetManagedGroupGrants",
+			Handler:    _ManagedGroupService_GetManagedGroupGrants_Handler,
+		},
+		{
+			MethodName: "GetManagedGroupHistory",
+			Handler:    _ManagedGroupService_GetManagedGroupHistory_Handler,
+		},
+		{
+			MethodName: "DiffManagedGroupVersions",
+			Handler:    _ManagedGroupService_DiffManagedGroupVersions_Handler,
+		},
+		{
+			MethodName: "CreateManagedGroupTemplate",
+			Handler:    _ManagedGroupService_CreateManagedGroupTemplate_Handler,
+		},
+		{
+			MethodName: "ListManagedGroupTemplates",
+			Handler:    _ManagedGroupService_ListManagedGroupTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteManagedGroupTemplate",
+			Handler:    _ManagedGroupService_DeleteManagedGroupTemplate_Handler,
+		},
+		{
+			MethodName: "CreateManagedGroupFromTemplate",
+			Handler:    _ManagedGroupService_CreateManagedGroupFromTemplate_Handler,
+		},
+		{
+			MethodName: "ReplaceInFilters",
+			Handler:    _ManagedGroupService_ReplaceInFilters_Handler,
+		},
+		{
+			MethodName: "ValidateStoredFilters",
+			Handler:    _ManagedGroupService_ValidateStoredFilters_Handler,
+		},
+		{
+			MethodName: "AddManagedGroupToRoles",
+			Handler:    _ManagedGroupService_AddManagedGroupToRoles_Handler,
+		},
+		{
+			MethodName: "AuthorizeManagedGroup",
+			Handler:    _ManagedGroupService_AuthorizeManagedGroup_Handler,
+		},
+		{
+			MethodName: "CountManagedGroups",
+			Handler:    _ManagedGroupService_CountManagedGroups_Handler,
+		},
+		{
+			MethodName: "ListManagedGroupMembers",
+			Handler:    _ManagedGroupService_ListManagedGroupMembers_Handler,
+		},
+		{
+			MethodName: "SetManagedGroupsEnabled",
+			Handler:    _ManagedGroupService_SetManagedGroupsEnabled_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamManagedGroups",
+			Handler:       _ManagedGroupService_StreamManagedGroups_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GenerateManagedGroupMembershipReport",
+			Handler:       _ManagedGroupService_GenerateManagedGroupMembershipReport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/managed_group_service.proto",
 }
@@ -111,4 +111,40 @@ const (
 	select * from final
 	order by action, member_id;
 	`
+
+	// grantsForManagedGroupQuery returns the grants of every role the
+	// managed group is a principal in, along with the role and its grant
+	// scope, ordered by role id.
+	grantsForManagedGroupQuery = `
+	select iam_role.public_id            as role_id,
+	       iam_role.grant_scope_id       as scope_id,
+	       iam_role_grant.canonical_grant as grant
+	  from iam_managed_group_role
+	 inner
+	  join iam_role
+	    on iam_managed_group_role.role_id = iam_role.public_id
+	 inner
+	  join iam_role_grant
+	    on iam_role.public_id = iam_role_grant.role_id
+	 where iam_managed_group_role.principal_id = ?
+	 order by iam_role.public_id, iam_role_grant.canonical_grant;
+	`
+
+	// managedGroupRolesQuery returns the id and scope of every role each
+	// managed group of an auth method is a principal in, ordered by managed
+	// group and role id.
+	managedGroupRolesQuery = `
+	select iam_managed_group_role.principal_id,
+	       iam_role.public_id,
+	       iam_role.scope_id
+	  from iam_managed_group_role
+	 inner
+	  join iam_role
+	    on iam_managed_group_role.role_id = iam_role.public_id
+	 inner
+	  join auth_managed_group
+	    on iam_managed_group_role.principal_id = auth_managed_group.public_id
+	 where auth_managed_group.auth_method_id = ?
+	 order by iam_managed_group_role.principal_id, iam_role.public_id;
+	`
 )
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
 
 // AddPrincipalRoles provides the ability to add principals (userIds and
@@ -147,6 +148,162 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	return currentPrincipals, nil
 }
 
+// AddManagedGroupToRoles adds the managed group as a principal to each of the
+// roles in a single transaction, so it is either added to all of them or to
+// none of them. Each role's current db version must match its Version or an
+// error is returned. The number of roles the managed group was added to is
+// returned on success.
+func (r *Repository) AddManagedGroupToRoles(ctx context.Context, managedGroupId string, roles []*Role, _ ...Option) (int, error) {
+	const op = "iam.(Repository).AddManagedGroupToRoles"
+	if managedGroupId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	}
+	if len(roles) == 0 {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing roles")
+	}
+	var added int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var err error
+			added, err = AddManagedGroupToRolesTx(ctx, reader, w, r.kms, managedGroupId, roles)
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return added, nil
+}
+
+// AddManagedGroupToRolesTx adds the managed group as a principal to each of
+// the roles. Each role's current db version must match its Version or an
+// error is returned. The number of roles the managed group was added to is
+// returned on success. No options are currently supported.
+//
+// This function doesn't include its own transaction and is intended to be
+// used within a transaction provided by the caller, so that other
+// repositories can e.g. create a managed group and add it to roles
+// atomically.
+func AddManagedGroupToRolesTx(ctx context.Context, reader db.Reader, writer db.Writer, kmsCache kms.GetWrapperer, managedGroupId string, roles []*Role, _ ...Option) (int, error) {
+	const op = "iam.AddManagedGroupToRolesTx"
+	switch {
+	case reader == nil:
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case writer == nil:
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case kmsCache == nil:
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	case managedGroupId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	case len(roles) == 0:
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing roles")
+	}
+
+	type roleAdd struct {
+		roleId       string
+		roleVersion  uint32
+		scope        *Scope
+		oplogWrapper wrapping.Wrapper
+		mgRole       *ManagedGroupRole
+	}
+	adds := make([]roleAdd, 0, len(roles))
+	for _, role := range roles {
+		switch {
+		case role == nil || role.Role == nil:
+			return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role")
+		case role.GetPublicId() == "":
+			return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+		case role.GetVersion() == 0:
+			return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("missing version for role %s", role.GetPublicId()))
+		}
+		mgRole, err := NewManagedGroupRole(ctx, role.GetPublicId(), managedGroupId)
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role"))
+		}
+		scope, err := role.GetScope(ctx, reader)
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get role %s scope", role.GetPublicId())))
+		}
+		oplogWrapper, err := kmsCache.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+		}
+		adds = append(adds, roleAdd{
+			roleId:       role.GetPublicId(),
+			roleVersion:  role.GetVersion(),
+			scope:        scope,
+			oplogWrapper: oplogWrapper,
+			mgRole:       mgRole,
+		})
+	}
+
+	for _, a := range adds {
+		role := allocRole()
+		role.PublicId = a.roleId
+		roleTicket, err := writer.GetTicket(ctx, &role)
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+		}
+		updatedRole := allocRole()
+		updatedRole.PublicId = a.roleId
+		updatedRole.Version = a.roleVersion + 1
+		var roleOplogMsg oplog.Message
+		rowsUpdated, err := writer.Update(ctx, &updatedRole, []string{"Version"}, nil, db.NewOplogMsg(&roleOplogMsg), db.WithVersion(&a.roleVersion))
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update role %s version", a.roleId)))
+		}
+		if rowsUpdated != 1 {
+			return db.NoRowsAffected, errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated role %s and %d rows updated", a.roleId, rowsUpdated))
+		}
+		var mgRoleOplogMsg oplog.Message
+		if err := writer.Create(ctx, a.mgRole, db.NewOplogMsg(&mgRoleOplogMsg)); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to add managed group to role %s", a.roleId)))
+		}
+		metadata := oplog.Metadata{
+			"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+			"scope-id":           []string{a.scope.PublicId},
+			"scope-type":         []string{a.scope.Type},
+			"resource-public-id": []string{a.roleId},
+		}
+		if err := writer.WriteOplogEntryWith(ctx, a.oplogWrapper, roleTicket, metadata, []*oplog.Message{&roleOplogMsg, &mgRoleOplogMsg}); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+		}
+	}
+	return len(adds), nil
+}
+
+// ListManagedGroupRoles returns the roles each managed group of the auth
+// method is a principal in, keyed by the managed group's public id and ordered
+// by role id. Only the public id and scope id of the roles are set. Managed
+// groups which aren't a principal in any role are left out.
+func (r *Repository) ListManagedGroupRoles(ctx context.Context, authMethodId string, _ ...Option) (map[string][]*Role, error) {
+	const op = "iam.(Repository).ListManagedGroupRoles"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	rows, err := r.reader.Query(ctx, managedGroupRolesQuery, []any{authMethodId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	roles := make(map[string][]*Role)
+	for rows.Next() {
+		var id string
+		role := allocRole()
+		if err := rows.Scan(&id, &role.PublicId, &role.ScopeId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		roles[id] = append(roles[id], &role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return roles, nil
+}
+
 // SetPrincipalRoles will set the role's principals. Set add and/or delete
 // principals as need to reconcile the existing principals with the principals
 // requested. If both userIds and groupIds are empty, the principal roles will
@@ -201,6 +201,127 @@ func TestRepository_PrincipalsToSet(t *testing.T) {
 	})
 }
 
+func TestRepository_AddManagedGroupToRoles(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+
+	repo := iam.TestRepo(t, conn, wrap)
+	org, proj := iam.TestScopes(t, repo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+
+	t.Run("adds to every role", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		orgRole := iam.TestRole(t, conn, org.GetPublicId())
+		projRole := iam.TestRole(t, conn, proj.GetPublicId())
+
+		added, err := repo.AddManagedGroupToRoles(ctx, mg.GetPublicId(), []*iam.Role{orgRole, projRole})
+		require.NoError(err)
+		assert.Equal(2, added)
+		for _, r := range []*iam.Role{orgRole, projRole} {
+			got, principals, _, err := repo.LookupRole(ctx, r.GetPublicId())
+			require.NoError(err)
+			assert.Equal(r.GetVersion()+1, got.GetVersion())
+			require.Len(principals, 1)
+			assert.Equal(mg.GetPublicId(), principals[0].GetPrincipalId())
+		}
+	})
+	t.Run("adds to no role when one fails", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		orgRole := iam.TestRole(t, conn, org.GetPublicId())
+		staleRole := iam.TestRole(t, conn, proj.GetPublicId())
+		staleRole.Version++
+
+		_, err := repo.AddManagedGroupToRoles(ctx, mg.GetPublicId(), []*iam.Role{orgRole, staleRole})
+		require.Error(err)
+		_, principals, _, err := repo.LookupRole(ctx, orgRole.GetPublicId())
+		require.NoError(err)
+		assert.Empty(principals)
+	})
+	t.Run("invalid parameters", func(t *testing.T) {
+		assert := assert.New(t)
+		role := iam.TestRole(t, conn, org.GetPublicId())
+
+		_, err := repo.AddManagedGroupToRoles(ctx, "", []*iam.Role{role})
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.AddManagedGroupToRoles(ctx, mg.GetPublicId(), nil)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		noVersion := role.Clone().(*iam.Role)
+		noVersion.Version = 0
+		_, err = repo.AddManagedGroupToRoles(ctx, mg.GetPublicId(), []*iam.Role{noVersion})
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func TestRepository_ListManagedGroupRoles(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+
+	repo := iam.TestRepo(t, conn, wrap)
+	org, proj := iam.TestScopes(t, repo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	newAuthMethod := func(clientId string) *oidc.AuthMethod {
+		return oidc.TestAuthMethod(
+			t, conn, databaseWrapper, org.GetPublicId(), oidc.ActivePrivateState,
+			clientId, "fido",
+			oidc.WithSigningAlgs(oidc.RS256),
+			oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www."+clientId+".com")[0]),
+			oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www."+clientId+".com/callback")[0]),
+		)
+	}
+	authMethod := newAuthMethod("alice")
+	otherAuthMethod := newAuthMethod("bob")
+	twoRoles := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	oneRole := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	other := oidc.TestManagedGroup(t, conn, otherAuthMethod, oidc.TestFakeManagedGroupFilter)
+
+	orgRole := iam.TestRole(t, conn, org.GetPublicId())
+	projRole := iam.TestRole(t, conn, proj.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), twoRoles.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, projRole.GetPublicId(), twoRoles.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), oneRole.GetPublicId())
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), other.GetPublicId())
+
+	got, err := repo.ListManagedGroupRoles(ctx, authMethod.GetPublicId())
+	require.NoError(t, err)
+	gotRoles := make(map[string]map[string]string, len(got))
+	for id, roles := range got {
+		gotRoles[id] = make(map[string]string, len(roles))
+		for _, r := range roles {
+			gotRoles[id][r.GetPublicId()] = r.GetScopeId()
+		}
+	}
+	assert.Equal(t, map[string]map[string]string{
+		twoRoles.GetPublicId(): {orgRole.GetPublicId(): org.GetPublicId(), projRole.GetPublicId(): proj.GetPublicId()},
+		oneRole.GetPublicId():  {orgRole.GetPublicId(): org.GetPublicId()},
+	}, gotRoles)
+
+	_, err = repo.ListManagedGroupRoles(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
 func assertSetResults(t *testing.T, got *iam.PrincipalSet, wantAddUsers, wantAddGroups, wantAddManagedGroups, wantDeleteUsers, wantDeleteGroups, wantDeleteManagedGroups []string) {
 	t.Helper()
 	assert := assert.New(t)
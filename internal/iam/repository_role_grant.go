@@ -494,3 +494,32 @@ select role_id as role_id, role_scope as scope_id, role_grant as grant from fina
 	}
 	return grants, nil
 }
+
+// GrantsForManagedGroup returns the grants of every role the managed group is
+// a principal in. Each grant is returned with the role it comes from and the
+// role's grant scope, the same way GrantsForUser returns them, ordered by role
+// id. The grants flow to the users whose accounts are members of the managed
+// group.
+func (r *Repository) GrantsForManagedGroup(ctx context.Context, managedGroupId string, _ ...Option) ([]perms.GrantTuple, error) {
+	const op = "iam.(Repository).GrantsForManagedGroup"
+	if managedGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	}
+	var grants []perms.GrantTuple
+	rows, err := r.reader.Query(ctx, grantsForManagedGroupQuery, []any{managedGroupId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var g perms.GrantTuple
+		if err := r.reader.ScanRows(ctx, rows, &g); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		grants = append(grants, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return grants, nil
+}
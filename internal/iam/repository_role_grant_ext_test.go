@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -253,3 +254,50 @@ func TestGrantsForUser(t *testing.T) {
 		t.Log("finished user", user.PublicId, "total roles", len(expectedRoleIds), "roles from users", rolesFromUsers, "roles from groups", rolesFromGroups, "roles from managed groups", rolesFromManagedGroups)
 	}
 }
+
+func TestGrantsForManagedGroup(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	o, p := iam.TestScopes(
+		t,
+		iamRepo,
+		iam.WithSkipAdminRoleCreation(true),
+		iam.WithSkipDefaultRoleCreation(true),
+	)
+
+	kmsCache := kms.TestKms(t, conn, wrap)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	authMethod := oidc.TestAuthMethod(
+		t, conn, databaseWrapper, o.GetPublicId(), oidc.ActivePrivateState,
+		"alice-rp", "fido",
+		oidc.WithSigningAlgs(oidc.RS256),
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://www.alice.com")[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	mg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+	otherMg := oidc.TestManagedGroup(t, conn, authMethod, oidc.TestFakeManagedGroupFilter)
+
+	orgRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, orgRole.GetPublicId(), "id=*;type=*;actions=read")
+	iam.TestManagedGroupRole(t, conn, orgRole.GetPublicId(), mg.GetPublicId())
+	projRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, projRole.GetPublicId(), "id=*;type=target;actions=authorize-session")
+	iam.TestManagedGroupRole(t, conn, projRole.GetPublicId(), mg.GetPublicId())
+	otherRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, otherRole.GetPublicId(), "id=*;type=*;actions=*")
+	iam.TestManagedGroupRole(t, conn, otherRole.GetPublicId(), otherMg.GetPublicId())
+
+	got, err := iamRepo.GrantsForManagedGroup(ctx, mg.GetPublicId())
+	require.NoError(t, err)
+	want := []perms.GrantTuple{
+		{RoleId: orgRole.GetPublicId(), ScopeId: o.GetPublicId(), Grant: "id=*;type=*;actions=read"},
+		{RoleId: projRole.GetPublicId(), ScopeId: p.GetPublicId(), Grant: "id=*;type=target;actions=authorize-session"},
+	}
+	assert.ElementsMatch(t, want, got)
+
+	_, err = iamRepo.GrantsForManagedGroup(ctx, "")
+	require.Error(t, err)
+}
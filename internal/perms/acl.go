@@ -276,6 +276,20 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	return
 }
 
+// GrantedIds returns the specific resource IDs named by the grants in the
+// given scope. Allowed only distinguishes between resources of the same type
+// and pin by their ID when a grant names that ID, so every resource whose ID
+// is not returned is allowed the same actions.
+func (a ACL) GrantedIds(scopeId string) map[string]bool {
+	ret := make(map[string]bool)
+	for _, grant := range a.scopeMap[scopeId] {
+		if grant.id != "" && grant.id != "*" {
+			ret[grant.id] = true
+		}
+	}
+	return ret
+}
+
 // ListPermissions builds a set of Permissions based on the grants in the ACL.
 // Permissions are determined for the given resource for each of the provided scopes.
 // There must be a grant for a given resource for one of the provided "id actions"
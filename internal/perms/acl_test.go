@@ -412,6 +412,38 @@ func Test_ACLAllowed(t *testing.T) {
 	}
 }
 
+func TestACL_GrantedIds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var grants []Grant
+	for _, sg := range []scopeGrant{
+		{
+			scope: "o_a",
+			grants: []string{
+				"ids=ampw_bar,ampw_baz;actions=read,update",
+				"id=ampw_bop;actions=read:self,update",
+				"id=*;type=host-set;actions=list,create",
+				"type=host-catalog;actions=create",
+			},
+		},
+		{
+			scope:  "o_b",
+			grants: []string{"id=ampw_zip;actions=read"},
+		},
+	} {
+		for _, g := range sg.grants {
+			grant, err := Parse(ctx, sg.scope, g)
+			require.NoError(t, err)
+			grants = append(grants, grant)
+		}
+	}
+	acl := NewACL(grants...)
+	assert.Equal(t, map[string]bool{"ampw_bar": true, "ampw_baz": true, "ampw_bop": true}, acl.GrantedIds("o_a"))
+	assert.Equal(t, map[string]bool{"ampw_zip": true}, acl.GrantedIds("o_b"))
+	assert.Empty(t, acl.GrantedIds("o_c"))
+}
+
 func TestACL_ListPermissions(t *testing.T) {
 	t.Parallel()
 
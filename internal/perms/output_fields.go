@@ -5,6 +5,7 @@ package perms
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 )
@@ -143,3 +144,24 @@ func (o *OutputFields) Has(in string) bool {
 	}
 	return o.fields["*"] || o.fields[in]
 }
+
+// HasNested returns true if a field nested in the given one, written as a
+// dotted path such as "attributes.filter", is explicitly allowed, or the
+// fields contains *. A nested field can be granted without the field it is
+// nested in, in which case only it is output of that field. It is safe to
+// call this on a nil object (it will always return false).
+func (o *OutputFields) HasNested(in string) bool {
+	if o == nil || o.fields == nil {
+		return false
+	}
+	if o.fields["*"] {
+		return true
+	}
+	prefix := in + "."
+	for f := range o.fields {
+		if strings.HasPrefix(f, prefix) {
+			return true
+		}
+	}
+	return false
+}
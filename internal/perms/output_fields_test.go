@@ -133,6 +133,30 @@ func Test_OutputFields(t *testing.T) {
 	}
 }
 
+func Test_OutputFieldsHasNested(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	var nilFields *OutputFields
+	assert.False(nilFields.HasNested("attributes"))
+	assert.False((&OutputFields{}).HasNested("attributes"))
+
+	star := (&OutputFields{}).AddFields([]string{"*"})
+	assert.True(star.HasNested("attributes"))
+
+	nested := (&OutputFields{}).AddFields([]string{"id", "attributes.filter"})
+	assert.True(nested.HasNested("attributes"))
+	assert.True(nested.Has("attributes.filter"))
+	assert.False(nested.Has("attributes"))
+	assert.False(nested.HasNested("attributes.filter"))
+
+	// The parent field alone doesn't allow the fields nested in it.
+	parent := (&OutputFields{}).AddFields([]string{"id", "attributes"})
+	assert.False(parent.HasNested("attributes"))
+	assert.False(parent.Has("attributes.filter"))
+	assert.False((&OutputFields{}).AddFields([]string{"attributesfilter"}).HasNested("attributes"))
+}
+
 func Test_ACLOutputFields(t *testing.T) {
 	t.Parallel()
 
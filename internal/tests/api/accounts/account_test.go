@@ -512,7 +512,7 @@ func TestErrorsOidc(t *testing.T) {
 	require.JSONEq(err.Error(), `{
 		"details": {
 			"request_fields": [{
-				"description": "Attribute fields do not match the expected format.",
+				"description": "Attribute fields do not match the expected format: unknown fields \"login_name\".",
 				"name": "attributes"
 			}]
 		},
@@ -5,6 +5,7 @@ package subtypes
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"google.golang.org/protobuf/proto"
@@ -12,7 +13,74 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-func convertAttributesToSubtype(msg proto.Message, st Subtype) error {
+// attributeFieldsError returns an *AttributeFieldsError describing the fields
+// of attrs which can't be converted to the message m, or nil if it can't tell
+// which fields are at fault. Each field is converted on its own, so the
+// fields of attrs must fail to convert together before this is used.
+func attributeFieldsError(attrs *structpb.Struct, m proto.Message) error {
+	known := knownAttributeFields(m)
+	var fieldsErr AttributeFieldsError
+	for k, v := range attrs.GetFields() {
+		if !known[k] {
+			fieldsErr.Unknown = append(fieldsErr.Unknown, k)
+			continue
+		}
+		single := &structpb.Struct{Fields: map[string]*structpb.Value{k: v}}
+		if err := handlers.StructToProto(single, m.ProtoReflect().New().Interface()); err != nil {
+			fieldsErr.Invalid = append(fieldsErr.Invalid, k)
+		}
+	}
+	if len(fieldsErr.Unknown) == 0 && len(fieldsErr.Invalid) == 0 {
+		return nil
+	}
+	sort.Strings(fieldsErr.Unknown)
+	sort.Strings(fieldsErr.Invalid)
+	return &fieldsErr
+}
+
+// knownAttributeFields returns the names the fields of the attributes message
+// m can be provided by.
+func knownAttributeFields(m proto.Message) map[string]bool {
+	known := make(map[string]bool)
+	fields := m.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		// protojson accepts both the JSON and the proto name of a field.
+		known[fields.Get(i).JSONName()] = true
+		known[string(fields.Get(i).Name())] = true
+	}
+	return known
+}
+
+// withoutUnknownFields returns attrs without the fields the attributes message
+// m doesn't have, along with the names of those fields, sorted. attrs is
+// returned as is if it has no unknown fields.
+func withoutUnknownFields(attrs *structpb.Struct, m proto.Message) (*structpb.Struct, []string) {
+	known := knownAttributeFields(m)
+	var unknown []string
+	for k := range attrs.GetFields() {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) == 0 {
+		return attrs, nil
+	}
+	sort.Strings(unknown)
+	kept := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(attrs.GetFields())-len(unknown))}
+	for k, v := range attrs.GetFields() {
+		if known[k] {
+			kept.Fields[k] = v
+		}
+	}
+	return kept, unknown
+}
+
+// convertAttributesToSubtype sets the attributes of msg provided in the
+// default attributes field into the attributes field of the subtype. When
+// ignoreUnknown is set, attribute fields the subtype doesn't have are left out
+// rather than failing the conversion, and their names are returned. The
+// fields the subtype has are converted strictly either way.
+func convertAttributesToSubtype(msg proto.Message, st Subtype, ignoreUnknown bool) ([]string, error) {
 	r := msg.ProtoReflect()
 	d := r.Descriptor()
 
@@ -22,7 +90,7 @@ func convertAttributesToSubtype(msg proto.Message, st Subtype) error {
 		// not registered or not in the format needed for conversion, so no
 		// conversion should be performed. The most likely case here is that
 		// the message has not been changed to use a oneof for attributes yet.
-		return nil
+		return nil, nil
 	}
 
 	stAttrField, err := attributeField(d, st)
@@ -30,12 +98,21 @@ func convertAttributesToSubtype(msg proto.Message, st Subtype) error {
 		// This error should not be possible, since any registration issue
 		// would trigger the previous call, and if this particular subtype
 		// is unknown, the default should be returned.
-		return nil
+		return nil, nil
 	}
 
 	if defaultAttrField == stAttrField {
 		// no need to convert
-		return nil
+		return nil, nil
+	}
+
+	if oneof := defaultAttrField.ContainingOneof(); oneof != nil {
+		if set := r.WhichOneof(oneof); set != nil && set != defaultAttrField {
+			// The attributes were provided in a strongly-typed field, e.g. by
+			// a gRPC client, so there is nothing to convert. Converting the
+			// unset default field would replace them with empty attributes.
+			return nil, nil
+		}
 	}
 
 	defaultAttrs, ok := r.Get(defaultAttrField).Message().Interface().(*structpb.Struct)
@@ -43,16 +120,23 @@ func convertAttributesToSubtype(msg proto.Message, st Subtype) error {
 		// This should not be possible since this is checked in
 		// (attributeRegistry).register at initialization time and would panic
 		// if this was the case.
-		return fmt.Errorf("found default attribute field that is not structpb.Struct: %s %s", d.FullName(), defaultAttrField.FullName())
+		return nil, fmt.Errorf("found default attribute field that is not structpb.Struct: %s %s", d.FullName(), defaultAttrField.FullName())
 	}
 	stAttrs := r.Get(stAttrField).Message().New().Interface()
+	var ignored []string
+	if ignoreUnknown {
+		defaultAttrs, ignored = withoutUnknownFields(defaultAttrs, stAttrs)
+	}
 	if err := handlers.StructToProto(defaultAttrs, stAttrs); err != nil {
-		return err
+		if fieldsErr := attributeFieldsError(defaultAttrs, stAttrs); fieldsErr != nil {
+			return nil, fieldsErr
+		}
+		return nil, err
 	}
 
 	// implicitly clears any previously set oneof value
 	r.Set(stAttrField, protoreflect.ValueOfMessage(stAttrs.ProtoReflect()))
-	return nil
+	return ignored, nil
 }
 
 func convertAttributesToDefault(msg proto.Message, st Subtype) error {
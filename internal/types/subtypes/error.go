@@ -3,6 +3,11 @@
 
 package subtypes
 
+import (
+	"strconv"
+	"strings"
+)
+
 // UnknownSubtypeIDError is an error type that describes an invalid
 // resource sub-type identifer. For example, this authentication sub-type
 // ID "ampwd_1234567890" is an error because the prefix "ampwd" is invalid.
@@ -16,3 +21,35 @@ type UnknownSubtypeIDError struct {
 func (e *UnknownSubtypeIDError) Error() string {
 	return "unknown subtype in ID: " + e.ID
 }
+
+// AttributeFieldsError is an error type that describes the attribute fields
+// which couldn't be converted to the attributes of a resource sub-type. For
+// example, the attribute field "filtr" is unknown to OIDC managed groups, and
+// the attribute field "filter" has an invalid value if it is a number.
+type AttributeFieldsError struct {
+	// Unknown are the attribute fields the sub-type doesn't have.
+	Unknown []string
+	// Invalid are the attribute fields whose value isn't valid for the field.
+	Invalid []string
+}
+
+// Error returns a string describing the attribute fields which couldn't be
+// converted.
+// Example: `unknown fields "filtr"; invalid values for fields "filter"`
+func (e *AttributeFieldsError) Error() string {
+	quote := func(fields []string) string {
+		quoted := make([]string, 0, len(fields))
+		for _, f := range fields {
+			quoted = append(quoted, strconv.Quote(f))
+		}
+		return strings.Join(quoted, ", ")
+	}
+	var parts []string
+	if len(e.Unknown) > 0 {
+		parts = append(parts, "unknown fields "+quote(e.Unknown))
+	}
+	if len(e.Invalid) > 0 {
+		parts = append(parts, "invalid values for fields "+quote(e.Invalid))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -6,14 +6,30 @@ package subtypes
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/protooptions"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ignoreUnknownAttributesField is the bool field of a request which, when set,
+// makes unknown attribute fields be ignored rather than rejected.
+const ignoreUnknownAttributesField = "ignore_unknown_attributes"
+
+const (
+	// updateMaskField is the field mask of an update request.
+	updateMaskField = "update_mask"
+	// attributesPath is the update mask path of the attributes of an item,
+	// which prefixes the paths of the individual attributes.
+	attributesPath = "attributes"
 )
 
 func messageDomain(m proto.Message) string {
@@ -97,7 +113,17 @@ func messageDomain(m proto.Message) string {
 //
 // Also note that for any of the id based lookups to function, the file that contains
 // the proto.Message definition must set the "domain" custom option.
-func transformRequestAttributes(req proto.Message) error {
+//
+// Attribute fields the subtype doesn't have fail the transformation, unless
+// the request has a bool "ignore_unknown_attributes" field which is set. Then
+// they are left out, e.g. so a newer client can send fields an older
+// controller doesn't know yet, and their names are returned.
+//
+// The attributes of the item of a request with an "update_mask" field mask
+// which is set but doesn't include any attribute paths aren't transformed,
+// since they aren't updated. This way attributes which are irrelevant to the
+// update can't fail it.
+func transformRequestAttributes(req proto.Message) ([]string, error) {
 	domain := messageDomain(req)
 
 	r := req.ProtoReflect()
@@ -107,6 +133,11 @@ func transformRequestAttributes(req proto.Message) error {
 	idField := fields.ByName("id")
 	attributesField := fields.ByName("attributes")
 
+	var ignoreUnknown bool
+	if fd := fields.ByName(ignoreUnknownAttributesField); fd != nil && fd.Kind() == protoreflect.BoolKind {
+		ignoreUnknown = r.Get(fd).Bool()
+	}
+
 	fieldValue := func(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
 		if fd == nil {
 			return ""
@@ -119,7 +150,7 @@ func transformRequestAttributes(req proto.Message) error {
 	case itemField != nil:
 		itemR := itemField.Message()
 		if itemR == nil {
-			return nil
+			return nil, nil
 		}
 		id := fieldValue(r, idField)
 
@@ -140,15 +171,43 @@ func transformRequestAttributes(req proto.Message) error {
 		case typeField != nil && t != "":
 			st = Subtype(t)
 		default: // need either type or id
-			return nil
+			return nil, nil
+		}
+		if mask, ok := updateMask(r); ok && !maskIncludesAttributes(mask.GetPaths()) {
+			return nil, nil
 		}
-		return convertAttributesToSubtype(item, st)
+		return convertAttributesToSubtype(item, st, ignoreUnknown)
 	case idField != nil && attributesField != nil:
 		id := r.Get(idField).String()
 		st = SubtypeFromId(domain, id)
-		return convertAttributesToSubtype(req, st)
+		return convertAttributesToSubtype(req, st, ignoreUnknown)
 	}
-	return nil
+	return nil, nil
+}
+
+// updateMask returns the "update_mask" field mask of the request, if it has
+// one which is set.
+func updateMask(r protoreflect.Message) (*fieldmaskpb.FieldMask, bool) {
+	fd := r.Descriptor().Fields().ByName(updateMaskField)
+	if fd == nil || fd.Message() == nil || !r.Has(fd) {
+		return nil, false
+	}
+	mask, ok := r.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask)
+	return mask, ok
+}
+
+// maskIncludesAttributes reports whether any of the update mask paths, which
+// may each hold several comma separated paths, is an attribute path.
+func maskIncludesAttributes(paths []string) bool {
+	for _, p := range paths {
+		for _, v := range strings.Split(p, ",") {
+			v = strings.TrimSpace(v)
+			if v == attributesPath || strings.HasPrefix(v, attributesPath+".") {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func transformResponseItemAttributes(item proto.Message) error {
@@ -176,14 +235,33 @@ func transformResponseItemAttributes(item proto.Message) error {
 	return convertAttributesToDefault(item, st)
 }
 
-func transformRequest(msg proto.Message) error {
+// transformRequest transforms the attributes of the request, returning the
+// names of the attribute fields which were ignored.
+func transformRequest(msg proto.Message) ([]string, error) {
 	fqn := msg.ProtoReflect().Descriptor().FullName()
 	if fn, ok := globalTransformationRegistry.requestTransformationFuncs[fqn]; ok {
-		return fn(msg)
+		return nil, fn(msg)
 	}
 	return transformRequestAttributes(msg)
 }
 
+// writeIgnoredAttributesEvent records that the unknown attribute fields of the
+// request were ignored, so operators can tell clients are sending fields the
+// controller doesn't know yet.
+func writeIgnoredAttributesEvent(ctx context.Context, op event.Op, req proto.Message, ignored []string) {
+	if _, ok := event.EventerFromContext(ctx); !ok && event.SysEventer() == nil {
+		// Nothing can be written, e.g. in tests.
+		return
+	}
+	details := []any{
+		"request", string(req.ProtoReflect().Descriptor().FullName()),
+		"ignored_attribute_fields", ignored,
+	}
+	if err := event.WriteObservation(ctx, op, event.WithDetails(details...)); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write ignored attribute fields observation"))
+	}
+}
+
 // transformResponseAttributes will modify the response proto.Message, setting
 // any subtype attribute fields into the default structpb.Struct field. It looks
 // for some specific structure in the proto.Message to identify that it is a
@@ -252,6 +330,26 @@ func transformResponse(msg proto.Message) error {
 	return transformResponseAttributes(msg)
 }
 
+// transformRequestFieldErrs returns the field errors to report when
+// transforming the attributes of a request failed with err. When the
+// attribute fields at fault are known they are named so the request can be
+// fixed.
+func transformRequestFieldErrs(err error) map[string]string {
+	fieldErrs := map[string]string{
+		"attributes": "Attribute fields do not match the expected format.",
+	}
+
+	var unknownSubTypeIDErr *UnknownSubtypeIDError
+	var attributeFieldsErr *AttributeFieldsError
+	switch {
+	case errors.As(err, &unknownSubTypeIDErr):
+		fieldErrs["attributes"] = unknownSubTypeIDErr.Error()
+	case errors.As(err, &attributeFieldsErr):
+		fieldErrs["attributes"] = fmt.Sprintf("Attribute fields do not match the expected format: %s.", attributeFieldsErr.Error())
+	}
+	return fieldErrs
+}
+
 // AttributeTransformerInterceptor is a grpc server interceptor that will
 // transform subtype attributes for requests and responses. This will only
 // modify requests and responses that adhere to a specific structure and is done
@@ -301,17 +399,12 @@ func AttributeTransformerInterceptor(_ context.Context) grpc.UnaryServerIntercep
 	const op = "subtypes.AttributeTransformInterceptor"
 	return func(interceptorCtx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		if reqMsg, ok := req.(proto.Message); ok {
-			if err := transformRequest(reqMsg); err != nil {
-				fieldErrs := map[string]string{
-					"attributes": "Attribute fields do not match the expected format.",
-				}
-
-				var unknownSubTypeIDErr *UnknownSubtypeIDError
-				if errors.As(err, &unknownSubTypeIDErr) {
-					fieldErrs["attributes"] = unknownSubTypeIDErr.Error()
-				}
-
-				return nil, handlers.InvalidArgumentErrorf("Error in provided request.", fieldErrs)
+			ignored, err := transformRequest(reqMsg)
+			if err != nil {
+				return nil, handlers.InvalidArgumentErrorf("Error in provided request.", transformRequestFieldErrs(err))
+			}
+			if len(ignored) > 0 {
+				writeIgnoredAttributesEvent(interceptorCtx, op, reqMsg, ignored)
 			}
 		}
 
@@ -325,3 +418,45 @@ func AttributeTransformerInterceptor(_ context.Context) grpc.UnaryServerIntercep
 		return res, handlerErr
 	}
 }
+
+// AttributeTransformerStreamInterceptor is a grpc stream server interceptor
+// that transforms subtype attributes the way AttributeTransformerInterceptor
+// does, for every message received and sent on the stream.
+func AttributeTransformerStreamInterceptor(_ context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &attributeTransformerServerStream{ServerStream: ss})
+	}
+}
+
+// attributeTransformerServerStream wraps a grpc.ServerStream to transform the
+// subtype attributes of the messages it receives and sends.
+type attributeTransformerServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *attributeTransformerServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	ignored, err := transformRequest(msg)
+	if err != nil {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", transformRequestFieldErrs(err))
+	}
+	if len(ignored) > 0 {
+		writeIgnoredAttributesEvent(s.Context(), "subtypes.AttributeTransformerStreamInterceptor", msg, ignored)
+	}
+	return nil
+}
+
+func (s *attributeTransformerServerStream) SendMsg(m any) error {
+	if msg, ok := m.(proto.Message); ok {
+		if err := transformResponse(msg); err != nil {
+			return handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building attribute struct: %v", err)
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
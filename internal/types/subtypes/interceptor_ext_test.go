@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/testing/attribute"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -338,7 +341,29 @@ func TestAttributeTransformerInterceptorRequestErrors(t *testing.T) {
 				},
 			},
 			handlers.InvalidArgumentErrorf("Error in provided request.",
-				map[string]string{"attributes": "Attribute fields do not match the expected format."}),
+				map[string]string{"attributes": `Attribute fields do not match the expected format: unknown fields "foo".`}),
+		},
+		{
+			// Ignoring unknown fields doesn't stop known fields being
+			// validated.
+			"InvalidKnownAttributesIgnoringUnknown",
+			&attribute.TestCreateResourceRequest{
+				Item: &attribute.TestResource{
+					Type: "sub_resource",
+					Attrs: &attribute.TestResource_Attributes{
+						Attributes: func() *structpb.Struct {
+							attrs, _ := structpb.NewStruct(map[string]any{
+								"foo":  "test",
+								"name": 1,
+							})
+							return attrs
+						}(),
+					},
+				},
+				IgnoreUnknownAttributes: true,
+			},
+			handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes": `Attribute fields do not match the expected format: invalid values for fields "name".`}),
 		},
 	}
 
@@ -356,3 +381,141 @@ func TestAttributeTransformerInterceptorRequestErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestAttributeTransformerInterceptorUpdateMask(t *testing.T) {
+	update := func(paths ...string) *attribute.TestUpdateResourceRequest {
+		attrs, _ := structpb.NewStruct(map[string]any{
+			"foo":  "test",
+			"name": 1,
+		})
+		return &attribute.TestUpdateResourceRequest{
+			Id: "trsr_one",
+			Item: &attribute.TestResource{
+				Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		}
+	}
+	invalid := handlers.InvalidArgumentErrorf("Error in provided request.",
+		map[string]string{"attributes": `Attribute fields do not match the expected format: unknown fields "foo"; invalid values for fields "name".`})
+	cases := []struct {
+		name  string
+		paths []string
+		want  error
+	}{
+		{"OtherPaths", []string{"name", "description"}, nil},
+		{"AttributePath", []string{"name", "attributes.name"}, invalid},
+		{"Attributes", []string{"attributes"}, invalid},
+		{"CommaJoinedAttributePath", []string{"name,attributes.name"}, invalid},
+		// Without a mask the handler decides what is updated.
+		{"NoMask", nil, invalid},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			req := update(tc.paths...)
+			if tc.paths == nil {
+				req.UpdateMask = nil
+			}
+			want := proto.Clone(req)
+			handler := func(ctx context.Context, req any) (any, error) {
+				// The attributes which aren't updated are left as they are.
+				require.Empty(t, cmp.Diff(req, want, protocmp.Transform()))
+				return &attribute.TestUpdateResourceResponse{}, nil
+			}
+
+			_, err := subtypes.AttributeTransformerInterceptor(ctx)(ctx, req, nil, handler)
+			if tc.want == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+// testServerStream is a grpc.ServerStream that receives recv and records
+// what is sent.
+type testServerStream struct {
+	grpc.ServerStream
+	recv proto.Message
+	sent []proto.Message
+}
+
+func (s *testServerStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.recv)
+	return nil
+}
+
+func (s *testServerStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m.(proto.Message))
+	return nil
+}
+
+func TestAttributeTransformerStreamInterceptor(t *testing.T) {
+	ctx := context.Background()
+	attrs, err := structpb.NewStruct(map[string]any{"name": "test"})
+	require.NoError(t, err)
+	stream := &testServerStream{
+		recv: &attribute.TestCreateResourceRequest{
+			Item: &attribute.TestResource{
+				Type:  "sub_resource",
+				Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+			},
+		},
+	}
+	handler := func(_ any, ss grpc.ServerStream) error {
+		req := &attribute.TestCreateResourceRequest{}
+		require.NoError(t, ss.RecvMsg(req))
+		require.Empty(t, cmp.Diff(&attribute.TestCreateResourceRequest{
+			Item: &attribute.TestResource{
+				Type: "sub_resource",
+				Attrs: &attribute.TestResource_SubResourceAttributes{
+					SubResourceAttributes: &attribute.TestSubResourceAttributes{Name: "test"},
+				},
+			},
+		}, req, protocmp.Transform()))
+		for _, id := range []string{"trsr_one", "trsr_two"} {
+			require.NoError(t, ss.SendMsg(&attribute.TestCreateResourceResponse{
+				Item: &attribute.TestResource{
+					Id:   id,
+					Type: "sub_resource",
+					Attrs: &attribute.TestResource_SubResourceAttributes{
+						SubResourceAttributes: &attribute.TestSubResourceAttributes{Name: "test"},
+					},
+				},
+			}))
+		}
+		return nil
+	}
+	require.NoError(t, subtypes.AttributeTransformerStreamInterceptor(ctx)(nil, stream, nil, handler))
+
+	// Every sent message is transformed.
+	require.Len(t, stream.sent, 2)
+	for i, id := range []string{"trsr_one", "trsr_two"} {
+		require.Empty(t, cmp.Diff(&attribute.TestCreateResourceResponse{
+			Item: &attribute.TestResource{
+				Id:    id,
+				Type:  "sub_resource",
+				Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+			},
+		}, stream.sent[i], protocmp.Transform()))
+	}
+
+	// A request with invalid attributes is an error from RecvMsg.
+	invalid, err := structpb.NewStruct(map[string]any{"foo": "test"})
+	require.NoError(t, err)
+	stream = &testServerStream{
+		recv: &attribute.TestCreateResourceRequest{
+			Item: &attribute.TestResource{
+				Type:  "sub_resource",
+				Attrs: &attribute.TestResource_Attributes{Attributes: invalid},
+			},
+		},
+	}
+	err = subtypes.AttributeTransformerStreamInterceptor(ctx)(nil, stream, nil, func(_ any, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&attribute.TestCreateResourceRequest{})
+	})
+	require.ErrorIs(t, err, handlers.InvalidArgumentErrorf("Error in provided request.",
+		map[string]string{"attributes": `Attribute fields do not match the expected format: unknown fields "foo".`}))
+}
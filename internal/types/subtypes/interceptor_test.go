@@ -53,6 +53,29 @@ func TestTransformRequestAttributes(t *testing.T) {
 				},
 			},
 		},
+		{
+			"TestCreateResource/SubResourceRequestTypedAttributes",
+			&attribute.TestCreateResourceRequest{
+				Item: &attribute.TestResource{
+					Type: "sub_resource",
+					Attrs: &attribute.TestResource_SubResourceAttributes{
+						SubResourceAttributes: &attribute.TestSubResourceAttributes{
+							Name: "test",
+						},
+					},
+				},
+			},
+			&attribute.TestCreateResourceRequest{
+				Item: &attribute.TestResource{
+					Type: "sub_resource",
+					Attrs: &attribute.TestResource_SubResourceAttributes{
+						SubResourceAttributes: &attribute.TestSubResourceAttributes{
+							Name: "test",
+						},
+					},
+				},
+			},
+		},
 		{
 			"TestCreateResource/DefaultResourceRequest",
 			&attribute.TestCreateResourceRequest{
@@ -302,7 +325,7 @@ func TestTransformRequestAttributes(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := transformRequest(tc.req)
+			_, err := transformRequest(tc.req)
 			require.NoError(t, err)
 			assert.Empty(t, cmp.Diff(tc.req, tc.expected, protocmp.Transform()))
 		})
@@ -632,7 +655,7 @@ func TestCustomTransformRequest(t *testing.T) {
 		},
 	}
 
-	err := transformRequest(request)
+	_, err := transformRequest(request)
 	require.NoError(t, err)
 	assert.Empty(t, cmp.Diff(request, expected, protocmp.Transform()))
 }
@@ -679,3 +702,83 @@ func TestCustomTransformResponse(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, cmp.Diff(response, expected, protocmp.Transform()))
 }
+
+func TestTransformRequestAttributes_fieldErrors(t *testing.T) {
+	attrs, err := structpb.NewStruct(map[string]any{
+		"foo":  "test",
+		"nme":  "test",
+		"name": 1,
+	})
+	require.NoError(t, err)
+	request := &attribute.TestCreateResourceRequest{
+		Item: &attribute.TestResource{
+			Type:  "sub_resource",
+			Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+		},
+	}
+
+	_, err = transformRequest(request)
+	require.Error(t, err)
+	var fieldsErr *AttributeFieldsError
+	require.ErrorAs(t, err, &fieldsErr)
+	assert.Equal(t, []string{"foo", "nme"}, fieldsErr.Unknown)
+	assert.Equal(t, []string{"name"}, fieldsErr.Invalid)
+	assert.Equal(t, map[string]string{
+		"attributes": `Attribute fields do not match the expected format: unknown fields "foo", "nme"; invalid values for fields "name".`,
+	}, transformRequestFieldErrs(err))
+
+	// Errors which don't come from the attribute fields keep the generic
+	// message.
+	assert.Equal(t, map[string]string{
+		"attributes": "Attribute fields do not match the expected format.",
+	}, transformRequestFieldErrs(assert.AnError))
+}
+
+func TestTransformRequestAttributes_ignoreUnknown(t *testing.T) {
+	attrs, err := structpb.NewStruct(map[string]any{
+		"foo":  "test",
+		"nme":  "test",
+		"name": "test",
+	})
+	require.NoError(t, err)
+	request := &attribute.TestCreateResourceRequest{
+		Item: &attribute.TestResource{
+			Type:  "sub_resource",
+			Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+		},
+		IgnoreUnknownAttributes: true,
+	}
+
+	ignored, err := transformRequest(request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "nme"}, ignored)
+	assert.Empty(t, cmp.Diff(&attribute.TestCreateResourceRequest{
+		Item: &attribute.TestResource{
+			Type: "sub_resource",
+			Attrs: &attribute.TestResource_SubResourceAttributes{
+				SubResourceAttributes: &attribute.TestSubResourceAttributes{Name: "test"},
+			},
+		},
+		IgnoreUnknownAttributes: true,
+	}, request, protocmp.Transform()))
+
+	// Known fields are still validated.
+	attrs, err = structpb.NewStruct(map[string]any{
+		"foo":  "test",
+		"name": 1,
+	})
+	require.NoError(t, err)
+	request = &attribute.TestCreateResourceRequest{
+		Item: &attribute.TestResource{
+			Type:  "sub_resource",
+			Attrs: &attribute.TestResource_Attributes{Attributes: attrs},
+		},
+		IgnoreUnknownAttributes: true,
+	}
+	_, err = transformRequest(request)
+	require.Error(t, err)
+	var fieldsErr *AttributeFieldsError
+	require.ErrorAs(t, err, &fieldsErr)
+	assert.Empty(t, fieldsErr.Unknown)
+	assert.Equal(t, []string{"name"}, fieldsErr.Invalid)
+}